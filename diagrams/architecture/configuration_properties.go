@@ -0,0 +1,82 @@
+package architecture
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	architectureConfigurationSection        string = "architecture"
+	baseArchitectureConfigurationProperties string = basediagram.Indentation + architectureConfigurationSection + ":\n"
+
+	architecturePropertyPadding     string = "padding"
+	architecturePropertyIconSize    string = "iconSize"
+	architecturePropertyFontSize    string = "fontSize"
+	architecturePropertyUseMaxWidth string = "useMaxWidth"
+)
+
+// ArchitectureConfigurationProperties holds architecture-specific configuration
+type ArchitectureConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewArchitectureConfigurationProperties() ArchitectureConfigurationProperties {
+	return ArchitectureConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *ArchitectureConfigurationProperties) SetPadding(v int) *ArchitectureConfigurationProperties {
+	c.properties[architecturePropertyPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: architecturePropertyPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *ArchitectureConfigurationProperties) SetIconSize(v int) *ArchitectureConfigurationProperties {
+	c.properties[architecturePropertyIconSize] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: architecturePropertyIconSize,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *ArchitectureConfigurationProperties) SetFontSize(v int) *ArchitectureConfigurationProperties {
+	c.properties[architecturePropertyFontSize] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: architecturePropertyFontSize,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *ArchitectureConfigurationProperties) SetUseMaxWidth(v bool) *ArchitectureConfigurationProperties {
+	c.properties[architecturePropertyUseMaxWidth] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: architecturePropertyUseMaxWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c ArchitectureConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(baseArchitectureConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
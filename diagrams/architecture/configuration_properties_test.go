@@ -0,0 +1,170 @@
+package architecture
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewArchitectureConfigurationProperties(t *testing.T) {
+	got := NewArchitectureConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewArchitectureConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewArchitectureConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestArchitectureConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   ArchitectureConfigurationProperties
+		setup    func(*ArchitectureConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewArchitectureConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewArchitectureConfigurationProperties(),
+			setup: func(c *ArchitectureConfigurationProperties) {
+				c.SetPadding(40)
+			},
+			contains: []string{
+				"architecture:",
+				"padding: 40",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewArchitectureConfigurationProperties(),
+			setup: func(c *ArchitectureConfigurationProperties) {
+				c.SetIconSize(80)
+				c.SetUseMaxWidth(true)
+			},
+			contains: []string{
+				"architecture:",
+				"iconSize: 80",
+				"useMaxWidth: true",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewArchitectureConfigurationProperties(),
+			setup: func(c *ArchitectureConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetPadding(40)
+			},
+			contains: []string{
+				"fontSize: 12",
+				"architecture:",
+				"padding: 40",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestArchitectureConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*ArchitectureConfigurationProperties) *ArchitectureConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set padding",
+			setup: func(c *ArchitectureConfigurationProperties) *ArchitectureConfigurationProperties {
+				return c.SetPadding(40)
+			},
+			property: architecturePropertyPadding,
+			value:    40,
+		},
+		{
+			name: "Set icon size",
+			setup: func(c *ArchitectureConfigurationProperties) *ArchitectureConfigurationProperties {
+				return c.SetIconSize(80)
+			},
+			property: architecturePropertyIconSize,
+			value:    80,
+		},
+		{
+			name: "Set font size",
+			setup: func(c *ArchitectureConfigurationProperties) *ArchitectureConfigurationProperties {
+				return c.SetFontSize(16)
+			},
+			property: architecturePropertyFontSize,
+			value:    16,
+		},
+		{
+			name: "Set use max width",
+			setup: func(c *ArchitectureConfigurationProperties) *ArchitectureConfigurationProperties {
+				return c.SetUseMaxWidth(true)
+			},
+			property: architecturePropertyUseMaxWidth,
+			value:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewArchitectureConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Package architecture provides functionality for creating Mermaid architecture diagrams
+package architecture
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for architecture diagrams
+const (
+	diagramType     string = "architecture-beta"
+	baseDiagramType string = diagramType + "\n"
+)
+
+// Diagram represents a Mermaid architecture diagram: services and junctions,
+// optionally placed in nested groups, connected by edges anchored to their sides.
+// Reference: https://mermaid.js.org/syntax/architecture.html
+type Diagram struct {
+	basediagram.BaseDiagram[ArchitectureConfigurationProperties]
+	Groups    []*Group
+	Services  []*Service
+	Junctions []*Junction
+	Edges     []*Edge
+}
+
+// NewDiagram creates a new architecture diagram
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram: basediagram.NewBaseDiagram(NewArchitectureConfigurationProperties()),
+		Groups:      make([]*Group, 0),
+		Services:    make([]*Service, 0),
+		Junctions:   make([]*Junction, 0),
+		Edges:       make([]*Edge, 0),
+	}
+}
+
+// AddGroup creates and adds a new top-level group
+func (d *Diagram) AddGroup(id string, icon string, title string) *Group {
+	group := NewGroup(id, icon, title)
+	d.Groups = append(d.Groups, group)
+	return group
+}
+
+// AddService creates and adds a new top-level service
+func (d *Diagram) AddService(id string, icon string, title string) *Service {
+	service := NewService(id, icon, title)
+	d.Services = append(d.Services, service)
+	return service
+}
+
+// AddJunction creates and adds a new top-level junction
+func (d *Diagram) AddJunction(id string) *Junction {
+	junction := NewJunction(id)
+	d.Junctions = append(d.Junctions, junction)
+	return junction
+}
+
+// AddEdge creates and adds a new edge between the given sides of two nodes
+func (d *Diagram) AddEdge(from Node, fromSide edgeSide, to Node, toSide edgeSide) *Edge {
+	edge := NewEdge(from, fromSide, to, toSide)
+	d.Edges = append(d.Edges, edge)
+	return edge
+}
+
+// String generates the Mermaid syntax for the architecture diagram
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces an architecture diagram.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one element at a time. Groups come
+// first, so that they are declared before the shapes placed in them.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		for _, group := range d.Groups {
+			if group != nil {
+				w.WriteString(group.String())
+			}
+		}
+
+		for _, service := range d.Services {
+			if service != nil {
+				w.WriteString(service.String())
+			}
+		}
+
+		for _, junction := range d.Junctions {
+			if junction != nil {
+				w.WriteString(junction.String())
+			}
+		}
+
+		for _, edge := range d.Edges {
+			if edge != nil {
+				w.WriteString(edge.String())
+			}
+		}
+	})
+}
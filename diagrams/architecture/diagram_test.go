@@ -0,0 +1,119 @@
+package architecture
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if len(diagram.Groups) != 0 || len(diagram.Services) != 0 || len(diagram.Junctions) != 0 || len(diagram.Edges) != 0 {
+		t.Error("NewDiagram() should create empty groups, services, junctions and edges")
+	}
+}
+
+func TestDiagram_AddGroup(t *testing.T) {
+	diagram := NewDiagram()
+	group := diagram.AddGroup("api", IconCloud, "API")
+
+	if len(diagram.Groups) != 1 || diagram.Groups[0] != group {
+		t.Fatalf("AddGroup() groups = %v, want [%v]", diagram.Groups, group)
+	}
+
+	if group.ID != "api" || group.Icon != IconCloud || group.Title != "API" || group.Parent != nil {
+		t.Errorf("AddGroup() = %+v, want top-level group api", group)
+	}
+}
+
+func TestDiagram_AddService(t *testing.T) {
+	diagram := NewDiagram()
+	service := diagram.AddService("db", IconDatabase, "Database")
+
+	if len(diagram.Services) != 1 || diagram.Services[0] != service {
+		t.Fatalf("AddService() services = %v, want [%v]", diagram.Services, service)
+	}
+
+	if service.ID != "db" || service.Icon != IconDatabase || service.Title != "Database" || service.Parent != nil {
+		t.Errorf("AddService() = %+v, want top-level service db", service)
+	}
+}
+
+func TestDiagram_AddJunction(t *testing.T) {
+	diagram := NewDiagram()
+	junction := diagram.AddJunction("hub")
+
+	if len(diagram.Junctions) != 1 || diagram.Junctions[0] != junction {
+		t.Fatalf("AddJunction() junctions = %v, want [%v]", diagram.Junctions, junction)
+	}
+
+	if junction.ID != "hub" || junction.Parent != nil {
+		t.Errorf("AddJunction() = %+v, want top-level junction hub", junction)
+	}
+}
+
+func TestDiagram_AddEdge(t *testing.T) {
+	diagram := NewDiagram()
+	db := diagram.AddService("db", IconDatabase, "")
+	server := diagram.AddService("server", IconServer, "")
+	edge := diagram.AddEdge(db, SideLeft, server, SideRight)
+
+	if len(diagram.Edges) != 1 || diagram.Edges[0] != edge {
+		t.Fatalf("AddEdge() edges = %v, want [%v]", diagram.Edges, edge)
+	}
+
+	if edge.From != db || edge.FromSide != SideLeft || edge.To != server || edge.ToSide != SideRight {
+		t.Errorf("AddEdge() = %+v, want db:L to R:server", edge)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func() *Diagram
+		want  string
+	}{
+		{
+			name: "Empty diagram",
+			setup: func() *Diagram {
+				return NewDiagram()
+			},
+			want: "architecture-beta\n",
+		},
+		{
+			name: "Complete diagram",
+			setup: func() *Diagram {
+				d := NewDiagram()
+				d.SetTitle("Platform")
+				api := d.AddGroup("api", IconCloud, "API")
+				private := d.AddGroup("private", "", "Private").SetParent(api)
+				db := d.AddService("db", IconDatabase, "Database").SetParent(private)
+				server := d.AddService("server", IconServer, "Server").SetParent(api)
+				hub := d.AddJunction("hub").SetParent(api)
+				d.Services = append(d.Services, nil)
+				d.AddEdge(db, SideLeft, server, SideRight)
+				d.AddEdge(server, SideBottom, hub, SideTop).SetArrows(false, true)
+				d.AddEdge(db, SideTop, server, SideBottom).SetGroups(true, false).SetLabel("replication")
+				return d
+			},
+			want: "architecture-beta\n" +
+				"    group api(cloud)[API]\n" +
+				"    group private[Private] in api\n" +
+				"    service db(database)[Database] in private\n" +
+				"    service server(server)[Server] in api\n" +
+				"    junction hub in api\n" +
+				"    db:L -- R:server\n" +
+				"    server:B --> T:hub\n" +
+				"    db{group}:T -[replication]- B:server\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.setup().String()
+			if !strings.HasSuffix(got, "---\n"+tt.want) {
+				t.Errorf("String() = %q, want suffix %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package architecture
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// edgeSide is the side of a shape that an edge is anchored to.
+type edgeSide string
+
+// List of edge sides.
+const (
+	SideLeft   edgeSide = "L"
+	SideRight  edgeSide = "R"
+	SideTop    edgeSide = "T"
+	SideBottom edgeSide = "B"
+)
+
+// Base string formats for edges
+const (
+	baseEdge      string = basediagram.Indentation + "%s:%s %s %s:%s\n"
+	baseEdgeGroup string = "{group}"
+)
+
+// Edge represents a line between the sides of two nodes. When FromGroup or
+// ToGroup is set, that end of the edge is drawn from the boundary of the
+// group holding the node instead of from the node itself.
+type Edge struct {
+	From      Node
+	FromSide  edgeSide
+	FromArrow bool
+	FromGroup bool
+	To        Node
+	ToSide    edgeSide
+	ToArrow   bool
+	ToGroup   bool
+	Label     string
+}
+
+// NewEdge creates a new edge from a side of a node to a side of another node
+func NewEdge(from Node, fromSide edgeSide, to Node, toSide edgeSide) *Edge {
+	return &Edge{
+		From:     from,
+		FromSide: fromSide,
+		To:       to,
+		ToSide:   toSide,
+	}
+}
+
+// SetArrows sets whether arrowheads are drawn at the from and to ends and returns the edge for chaining
+func (e *Edge) SetArrows(from bool, to bool) *Edge {
+	e.FromArrow = from
+	e.ToArrow = to
+	return e
+}
+
+// SetGroups sets whether the from and to ends leave the boundary of the node's group and returns the edge for chaining
+func (e *Edge) SetGroups(from bool, to bool) *Edge {
+	e.FromGroup = from
+	e.ToGroup = to
+	return e
+}
+
+// SetLabel sets the edge label and returns the edge for chaining
+func (e *Edge) SetLabel(label string) *Edge {
+	e.Label = label
+	return e
+}
+
+// String generates the Mermaid syntax for the edge
+func (e *Edge) String() string {
+	return fmt.Sprintf(baseEdge, formatEnd(e.From, e.FromGroup), e.FromSide, e.arrow(), e.ToSide, formatEnd(e.To, e.ToGroup))
+}
+
+// arrow returns the line between the two sides, e.g. "<-[label]->".
+func (e *Edge) arrow() string {
+	var sb strings.Builder
+	if e.FromArrow {
+		sb.WriteString("<")
+	}
+	if e.Label != "" {
+		sb.WriteString("-[" + e.Label + "]-")
+	} else {
+		sb.WriteString("--")
+	}
+	if e.ToArrow {
+		sb.WriteString(">")
+	}
+
+	return sb.String()
+}
+
+func formatEnd(node Node, group bool) string {
+	if group {
+		return node.nodeID() + baseEdgeGroup
+	}
+
+	return node.nodeID()
+}
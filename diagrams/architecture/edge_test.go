@@ -0,0 +1,76 @@
+package architecture
+
+import (
+	"testing"
+)
+
+func TestNewEdge(t *testing.T) {
+	db := NewService("db", "", "")
+	hub := NewJunction("hub")
+	got := NewEdge(db, SideRight, hub, SideLeft)
+
+	if got.From != db || got.FromSide != SideRight || got.To != hub || got.ToSide != SideLeft {
+		t.Errorf("NewEdge() = %+v, want db:R to L:hub", got)
+	}
+
+	if got.FromArrow || got.ToArrow || got.FromGroup || got.ToGroup || got.Label != "" {
+		t.Errorf("NewEdge() = %+v, want plain edge", got)
+	}
+}
+
+func TestEdge_Setters(t *testing.T) {
+	edge := NewEdge(NewService("a", "", ""), SideLeft, NewService("b", "", ""), SideRight)
+
+	if got := edge.SetArrows(true, false).SetGroups(false, true).SetLabel("sync"); got != edge {
+		t.Fatal("Setters should return the edge for chaining")
+	}
+
+	if !edge.FromArrow || edge.ToArrow || edge.FromGroup || !edge.ToGroup || edge.Label != "sync" {
+		t.Errorf("Setters = %+v, want from arrow, to group and label set", edge)
+	}
+}
+
+func TestEdge_String(t *testing.T) {
+	db := NewService("db", "", "")
+	server := NewService("server", "", "")
+
+	tests := []struct {
+		name string
+		edge *Edge
+		want string
+	}{
+		{
+			name: "Plain edge",
+			edge: NewEdge(db, SideLeft, server, SideRight),
+			want: "    db:L -- R:server\n",
+		},
+		{
+			name: "Edge with arrowheads at both ends",
+			edge: NewEdge(db, SideTop, server, SideBottom).SetArrows(true, true),
+			want: "    db:T <--> B:server\n",
+		},
+		{
+			name: "Edge with arrowhead at the start",
+			edge: NewEdge(db, SideTop, server, SideBottom).SetArrows(true, false),
+			want: "    db:T <-- B:server\n",
+		},
+		{
+			name: "Edge between group boundaries",
+			edge: NewEdge(db, SideBottom, server, SideTop).SetArrows(false, true).SetGroups(true, true),
+			want: "    db{group}:B --> T:server{group}\n",
+		},
+		{
+			name: "Edge with label",
+			edge: NewEdge(db, SideRight, server, SideLeft).SetArrows(false, true).SetLabel("reads from"),
+			want: "    db:R -[reads from]-> L:server\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.edge.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
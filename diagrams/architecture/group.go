@@ -0,0 +1,53 @@
+package architecture
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for groups
+const (
+	baseGroup string = basediagram.Indentation + "group %s%s\n"
+)
+
+// Group represents a boundary drawn around services, junctions and other
+// groups, which are placed in it by setting their parent.
+type Group struct {
+	ID     string
+	Icon   string
+	Title  string
+	Parent *Group
+}
+
+// NewGroup creates a new group with the given ID, icon and title
+func NewGroup(id string, icon string, title string) *Group {
+	return &Group{
+		ID:    id,
+		Icon:  icon,
+		Title: title,
+	}
+}
+
+// SetIcon sets the group icon and returns the group for chaining
+func (g *Group) SetIcon(icon string) *Group {
+	g.Icon = icon
+	return g
+}
+
+// SetTitle sets the group title and returns the group for chaining
+func (g *Group) SetTitle(title string) *Group {
+	g.Title = title
+	return g
+}
+
+// SetParent nests the group in parent and returns the group for chaining
+func (g *Group) SetParent(parent *Group) *Group {
+	g.Parent = parent
+	return g
+}
+
+// String generates the Mermaid syntax for the group
+func (g *Group) String() string {
+	return fmt.Sprintf(baseGroup, g.ID, formatDeclaration(g.Icon, g.Title, g.Parent))
+}
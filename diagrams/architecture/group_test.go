@@ -0,0 +1,60 @@
+package architecture
+
+import (
+	"testing"
+)
+
+func TestNewGroup(t *testing.T) {
+	got := NewGroup("api", IconCloud, "API")
+
+	if got.ID != "api" || got.Icon != IconCloud || got.Title != "API" || got.Parent != nil {
+		t.Errorf("NewGroup() = %+v, want top-level group api", got)
+	}
+}
+
+func TestGroup_Setters(t *testing.T) {
+	parent := NewGroup("vpc", "", "")
+	group := NewGroup("api", "", "")
+
+	if got := group.SetIcon(IconCloud).SetTitle("API").SetParent(parent); got != group {
+		t.Fatal("Setters should return the group for chaining")
+	}
+
+	if group.Icon != IconCloud || group.Title != "API" || group.Parent != parent {
+		t.Errorf("Setters = %+v, want icon, title and parent set", group)
+	}
+}
+
+func TestGroup_String(t *testing.T) {
+	parent := NewGroup("vpc", "", "")
+
+	tests := []struct {
+		name  string
+		group *Group
+		want  string
+	}{
+		{
+			name:  "Bare group",
+			group: NewGroup("api", "", ""),
+			want:  "    group api\n",
+		},
+		{
+			name:  "Group with icon and title",
+			group: NewGroup("api", IconCloud, "Public API"),
+			want:  "    group api(cloud)[Public API]\n",
+		},
+		{
+			name:  "Nested group",
+			group: NewGroup("subnet", "logos:aws-vpc", "Subnet").SetParent(parent),
+			want:  "    group subnet(logos:aws-vpc)[Subnet] in vpc\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.group.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package architecture
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for junctions
+const (
+	baseJunction string = basediagram.Indentation + "junction %s%s\n"
+)
+
+// Junction represents an invisible point where edges split or merge.
+type Junction struct {
+	ID     string
+	Parent *Group
+}
+
+// NewJunction creates a new junction with the given ID
+func NewJunction(id string) *Junction {
+	return &Junction{
+		ID: id,
+	}
+}
+
+// SetParent places the junction in parent and returns the junction for chaining
+func (j *Junction) SetParent(parent *Group) *Junction {
+	j.Parent = parent
+	return j
+}
+
+// String generates the Mermaid syntax for the junction
+func (j *Junction) String() string {
+	return fmt.Sprintf(baseJunction, j.ID, formatDeclaration("", "", j.Parent))
+}
+
+func (j *Junction) nodeID() string {
+	return j.ID
+}
+
+func (j *Junction) group() *Group {
+	return j.Parent
+}
@@ -0,0 +1,53 @@
+package architecture
+
+import (
+	"testing"
+)
+
+func TestNewJunction(t *testing.T) {
+	got := NewJunction("hub")
+
+	if got.ID != "hub" || got.Parent != nil {
+		t.Errorf("NewJunction() = %+v, want top-level junction hub", got)
+	}
+}
+
+func TestJunction_SetParent(t *testing.T) {
+	parent := NewGroup("api", "", "")
+	junction := NewJunction("hub")
+
+	if got := junction.SetParent(parent); got != junction {
+		t.Fatal("SetParent() should return the junction for chaining")
+	}
+
+	if junction.nodeID() != "hub" || junction.group() != parent {
+		t.Errorf("junction node = %q in %v, want hub in api", junction.nodeID(), junction.group())
+	}
+}
+
+func TestJunction_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		junction *Junction
+		want     string
+	}{
+		{
+			name:     "Top-level junction",
+			junction: NewJunction("hub"),
+			want:     "    junction hub\n",
+		},
+		{
+			name:     "Junction in a group",
+			junction: NewJunction("hub").SetParent(NewGroup("api", "", "")),
+			want:     "    junction hub in api\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.junction.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
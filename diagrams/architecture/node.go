@@ -0,0 +1,48 @@
+package architecture
+
+// List of icons built into Mermaid. Any other icon is written as
+// "pack:name", e.g. "logos:aws-lambda", and must come from an icon pack
+// registered with Mermaid.
+// Reference: https://mermaid.js.org/syntax/architecture.html#icons
+const (
+	IconCloud    string = "cloud"
+	IconDatabase string = "database"
+	IconDisk     string = "disk"
+	IconInternet string = "internet"
+	IconServer   string = "server"
+)
+
+// Node is a service or a junction, the two kinds of shapes that edges connect.
+type Node interface {
+	nodeID() string
+	group() *Group
+}
+
+// formatDeclaration returns the icon, title and parent group of a declaration
+// as written after its ID.
+func formatDeclaration(icon string, title string, parent *Group) string {
+	text := ""
+	if icon != "" {
+		text += "(" + icon + ")"
+	}
+	if title != "" {
+		text += "[" + title + "]"
+	}
+	if parent != nil {
+		text += " in " + parent.ID
+	}
+
+	return text
+}
+
+// isNil reports whether node is nil or holds a nil service or junction.
+func isNil(node Node) bool {
+	switch n := node.(type) {
+	case *Service:
+		return n == nil
+	case *Junction:
+		return n == nil
+	}
+
+	return node == nil
+}
@@ -0,0 +1,72 @@
+package architecture
+
+import (
+	"testing"
+)
+
+func TestFormatDeclaration(t *testing.T) {
+	parent := NewGroup("api", "", "")
+
+	tests := []struct {
+		name   string
+		icon   string
+		title  string
+		parent *Group
+		want   string
+	}{
+		{
+			name: "Nothing after the ID",
+			want: "",
+		},
+		{
+			name: "Icon only",
+			icon: IconCloud,
+			want: "(cloud)",
+		},
+		{
+			name:  "Title only",
+			title: "Public API",
+			want:  "[Public API]",
+		},
+		{
+			name:   "Icon, title and parent",
+			icon:   "logos:aws-lambda",
+			title:  "Handler",
+			parent: parent,
+			want:   "(logos:aws-lambda)[Handler] in api",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatDeclaration(tt.icon, tt.title, tt.parent); got != tt.want {
+				t.Errorf("formatDeclaration() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNil(t *testing.T) {
+	var service *Service
+	var junction *Junction
+
+	tests := []struct {
+		name string
+		node Node
+		want bool
+	}{
+		{name: "Nil interface", node: nil, want: true},
+		{name: "Nil service", node: service, want: true},
+		{name: "Nil junction", node: junction, want: true},
+		{name: "Service", node: NewService("db", "", ""), want: false},
+		{name: "Junction", node: NewJunction("hub"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isNil(tt.node); got != tt.want {
+				t.Errorf("isNil() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
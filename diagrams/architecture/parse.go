@@ -0,0 +1,260 @@
+package architecture
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// reference is an ID whose group or node is looked up once the whole
+// diagram is read, since shapes may be declared after their use.
+type reference struct {
+	set  func(id string) bool
+	id   string
+	line parser.Line
+	pos  int
+	kind string
+}
+
+type architectureParser struct {
+	diagram    *Diagram
+	groups     map[string]*Group
+	nodes      map[string]Node
+	references []reference
+}
+
+// Parse reads Mermaid architecture diagram syntax and returns the
+// corresponding Diagram. It understands the syntax generated by
+// Diagram.String, with declarations and edges in any order. Services
+// described by a text instead of an icon are not supported by the model
+// and are reported as errors.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &architectureParser{
+		diagram: NewDiagram(),
+		groups:  make(map[string]*Group),
+		nodes:   make(map[string]Node),
+	}
+	p.diagram.Title = doc.Title
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, architectureConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, ref := range p.references {
+		if !ref.set(ref.id) {
+			return nil, ref.line.Errorf(ref.pos, "unknown %s %q", ref.kind, ref.id)
+		}
+	}
+
+	return p.diagram, nil
+}
+
+func (p *architectureParser) parseLine(line parser.Line) error {
+	keyword, rest, _ := strings.Cut(line.Text, " ")
+	switch keyword {
+	case "group", "service", "junction":
+		if strings.TrimSpace(rest) != "" {
+			return p.parseDeclaration(line, keyword)
+		}
+	}
+
+	return p.parseEdge(line)
+}
+
+// parseDeclaration reads `group id(icon)[title] in parent` and its service
+// and junction counterparts, where the icon, title and parent are optional.
+func (p *architectureParser) parseDeclaration(line parser.Line, keyword string) error {
+	s := parser.NewScanner(line)
+	s.Advance(len(keyword))
+	s.SkipSpaces()
+
+	idPos := s.Pos()
+	id := s.ReadWhile(parser.IsIdentifier)
+	if id == "" {
+		return s.Errorf("expected ID")
+	}
+	if _, ok := p.groups[id]; ok {
+		return s.ErrorAt(idPos, "ID %q is already used", id)
+	}
+	if _, ok := p.nodes[id]; ok {
+		return s.ErrorAt(idPos, "ID %q is already used", id)
+	}
+
+	var icon, title string
+	if keyword != "junction" {
+		if s.Consume("(") {
+			if s.HasPrefix(`"`) {
+				return s.Errorf("unsupported text icon")
+			}
+			text, ok := s.ReadUntil(")")
+			if !ok {
+				return s.Errorf("expected ')'")
+			}
+			icon = text
+		}
+		if s.Consume("[") {
+			text, ok := s.ReadUntil("]")
+			if !ok {
+				return s.Errorf("expected ']'")
+			}
+			title = text
+		}
+	}
+
+	var setParent func(parent *Group)
+	switch keyword {
+	case "group":
+		group := p.diagram.AddGroup(id, icon, title)
+		p.groups[id] = group
+		setParent = func(parent *Group) { group.Parent = parent }
+	case "service":
+		service := p.diagram.AddService(id, icon, title)
+		p.nodes[id] = service
+		setParent = func(parent *Group) { service.Parent = parent }
+	default:
+		junction := p.diagram.AddJunction(id)
+		p.nodes[id] = junction
+		setParent = func(parent *Group) { junction.Parent = parent }
+	}
+
+	s.SkipSpaces()
+	if s.EOF() {
+		return nil
+	}
+	if !s.Consume("in ") {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+	s.SkipSpaces()
+
+	parentPos := s.Pos()
+	parent := s.ReadWhile(parser.IsIdentifier)
+	if parent == "" {
+		return s.Errorf("expected group ID")
+	}
+	if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	p.references = append(p.references, reference{
+		set: func(id string) bool {
+			group, ok := p.groups[id]
+			setParent(group)
+			return ok
+		},
+		id:   parent,
+		line: line,
+		pos:  parentPos,
+		kind: "group",
+	})
+
+	return nil
+}
+
+// parseEdge reads `from{group}:R <-[label]-> L:to{group}`, where the
+// group modifiers, arrowheads and label are optional.
+func (p *architectureParser) parseEdge(line parser.Line) error {
+	s := parser.NewScanner(line)
+	edge := p.diagram.AddEdge(nil, "", nil, "")
+
+	fromPos := s.Pos()
+	from := s.ReadWhile(parser.IsIdentifier)
+	if from == "" {
+		return s.Errorf("expected statement")
+	}
+	edge.FromGroup = s.Consume(baseEdgeGroup)
+	if !s.Consume(":") {
+		return s.Errorf("expected ':'")
+	}
+	side, err := readSide(s)
+	if err != nil {
+		return err
+	}
+	edge.FromSide = side
+	s.SkipSpaces()
+
+	edge.FromArrow = s.Consume("<")
+	switch {
+	case s.Consume("--"):
+	case s.Consume("-["):
+		label, ok := s.ReadUntil("]")
+		if !ok {
+			return s.Errorf("expected ']'")
+		}
+		if !s.Consume("-") {
+			return s.Errorf("expected '-'")
+		}
+		edge.Label = label
+	default:
+		return s.Errorf("expected '--'")
+	}
+	edge.ToArrow = s.Consume(">")
+	s.SkipSpaces()
+
+	side, err = readSide(s)
+	if err != nil {
+		return err
+	}
+	edge.ToSide = side
+	if !s.Consume(":") {
+		return s.Errorf("expected ':'")
+	}
+
+	toPos := s.Pos()
+	to := s.ReadWhile(parser.IsIdentifier)
+	if to == "" {
+		return s.Errorf("expected ID")
+	}
+	edge.ToGroup = s.Consume(baseEdgeGroup)
+	if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	p.references = append(p.references,
+		reference{
+			set:  func(id string) bool { edge.From = p.nodes[id]; return edge.From != nil },
+			id:   from,
+			line: line,
+			pos:  fromPos,
+			kind: "node",
+		},
+		reference{
+			set:  func(id string) bool { edge.To = p.nodes[id]; return edge.To != nil },
+			id:   to,
+			line: line,
+			pos:  toPos,
+			kind: "node",
+		})
+
+	return nil
+}
+
+// readSide reads one of the L, R, T and B sides.
+func readSide(s *parser.Scanner) (edgeSide, error) {
+	for _, side := range []edgeSide{SideLeft, SideRight, SideTop, SideBottom} {
+		if s.Consume(string(side)) {
+			return side, nil
+		}
+	}
+
+	return "", s.Errorf("expected side L, R, T or B")
+}
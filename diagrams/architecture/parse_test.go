@@ -0,0 +1,214 @@
+package architecture
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Diagram with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Platform"
+				d.Config.SetIconSize(60).SetPadding(20)
+				d.EnableMarkdownFence()
+				d.AddService("db", IconDatabase, "Database")
+			},
+		},
+		{
+			name: "Diagram with nested groups, junctions and edges",
+			setup: func(d *Diagram) {
+				api := d.AddGroup("api", IconCloud, "API")
+				private := d.AddGroup("private", "", "Private").SetParent(api)
+				d.AddGroup("empty", "", "")
+				db := d.AddService("db", IconDatabase, "Database").SetParent(private)
+				fn := d.AddService("fn", "logos:aws-lambda", "").SetParent(api)
+				server := d.AddService("server", "", "Server")
+				hub := d.AddJunction("hub").SetParent(api)
+				d.AddEdge(db, SideLeft, fn, SideRight)
+				d.AddEdge(fn, SideBottom, hub, SideTop).SetArrows(true, true)
+				d.AddEdge(hub, SideRight, server, SideLeft).SetArrows(false, true).SetLabel("calls out")
+				d.AddEdge(db, SideTop, server, SideBottom).SetArrows(true, false).SetGroups(true, false)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `architecture-beta
+    db:L -- R:server
+    group api(cloud)[API] in edge
+
+    service db(database)[Database] in api
+    service server(server)[Server] in api
+    group edge
+    junction j1
+    server{group}:B-->T:j1
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if len(d.Groups) != 2 || d.Groups[0].Parent != d.Groups[1] {
+		t.Fatalf("Parse() groups = %+v, want api in edge", d.Groups)
+	}
+
+	if len(d.Services) != 2 || d.Services[0].Parent != d.Groups[0] || d.Services[1].Parent != d.Groups[0] {
+		t.Fatalf("Parse() services = %+v, want db and server in api", d.Services)
+	}
+
+	if len(d.Edges) != 2 {
+		t.Fatalf("Parse() edges = %+v, want 2 edges", d.Edges)
+	}
+
+	first, second := d.Edges[0], d.Edges[1]
+	if first.From != d.Services[0] || first.To != d.Services[1] || first.FromSide != SideLeft || first.ToSide != SideRight {
+		t.Errorf("Parse() first edge = %+v, want db:L -- R:server", first)
+	}
+	if second.From != d.Services[1] || second.To != d.Junctions[0] || !second.FromGroup || !second.ToArrow || second.FromArrow {
+		t.Errorf("Parse() second edge = %+v, want server{group}:B --> T:j1", second)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "service db\n",
+			line:    1,
+			column:  1,
+			message: "expected architecture-beta declaration",
+		},
+		{
+			name:    "Header with arguments",
+			input:   "architecture-beta LR\n",
+			line:    1,
+			column:  19,
+			message: `unexpected "LR"`,
+		},
+		{
+			name:    "Missing ID",
+			input:   "architecture-beta\n    service (cloud)\n",
+			line:    2,
+			column:  13,
+			message: "expected ID",
+		},
+		{
+			name:    "Duplicate ID",
+			input:   "architecture-beta\n    group db\n    service db\n",
+			line:    3,
+			column:  13,
+			message: `ID "db" is already used`,
+		},
+		{
+			name:    "Unterminated icon",
+			input:   "architecture-beta\n    service db(database\n",
+			line:    2,
+			column:  16,
+			message: "expected ')'",
+		},
+		{
+			name:    "Text icon",
+			input:   "architecture-beta\n    service db(\"DB\")[Database]\n",
+			line:    2,
+			column:  16,
+			message: "unsupported text icon",
+		},
+		{
+			name:    "Text after the title",
+			input:   "architecture-beta\n    service db[Database] on api\n",
+			line:    2,
+			column:  26,
+			message: `unexpected "on api"`,
+		},
+		{
+			name:    "Unknown group",
+			input:   "architecture-beta\n    junction hub in api\n",
+			line:    2,
+			column:  21,
+			message: `unknown group "api"`,
+		},
+		{
+			name:    "Missing side",
+			input:   "architecture-beta\n    service a\n    service b\n    a -- R:b\n",
+			line:    4,
+			column:  6,
+			message: "expected ':'",
+		},
+		{
+			name:    "Unknown side",
+			input:   "architecture-beta\n    service a\n    service b\n    a:X -- R:b\n",
+			line:    4,
+			column:  7,
+			message: "expected side L, R, T or B",
+		},
+		{
+			name:    "Missing line",
+			input:   "architecture-beta\n    service a\n    service b\n    a:L > R:b\n",
+			line:    4,
+			column:  9,
+			message: "expected '--'",
+		},
+		{
+			name:    "Unknown node",
+			input:   "architecture-beta\n    service a\n    a:L --> R:b\n",
+			line:    3,
+			column:  15,
+			message: `unknown node "b"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package architecture
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for services
+const (
+	baseService string = basediagram.Indentation + "service %s%s\n"
+)
+
+// Service represents a component of the architecture drawn as an icon.
+type Service struct {
+	ID     string
+	Icon   string
+	Title  string
+	Parent *Group
+}
+
+// NewService creates a new service with the given ID, icon and title
+func NewService(id string, icon string, title string) *Service {
+	return &Service{
+		ID:    id,
+		Icon:  icon,
+		Title: title,
+	}
+}
+
+// SetIcon sets the service icon and returns the service for chaining
+func (s *Service) SetIcon(icon string) *Service {
+	s.Icon = icon
+	return s
+}
+
+// SetTitle sets the service title and returns the service for chaining
+func (s *Service) SetTitle(title string) *Service {
+	s.Title = title
+	return s
+}
+
+// SetParent places the service in parent and returns the service for chaining
+func (s *Service) SetParent(parent *Group) *Service {
+	s.Parent = parent
+	return s
+}
+
+// String generates the Mermaid syntax for the service
+func (s *Service) String() string {
+	return fmt.Sprintf(baseService, s.ID, formatDeclaration(s.Icon, s.Title, s.Parent))
+}
+
+func (s *Service) nodeID() string {
+	return s.ID
+}
+
+func (s *Service) group() *Group {
+	return s.Parent
+}
@@ -0,0 +1,64 @@
+package architecture
+
+import (
+	"testing"
+)
+
+func TestNewService(t *testing.T) {
+	got := NewService("db", IconDatabase, "Database")
+
+	if got.ID != "db" || got.Icon != IconDatabase || got.Title != "Database" || got.Parent != nil {
+		t.Errorf("NewService() = %+v, want top-level service db", got)
+	}
+}
+
+func TestService_Setters(t *testing.T) {
+	parent := NewGroup("api", "", "")
+	service := NewService("db", "", "")
+
+	if got := service.SetIcon(IconDisk).SetTitle("Storage").SetParent(parent); got != service {
+		t.Fatal("Setters should return the service for chaining")
+	}
+
+	if service.Icon != IconDisk || service.Title != "Storage" || service.Parent != parent {
+		t.Errorf("Setters = %+v, want icon, title and parent set", service)
+	}
+
+	if service.nodeID() != "db" || service.group() != parent {
+		t.Errorf("service node = %q in %v, want db in api", service.nodeID(), service.group())
+	}
+}
+
+func TestService_String(t *testing.T) {
+	parent := NewGroup("api", "", "")
+
+	tests := []struct {
+		name    string
+		service *Service
+		want    string
+	}{
+		{
+			name:    "Bare service",
+			service: NewService("db", "", ""),
+			want:    "    service db\n",
+		},
+		{
+			name:    "Service with built-in icon",
+			service: NewService("db", IconDatabase, "Database"),
+			want:    "    service db(database)[Database]\n",
+		},
+		{
+			name:    "Service with icon from a pack in a group",
+			service: NewService("fn", "logos:aws-lambda", "Handler").SetParent(parent),
+			want:    "    service fn(logos:aws-lambda)[Handler] in api\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.service.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package architecture
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// missing or duplicate IDs, which groups, services and junctions share, IDs,
+// icons, titles and labels holding characters Mermaid does not accept, unknown
+// built-in icons, parents that were never added to the diagram, groups nested
+// in themselves, and edges between nodes that are missing or were never added,
+// with unknown sides or leaving the group of a node that is not in one.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	groups := make(map[*Group]bool, len(d.Groups))
+	for _, group := range d.Groups {
+		if group != nil {
+			groups[group] = true
+		}
+	}
+
+	for i, group := range d.Groups {
+		path := fmt.Sprintf("Groups[%d]", i)
+		if group == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing group")
+			continue
+		}
+
+		validateID(&v, path+".ID", group.ID)
+		validateIcon(&v, path+".Icon", group.Icon)
+		validateText(&v, path+".Title", "title", group.Title)
+		validateParent(&v, path+".Parent", group.Parent, groups)
+
+		if nestedIn(group, group.Parent, len(groups)) {
+			v.Error(basediagram.CodeInvalidValue, path+".Parent", "group %q is nested in itself", group.ID)
+		}
+	}
+
+	nodes := make(map[Node]bool, len(d.Services)+len(d.Junctions))
+	for i, service := range d.Services {
+		path := fmt.Sprintf("Services[%d]", i)
+		if service == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing service")
+			continue
+		}
+
+		validateID(&v, path+".ID", service.ID)
+		validateIcon(&v, path+".Icon", service.Icon)
+		validateText(&v, path+".Title", "title", service.Title)
+		validateParent(&v, path+".Parent", service.Parent, groups)
+		nodes[service] = true
+	}
+
+	for i, junction := range d.Junctions {
+		path := fmt.Sprintf("Junctions[%d]", i)
+		if junction == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing junction")
+			continue
+		}
+
+		validateID(&v, path+".ID", junction.ID)
+		validateParent(&v, path+".Parent", junction.Parent, groups)
+		nodes[junction] = true
+	}
+
+	for i, edge := range d.Edges {
+		path := fmt.Sprintf("Edges[%d]", i)
+		if edge == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing edge")
+			continue
+		}
+
+		validateEnd(&v, path+".From", edge.From, edge.FromSide, edge.FromGroup, nodes)
+		validateEnd(&v, path+".To", edge.To, edge.ToSide, edge.ToGroup, nodes)
+		validateText(&v, path+".Label", "label", edge.Label)
+	}
+
+	return v.Errors()
+}
+
+// validateID checks that id is unique and a single word.
+func validateID(v *basediagram.Validator, path string, id string) {
+	v.UniqueID(path, id)
+
+	if strings.IndexFunc(id, isNotIdentifier) >= 0 {
+		v.Error(basediagram.CodeInvalidValue, path, "ID %q may only hold letters, digits and underscores", id)
+	}
+}
+
+// validateIcon checks that icon is a built-in icon or names an icon of a pack.
+func validateIcon(v *basediagram.Validator, path string, icon string) {
+	if strings.IndexFunc(icon, isNotIconRune) >= 0 {
+		v.Error(basediagram.CodeInvalidValue, path, "icon %q may only hold letters, digits, underscores, dashes and colons", icon)
+		return
+	}
+
+	switch icon {
+	case "", IconCloud, IconDatabase, IconDisk, IconInternet, IconServer:
+	default:
+		if !strings.Contains(icon, ":") {
+			v.Warning(basediagram.CodeInvalidValue, path, "unknown icon %q", icon)
+		}
+	}
+}
+
+// validateText checks that a title or label only holds words and spaces,
+// which are the only characters Mermaid reads between its brackets.
+func validateText(v *basediagram.Validator, path string, name string, text string) {
+	if strings.IndexFunc(text, isNotTextRune) >= 0 {
+		v.Error(basediagram.CodeInvalidValue, path, "%s %q may only hold letters, digits, underscores and spaces", name, text)
+	}
+}
+
+// nestedIn reports whether group is found among parent and its ancestors.
+// At most depth ancestors are followed, so that cycles elsewhere in the
+// chain end the search.
+func nestedIn(group *Group, parent *Group, depth int) bool {
+	for i := 0; parent != nil && i < depth; i++ {
+		if parent == group {
+			return true
+		}
+		parent = parent.Parent
+	}
+
+	return false
+}
+
+func validateParent(v *basediagram.Validator, path string, parent *Group, groups map[*Group]bool) {
+	if parent != nil && !groups[parent] {
+		v.Error(basediagram.CodeUnknownReference, path, "group %q is not part of the diagram", parent.ID)
+	}
+}
+
+func validateEnd(v *basediagram.Validator, path string, node Node, side edgeSide, group bool, nodes map[Node]bool) {
+	switch side {
+	case SideLeft, SideRight, SideTop, SideBottom:
+	default:
+		v.Error(basediagram.CodeInvalidValue, path+"Side", "unknown side %q", side)
+	}
+
+	switch {
+	case isNil(node):
+		v.Error(basediagram.CodeMissingReference, path, "missing node")
+	case !nodes[node]:
+		v.Error(basediagram.CodeUnknownReference, path, "node %q is not part of the diagram", node.nodeID())
+	case group && node.group() == nil:
+		v.Error(basediagram.CodeInvalidValue, path+"Group", "node %q is not in a group", node.nodeID())
+	}
+}
+
+func isNotIdentifier(r rune) bool {
+	return !parser.IsIdentifier(r)
+}
+
+func isNotIconRune(r rune) bool {
+	return !parser.IsIdentifier(r) && r != '-' && r != ':'
+}
+
+func isNotTextRune(r rune) bool {
+	return !parser.IsIdentifier(r) && r != ' '
+}
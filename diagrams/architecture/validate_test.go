@@ -0,0 +1,101 @@
+package architecture
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				api := d.AddGroup("api", IconCloud, "API")
+				private := d.AddGroup("private", "", "Private 2").SetParent(api)
+				db := d.AddService("db", IconDatabase, "Database").SetParent(private)
+				fn := d.AddService("fn", "logos:aws-lambda", "").SetParent(api)
+				hub := d.AddJunction("hub")
+				d.AddEdge(db, SideLeft, fn, SideRight).SetGroups(true, true).SetLabel("reads_from")
+				d.AddEdge(fn, SideBottom, hub, SideTop).SetArrows(true, true)
+			},
+		},
+		{
+			name: "Invalid declarations",
+			setup: func(d *Diagram) {
+				api := d.AddGroup("api", "cloud-ish", "API (public)")
+				d.AddGroup("api", "", "")
+				d.Groups = append(d.Groups, nil)
+				d.AddService("", "bad icon", "")
+				d.AddService("web-1", "", "").SetParent(NewGroup("other", "", ""))
+				d.AddJunction("api").SetParent(api)
+				d.Junctions = append(d.Junctions, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityWarning, Path: "Groups[0].Icon", Message: `unknown icon "cloud-ish"`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Groups[0].Title", Message: `title "API (public)" may only hold letters, digits, underscores and spaces`},
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Groups[1].ID", Message: `ID "api" is already used by Groups[0].ID`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Groups[2]", Message: "missing group"},
+				{Code: basediagram.CodeEmptyID, Severity: basediagram.SeverityError, Path: "Services[0].ID", Message: "missing ID"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Services[0].Icon", Message: `icon "bad icon" may only hold letters, digits, underscores, dashes and colons`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Services[1].ID", Message: `ID "web-1" may only hold letters, digits and underscores`},
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "Services[1].Parent", Message: `group "other" is not part of the diagram`},
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Junctions[0].ID", Message: `ID "api" is already used by Groups[0].ID`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Junctions[1]", Message: "missing junction"},
+			},
+		},
+		{
+			name: "Nested groups",
+			setup: func(d *Diagram) {
+				a := d.AddGroup("a", "", "")
+				b := d.AddGroup("b", "", "").SetParent(a)
+				c := d.AddGroup("c", "", "").SetParent(b)
+				b.SetParent(c)
+				d.AddGroup("self", "", "").SetParent(d.Groups[3])
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Groups[1].Parent", Message: `group "b" is nested in itself`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Groups[2].Parent", Message: `group "c" is nested in itself`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Groups[3].Parent", Message: `group "self" is nested in itself`},
+			},
+		},
+		{
+			name: "Invalid edges",
+			setup: func(d *Diagram) {
+				db := d.AddService("db", "", "")
+				hub := d.AddJunction("hub")
+				d.AddEdge(db, "X", NewService("other", "", ""), SideLeft)
+				d.AddEdge(nil, SideLeft, hub, SideRight).SetGroups(false, true).SetLabel("a:b")
+				d.Edges = append(d.Edges, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Edges[0].FromSide", Message: `unknown side "X"`},
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "Edges[0].To", Message: `node "other" is not part of the diagram`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Edges[1].From", Message: "missing node"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Edges[1].ToGroup", Message: `node "hub" is not in a group`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Edges[1].Label", Message: `label "a:b" may only hold letters, digits, underscores and spaces`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Edges[2]", Message: "missing edge"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -147,7 +147,7 @@ func (b *Block) String() string {
 				if child.isArrow {
 					sb.WriteString(fmt.Sprintf(tplChildBlock, child.ID, BlockArrowShape(child.Text, child.direction...)))
 				} else {
-					sb.WriteString(fmt.Sprintf(tplChildBlock, child.ID, fmt.Sprintf(string(child.Shape), child.Text)))
+					sb.WriteString(fmt.Sprintf(tplChildBlock, child.ID, fmt.Sprintf(string(child.Shape), basediagram.Escape(child.Text))))
 				}
 			} else {
 				sb.WriteString(fmt.Sprintf(tplChildSimple, child.ID))
@@ -164,9 +164,9 @@ func (b *Block) String() string {
 				}
 			} else {
 				if b.Width > 1 {
-					sb.WriteString(fmt.Sprintf(tplBlockWidth, b.ID, fmt.Sprintf(string(b.Shape), b.Text), b.Width))
+					sb.WriteString(fmt.Sprintf(tplBlockWidth, b.ID, fmt.Sprintf(string(b.Shape), basediagram.Escape(b.Text)), b.Width))
 				} else {
-					sb.WriteString(fmt.Sprintf(tplBlockNoWidth, b.ID, fmt.Sprintf(string(b.Shape), b.Text)))
+					sb.WriteString(fmt.Sprintf(tplBlockNoWidth, b.ID, fmt.Sprintf(string(b.Shape), basediagram.Escape(b.Text))))
 				}
 			}
 		} else {
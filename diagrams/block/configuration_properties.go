@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	baseBlockConfigurationProperties string = "block:\n"
+	blockConfigurationSection        string = "block"
+	baseBlockConfigurationProperties string = basediagram.Indentation + blockConfigurationSection + ":\n"
 	blockPropertyPadding             string = "padding"
 )
 
@@ -40,9 +41,7 @@ func (c BlockConfigurationProperties) String() string {
 
 	if len(c.properties) > 0 {
 		sb.WriteString(baseBlockConfigurationProperties)
-		for _, prop := range c.properties {
-			sb.WriteString(prop.Format())
-		}
+		sb.WriteString(basediagram.FormatProperties(c.properties))
 	}
 
 	return sb.String()
@@ -2,7 +2,7 @@
 package block
 
 import (
-	"fmt"
+	"io"
 	"strings"
 
 	"github.com/TyphonHill/go-mermaid/diagrams/utils"
@@ -14,7 +14,8 @@ var idGenerator = utils.NewIDGenerator()
 
 // Mermaid diagram syntax templates
 const (
-	baseDiagramType = "block-beta\n"
+	diagramType     = "block-beta"
+	baseDiagramType = diagramType + "\n"
 	tplDiagramCols  = basediagram.Indentation + "columns %d\n"
 )
 
@@ -82,25 +83,35 @@ func (d *Diagram) AddLink(from, to *Block) *Link {
 // String returns the Mermaid syntax representation of this diagram
 func (d *Diagram) String() string {
 	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
 
-	sb.WriteString(baseDiagramType)
+// DiagramType returns the Mermaid keyword that introduces a block diagram.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
 
-	if d.Columns > 0 {
-		sb.WriteString(fmt.Sprintf(tplDiagramCols, d.Columns))
-	}
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
 
-	for _, block := range d.Blocks {
-		sb.WriteString(block.String())
-	}
+// WriteTo streams the diagram to w one element at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
 
-	for _, link := range d.Links {
-		sb.WriteString(link.String())
-	}
+		if d.Columns > 0 {
+			w.Printf(tplDiagramCols, d.Columns)
+		}
 
-	return d.BaseDiagram.String(sb.String())
-}
+		for _, block := range d.Blocks {
+			w.WriteString(block.String())
+		}
 
-// RenderToFile saves the diagram to a file at the specified path
-func (d *Diagram) RenderToFile(path string) error {
-	return utils.RenderToFile(path, d.String())
+		for _, link := range d.Links {
+			w.WriteString(link.String())
+		}
+	})
 }
@@ -36,7 +36,7 @@ func (l *Link) SetText(text string) *Link {
 // String returns the Mermaid syntax representation of this link
 func (l *Link) String() string {
 	if l.Text != "" {
-		return fmt.Sprintf(tplLinkWithText, l.From.ID, l.Text, l.To.ID)
+		return fmt.Sprintf(tplLinkWithText, l.From.ID, basediagram.Escape(l.Text), l.To.ID)
 	}
 	return fmt.Sprintf(tplLink, l.From.ID, l.To.ID)
 }
@@ -0,0 +1,298 @@
+package block
+
+import (
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+var (
+	// linkPattern matches `A --> B` and `A -- "text" --> B`.
+	linkPattern = regexp.MustCompile(`^([\w-]+)\s*(?:--\s*"([^"]*)"\s*)?-->\s*([\w-]+)$`)
+	// arrowPattern matches block arrows such as `<["text"]>(right, down)`.
+	arrowPattern = regexp.MustCompile(`^<\["?(.*?)"?\]>\(([^)]*)\)$`)
+	// widthPattern matches the `:N` width suffix of a block.
+	widthPattern = regexp.MustCompile(`:(\d+)$`)
+)
+
+// shapeDelimiter holds the text surrounding the label of a block shape.
+type shapeDelimiter struct {
+	shape   blockShape
+	opening string
+	closing string
+}
+
+// shapeDelimiters lists the quoted and unquoted forms of every block shape,
+// longest opening first so that `((("` is tried before `(("`.
+var shapeDelimiters = func() []shapeDelimiter {
+	var delimiters []shapeDelimiter
+	for _, shape := range []blockShape{
+		BlockShapeDefault, BlockShapeRoundEdges, BlockShapeStadium, BlockShapeSubroutine,
+		BlockShapeCylindrical, BlockShapeCircle, BlockShapeAsymmetric, BlockShapeRhombus,
+		BlockShapeHexagon, BlockShapeParallelogram, BlockShapeTrapezoid, BlockShapeTrapezoidAlt,
+		BlockShapeDoubleCircle,
+	} {
+		opening, closing, _ := strings.Cut(string(shape), "%s")
+		delimiters = append(delimiters,
+			shapeDelimiter{shape: shape, opening: opening, closing: closing},
+			shapeDelimiter{shape: shape, opening: strings.TrimSuffix(opening, `"`), closing: strings.TrimPrefix(closing, `"`)},
+		)
+	}
+	sort.SliceStable(delimiters, func(i, j int) bool {
+		return len(delimiters[i].opening) > len(delimiters[j].opening)
+	})
+	return delimiters
+}()
+
+type openBlock struct {
+	block *Block
+	line  parser.Line
+}
+
+type blockParser struct {
+	diagram *Diagram
+	blocks  map[string]*Block
+	parents []openBlock
+}
+
+// Parse reads Mermaid block diagram syntax and returns the corresponding Diagram.
+// It understands the syntax generated by Diagram.String, with one block per line.
+// Blocks referenced by links before being declared are added to the diagram.
+// The package ID generator is advanced past numeric block IDs, so that blocks
+// added afterwards do not reuse them.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &blockParser{
+		diagram: NewDiagram(),
+		blocks:  make(map[string]*Block),
+	}
+	p.diagram.Title = doc.Title
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, blockConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.parents) > 0 {
+		open := p.parents[len(p.parents)-1]
+		return nil, open.line.Errorf(0, "block %q is missing its end", open.block.ID)
+	}
+
+	return p.diagram, nil
+}
+
+func (p *blockParser) parseLine(line parser.Line) error {
+	if m := linkPattern.FindStringSubmatch(line.Text); m != nil {
+		p.diagram.AddLink(p.block(m[1]), p.block(m[3])).SetText(basediagram.Unescape(m[2]))
+		return nil
+	}
+
+	s := parser.NewScanner(line)
+	keyword := s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) && r != ':' })
+
+	switch keyword {
+	case "end":
+		if !s.EOF() {
+			break
+		}
+		if len(p.parents) == 0 {
+			return line.Errorf(0, "end without block")
+		}
+		p.parents = p.parents[:len(p.parents)-1]
+		return nil
+	case "columns":
+		s.SkipSpaces()
+		columns, err := strconv.Atoi(s.Rest())
+		if err != nil {
+			return s.Errorf("invalid columns %q", s.Rest())
+		}
+		if len(p.parents) > 0 {
+			p.parents[len(p.parents)-1].block.SetColumns(columns)
+		} else {
+			p.diagram.SetColumns(columns)
+		}
+		return nil
+	case "space":
+		return p.parseSpace(s)
+	case "block":
+		if s.HasPrefix(":") {
+			return p.parseComposite(s)
+		}
+	case "style":
+		return p.parseStyle(s)
+	case "classDef", "class":
+		return line.Errorf(0, "unsupported statement %q", keyword)
+	}
+
+	return p.parseBlock(parser.NewScanner(line))
+}
+
+// parseSpace reads `space` or `space:N`.
+func (p *blockParser) parseSpace(s *parser.Scanner) error {
+	space := &Block{IsSpace: true}
+
+	if s.Consume(":") {
+		width, err := strconv.Atoi(s.Rest())
+		if err != nil {
+			return s.Errorf("invalid width %q", s.Rest())
+		}
+		space.Width = width
+	} else if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	p.add(space)
+
+	return nil
+}
+
+// parseComposite reads `block:ID` or `block:ID:N`, which opens a block holding
+// the following blocks until `end`.
+func (p *blockParser) parseComposite(s *parser.Scanner) error {
+	s.Advance(1)
+	id := s.ReadWhile(isBlockID)
+	if id == "" {
+		return s.Errorf("expected block identifier")
+	}
+
+	block := p.block(id)
+	block.Width = 0
+
+	if s.Consume(":") {
+		width, err := strconv.Atoi(s.Rest())
+		if err != nil {
+			return s.Errorf("invalid width %q", s.Rest())
+		}
+		block.Width = width
+	} else if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	p.parents = append(p.parents, openBlock{block: block, line: s.Line()})
+
+	return nil
+}
+
+// parseStyle reads `style ID css`.
+func (p *blockParser) parseStyle(s *parser.Scanner) error {
+	s.SkipSpaces()
+	id := s.ReadWhile(isBlockID)
+	if id == "" {
+		return s.Errorf("expected block identifier")
+	}
+	s.SkipSpaces()
+
+	if s.EOF() {
+		return s.Errorf("expected style")
+	}
+	p.block(id).SetStyle(s.Rest())
+
+	return nil
+}
+
+// parseBlock reads a block such as `ID`, `ID:2`, `ID["text"]` or `ID<["text"]>(right)`.
+func (p *blockParser) parseBlock(s *parser.Scanner) error {
+	id := s.ReadWhile(isBlockID)
+	if id == "" {
+		return s.Errorf("expected block identifier")
+	}
+
+	block := p.block(id)
+
+	rest := s.Rest()
+	if m := widthPattern.FindStringSubmatchIndex(rest); m != nil {
+		block.Width, _ = strconv.Atoi(rest[m[2]:m[3]])
+		rest = rest[:m[0]]
+	}
+
+	if rest == "" {
+		return nil
+	}
+
+	if m := arrowPattern.FindStringSubmatch(rest); m != nil {
+		var directions []BlockArrowDirection
+		for _, direction := range strings.Split(m[2], ",") {
+			directions = append(directions, BlockArrowDirection(strings.TrimSpace(direction)))
+		}
+		block.Text = basediagram.Unescape(m[1])
+		block.SetArrow(directions...)
+		return nil
+	}
+
+	for _, delimiter := range shapeDelimiters {
+		if len(rest) >= len(delimiter.opening)+len(delimiter.closing) &&
+			strings.HasPrefix(rest, delimiter.opening) && strings.HasSuffix(rest, delimiter.closing) {
+			block.Text = basediagram.Unescape(rest[len(delimiter.opening) : len(rest)-len(delimiter.closing)])
+			block.Shape = delimiter.shape
+			return nil
+		}
+	}
+
+	return s.Errorf("unexpected %q", rest)
+}
+
+// add appends a block to the innermost open block, or to the diagram.
+func (p *blockParser) add(block *Block) {
+	if len(p.parents) > 0 {
+		parent := p.parents[len(p.parents)-1].block
+		parent.Children = append(parent.Children, block)
+		return
+	}
+
+	block.diagram = p.diagram
+	p.diagram.Blocks = append(p.diagram.Blocks, block)
+}
+
+// block returns the block with the given ID, adding it to the current scope on first use.
+func (p *blockParser) block(id string) *Block {
+	if block, ok := p.blocks[id]; ok {
+		return block
+	}
+
+	block := NewBlock(id, "")
+	p.add(block)
+	p.blocks[id] = block
+	reserveID(id)
+
+	return block
+}
+
+// reserveID advances the package ID generator past numeric IDs.
+func reserveID(id string) {
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return
+	}
+	for {
+		if next, _ := strconv.Atoi(idGenerator.NextID()); next >= n {
+			return
+		}
+	}
+}
+
+func isBlockID(r rune) bool {
+	return r == '-' || parser.IsIdentifier(r)
+}
@@ -0,0 +1,208 @@
+package block
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Diagram with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Blocks"
+				d.Config.SetPadding(8)
+				d.Config.SetTheme("dark")
+				d.EnableMarkdownFence()
+				d.SetColumns(3)
+				d.AddBlock("Only")
+			},
+		},
+		{
+			name: "Diagram with every shape, spaces and widths",
+			setup: func(d *Diagram) {
+				for _, shape := range []blockShape{
+					BlockShapeDefault, BlockShapeRoundEdges, BlockShapeStadium, BlockShapeSubroutine,
+					BlockShapeCylindrical, BlockShapeCircle, BlockShapeAsymmetric, BlockShapeRhombus,
+					BlockShapeHexagon, BlockShapeParallelogram, BlockShapeTrapezoid, BlockShapeTrapezoidAlt,
+					BlockShapeDoubleCircle,
+				} {
+					d.AddBlock("Shape").SetShape(shape)
+				}
+				d.AddSpace()
+				d.AddSpaceWithWidth(2)
+				d.AddBlock("Wide").SetWidth(3).SetStyle("fill:#f9f")
+				d.AddBlock("").SetWidth(2)
+				d.AddBlock("Arrow").SetArrow(BlockArrowDirectionRight, BlockArrowDirectionDown)
+			},
+		},
+		{
+			name: "Diagram with text that needs escaping",
+			setup: func(d *Diagram) {
+				a := d.AddBlock(`Say "hi"`)
+				b := d.AddBlock("Go; #1").SetArrow(BlockArrowDirectionRight)
+				d.AddLink(a, b).SetText(`"next"`)
+			},
+		},
+		{
+			name: "Diagram with nested blocks and links",
+			setup: func(d *Diagram) {
+				parent := d.AddBlock("Parent").SetColumns(2)
+				child := parent.AddBlock("Child")
+				parent.AddBlock("")
+				other := d.AddBlock("Other")
+				d.AddLink(child, other)
+				d.AddLink(other, parent).SetText("back")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `block-beta
+    columns 2
+    a[Unquoted]
+    b:2
+    block:group
+        c((Circle))
+    end
+    a --> c
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if d.Columns != 2 || len(d.Blocks) != 3 {
+		t.Fatalf("Parse() = %d columns and %d blocks, want 2 and 3", d.Columns, len(d.Blocks))
+	}
+
+	if a := d.Blocks[0]; a.ID != "a" || a.Text != "Unquoted" || a.Shape != BlockShapeDefault {
+		t.Errorf("Parse() block a = %+v, want default shape with text Unquoted", a)
+	}
+	if b := d.Blocks[1]; b.ID != "b" || b.Width != 2 {
+		t.Errorf("Parse() block b = %+v, want width 2", b)
+	}
+
+	group := d.Blocks[2]
+	if group.ID != "group" || len(group.Children) != 1 || group.Children[0].Shape != BlockShapeCircle {
+		t.Fatalf("Parse() block group = %+v, want one circle child", group)
+	}
+
+	if len(d.Links) != 1 || d.Links[0].From != d.Blocks[0] || d.Links[0].To != group.Children[0] {
+		t.Errorf("Parse() links = %+v, want a --> c", d.Links)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "block\n",
+			line:    1,
+			column:  1,
+			message: "expected block-beta declaration",
+		},
+		{
+			name:    "Invalid columns",
+			input:   "block-beta\n    columns auto\n",
+			line:    2,
+			column:  13,
+			message: `invalid columns "auto"`,
+		},
+		{
+			name:    "Invalid space width",
+			input:   "block-beta\n    space:x\n",
+			line:    2,
+			column:  11,
+			message: `invalid width "x"`,
+		},
+		{
+			name:    "Unknown shape",
+			input:   "block-beta\n    a<text>\n",
+			line:    2,
+			column:  6,
+			message: `unexpected "<text>"`,
+		},
+		{
+			name:    "Several blocks on one line",
+			input:   "block-beta\n    a[A] b\n",
+			line:    2,
+			column:  6,
+			message: `unexpected "[A] b"`,
+		},
+		{
+			name:    "End without block",
+			input:   "block-beta\n    end\n",
+			line:    2,
+			column:  5,
+			message: "end without block",
+		},
+		{
+			name:    "Unterminated block",
+			input:   "block-beta\n    block:group\n    a\n",
+			line:    2,
+			column:  5,
+			message: `block "group" is missing its end`,
+		},
+		{
+			name:    "Unsupported statement",
+			input:   "block-beta\n    classDef blue fill:#00f\n",
+			line:    2,
+			column:  5,
+			message: `unsupported statement "classDef"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package block
 import (
 	"fmt"
 	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
 )
 
 // BlockArrowDirection specifies the direction of a block arrow
@@ -29,5 +31,5 @@ func BlockArrowShape(text string, directions ...BlockArrowDirection) string {
 		strs[i] = string(d)
 	}
 	dirStr := strings.Join(strs, ", ")
-	return fmt.Sprintf(baseBlockArrowShape, text, dirStr)
+	return fmt.Sprintf(baseBlockArrowShape, basediagram.Escape(text), dirStr)
 }
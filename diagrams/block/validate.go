@@ -0,0 +1,69 @@
+package block
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// missing blocks and links, duplicate block IDs, links between blocks that are missing or were never
+// added, blocks wider than the diagram columns, and blocks nested more than
+// one level deep, which are dropped from the output.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	blocks := make(map[*Block]bool)
+	for i, block := range d.Blocks {
+		path := fmt.Sprintf("Blocks[%d]", i)
+		if block == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing block")
+			continue
+		}
+		if block.IsSpace {
+			continue
+		}
+
+		v.UniqueID(path, block.ID)
+		blocks[block] = true
+
+		if d.Columns > 0 && block.Width > d.Columns {
+			v.Warning(basediagram.CodeOutOfRange, path+".Width", "width %d exceeds the %d diagram columns", block.Width, d.Columns)
+		}
+
+		for j, child := range block.Children {
+			childPath := fmt.Sprintf("%s.Children[%d]", path, j)
+			if child == nil {
+				v.Error(basediagram.CodeMissingReference, childPath, "missing block")
+				continue
+			}
+			v.UniqueID(childPath, child.ID)
+			blocks[child] = true
+
+			for k := range child.Children {
+				v.Warning(basediagram.CodeIgnoredValue, fmt.Sprintf("%s.Children[%d]", childPath, k), "blocks nested more than one level deep are not rendered")
+			}
+		}
+	}
+
+	for i, link := range d.Links {
+		path := fmt.Sprintf("Links[%d]", i)
+		if link == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing link")
+			continue
+		}
+		validateBlock(&v, path+".From", link.From, blocks)
+		validateBlock(&v, path+".To", link.To, blocks)
+	}
+
+	return v.Errors()
+}
+
+func validateBlock(v *basediagram.Validator, path string, block *Block, blocks map[*Block]bool) {
+	switch {
+	case block == nil:
+		v.Error(basediagram.CodeMissingReference, path, "missing block")
+	case !blocks[block]:
+		v.Error(basediagram.CodeUnknownReference, path, "block %q is not part of the diagram", block.ID)
+	}
+}
@@ -0,0 +1,94 @@
+package block
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				d.SetColumns(3)
+				a := d.AddBlock("A").SetWidth(2)
+				d.AddSpace()
+				group := d.AddBlock("Group")
+				child := group.AddBlock("Child")
+				d.AddLink(a, child)
+			},
+		},
+		{
+			name: "Link to a block that was never added",
+			setup: func(d *Diagram) {
+				a := d.AddBlock("A")
+				a.ID = "a"
+				d.AddLink(a, NewBlock("ghost", "Ghost"))
+				d.AddLink(nil, a)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "Links[0].To", Message: `block "ghost" is not part of the diagram`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Links[1].From", Message: "missing block"},
+			},
+		},
+		{
+			name: "Duplicate IDs and deep nesting",
+			setup: func(d *Diagram) {
+				a := d.AddBlock("A")
+				a.ID = "a"
+				child := d.AddBlock("Group").AddBlock("Child")
+				child.ID = "a"
+				child.AddBlock("Grandchild")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Blocks[1].Children[0]", Message: `ID "a" is already used by Blocks[0]`},
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Blocks[1].Children[0].Children[0]", Message: "blocks nested more than one level deep are not rendered"},
+			},
+		},
+		{
+			name: "Block wider than the diagram",
+			setup: func(d *Diagram) {
+				d.SetColumns(2)
+				d.AddBlock("A").SetWidth(3)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityWarning, Path: "Blocks[0].Width", Message: "width 3 exceeds the 2 diagram columns"},
+			},
+		},
+		{
+			name: "Missing blocks and link",
+			setup: func(d *Diagram) {
+				parent := d.AddBlock("Parent")
+				parent.Children = append(parent.Children, nil)
+				d.Blocks = append(d.Blocks, nil)
+				d.Links = append(d.Links, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Blocks[0].Children[0]", Message: "missing block"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Blocks[1]", Message: "missing block"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Links[0]", Message: "missing link"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
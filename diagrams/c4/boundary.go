@@ -0,0 +1,133 @@
+package c4
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// boundaryType is the macro declaring a boundary.
+type boundaryType string
+
+// List of boundary types. Deployment nodes may also be declared with the
+// Node, Node_L and Node_R macros, which differ in the alignment of the label.
+const (
+	BoundaryGeneric        boundaryType = "Boundary"
+	BoundaryEnterprise     boundaryType = "Enterprise_Boundary"
+	BoundarySystem         boundaryType = "System_Boundary"
+	BoundaryContainer      boundaryType = "Container_Boundary"
+	BoundaryDeploymentNode boundaryType = "Deployment_Node"
+	BoundaryNode           boundaryType = "Node"
+	BoundaryNodeLeft       boundaryType = "Node_L"
+	BoundaryNodeRight      boundaryType = "Node_R"
+)
+
+// Base string formats for boundaries
+const (
+	baseBoundary    string = basediagram.Indentation + "%s {\n"
+	baseBoundaryEnd string = basediagram.Indentation + "}\n"
+)
+
+// Boundary groups elements and other boundaries, which may be nested to
+// any depth. Kind is the type shown under the label, such as "Company" or
+// "Ubuntu 16.04 LTS", and is only shown for generic boundaries and deployment
+// nodes, which also show the Description.
+type Boundary struct {
+	Scope
+	Type        boundaryType
+	Alias       string
+	Label       string
+	Kind        string
+	Description string
+	Tags        string
+	Link        string
+}
+
+// NewBoundary creates a new empty Boundary
+func NewBoundary(boundaryType boundaryType, alias string, label string) *Boundary {
+	return &Boundary{
+		Scope: Scope{Shapes: make([]Shape, 0)},
+		Type:  boundaryType,
+		Alias: alias,
+		Label: label,
+	}
+}
+
+// SetKind sets the type shown under the label and returns the boundary for chaining
+func (b *Boundary) SetKind(kind string) *Boundary {
+	b.Kind = kind
+	return b
+}
+
+// SetDescription sets the description of a deployment node and returns the boundary for chaining
+func (b *Boundary) SetDescription(description string) *Boundary {
+	b.Description = description
+	return b
+}
+
+// SetTags sets the boundary tags and returns the boundary for chaining
+func (b *Boundary) SetTags(tags string) *Boundary {
+	b.Tags = tags
+	return b
+}
+
+// SetLink sets the URL the boundary links to and returns the boundary for chaining
+func (b *Boundary) SetLink(link string) *Boundary {
+	b.Link = link
+	return b
+}
+
+func (b *Boundary) alias() string {
+	return b.Alias
+}
+
+// hasKind reports whether the macro of the boundary takes a type.
+func (b *Boundary) hasKind() bool {
+	return b.Type == BoundaryGeneric || b.isDeploymentNode()
+}
+
+// isDeploymentNode reports whether the boundary is a deployment node.
+func (b *Boundary) isDeploymentNode() bool {
+	switch b.Type {
+	case BoundaryDeploymentNode, BoundaryNode, BoundaryNodeLeft, BoundaryNodeRight:
+		return true
+	}
+	return false
+}
+
+// String generates the Mermaid syntax for the boundary and its content
+// with custom indentation.
+func (b *Boundary) String(curIndentation string) string {
+	var sb strings.Builder
+	b.writeTo(basediagram.NewWriter(&sb), curIndentation)
+	return sb.String()
+}
+
+// WriteTo writes the Mermaid representation of the boundary and its content
+// to w, indented as a top-level boundary of a diagram.
+func (b *Boundary) WriteTo(w io.Writer) (int64, error) {
+	cw := basediagram.NewWriter(w)
+	b.writeTo(cw, "")
+	return cw.Result()
+}
+
+// writeTo writes the boundary to w with the specified indentation, followed
+// by its content one level deeper.
+func (b *Boundary) writeTo(w *basediagram.Writer, curIndentation string) {
+	texts := []string{b.Label}
+	switch {
+	case b.isDeploymentNode():
+		texts = []string{b.Label, b.Kind, b.Description}
+	case b.hasKind():
+		texts = []string{b.Label, b.Kind}
+	}
+
+	call := formatCall(string(b.Type), []string{b.Alias}, texts,
+		namedArgument{"tags", b.Tags},
+		namedArgument{"link", b.Link},
+	)
+	w.Printf("%s"+baseBoundary, curIndentation, call)
+	b.Scope.writeTo(w, curIndentation+basediagram.Indentation)
+	w.Printf("%s%s", curIndentation, baseBoundaryEnd)
+}
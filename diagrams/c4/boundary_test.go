@@ -0,0 +1,89 @@
+package c4
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewBoundary(t *testing.T) {
+	got := NewBoundary(BoundarySystem, "b1", "Banking")
+
+	if got.Type != BoundarySystem || got.Alias != "b1" || got.Label != "Banking" {
+		t.Errorf("NewBoundary() = %+v, want System_Boundary b1", got)
+	}
+
+	if len(got.Shapes) != 0 {
+		t.Error("NewBoundary() should create empty shapes slice")
+	}
+}
+
+func TestBoundary_Setters(t *testing.T) {
+	boundary := NewBoundary(BoundaryDeploymentNode, "n", "Server")
+	result := boundary.SetKind("Ubuntu").SetDescription("Main server").SetTags("prod").SetLink("https://example.com")
+
+	if result != boundary {
+		t.Error("Setters should return boundary for chaining")
+	}
+
+	if boundary.Kind != "Ubuntu" || boundary.Description != "Main server" || boundary.Tags != "prod" || boundary.Link != "https://example.com" {
+		t.Errorf("Setters = %+v", boundary)
+	}
+}
+
+func TestBoundary_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func() *Boundary
+		indent string
+		want   string
+	}{
+		{
+			name: "Empty enterprise boundary",
+			setup: func() *Boundary {
+				return NewBoundary(BoundaryEnterprise, "b0", "Bank").SetKind("ignored").SetDescription("ignored")
+			},
+			want: "    Enterprise_Boundary(b0, \"Bank\") {\n    }\n",
+		},
+		{
+			name: "Generic boundary with kind",
+			setup: func() *Boundary {
+				return NewBoundary(BoundaryGeneric, "b", "Partners").SetKind("Company").SetTags("ext")
+			},
+			want: "    Boundary(b, \"Partners\", \"Company\", $tags=\"ext\") {\n    }\n",
+		},
+		{
+			name: "Nested boundaries",
+			setup: func() *Boundary {
+				b := NewBoundary(BoundaryDeploymentNode, "dc", "Data center").SetDescription("Main site")
+				node := b.AddBoundary(BoundaryNodeLeft, "srv", "Server").SetKind("Ubuntu")
+				node.AddContainer("api", "API")
+				b.Shapes = append(b.Shapes, nil)
+				b.AddContainer("db", "Database").SetVariant(VariantDb)
+				return b
+			},
+			indent: "  ",
+			want: "      Deployment_Node(dc, \"Data center\", \"\", \"Main site\") {\n" +
+				"          Node_L(srv, \"Server\", \"Ubuntu\") {\n" +
+				"              Container(api, \"API\")\n" +
+				"          }\n" +
+				"          ContainerDb(db, \"Database\")\n" +
+				"      }\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boundary := tt.setup()
+
+			if got := boundary.String(tt.indent); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+
+			var sb strings.Builder
+			boundary.WriteTo(&sb)
+			if want := boundary.String(""); sb.String() != want {
+				t.Errorf("WriteTo() = %q, want %q", sb.String(), want)
+			}
+		})
+	}
+}
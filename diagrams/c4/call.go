@@ -0,0 +1,35 @@
+package c4
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// namedArgument is a `$name="value"` argument of a C4 macro.
+type namedArgument struct {
+	name  string
+	value string
+}
+
+// formatCall writes a C4 macro call such as `Rel(a, b, "Uses", $tags="v1")`.
+// The aliases are written as is and the texts quoted, dropping the trailing
+// empty ones, followed by the named arguments that are set.
+func formatCall(macro string, aliases []string, texts []string, named ...namedArgument) string {
+	for len(texts) > 0 && texts[len(texts)-1] == "" {
+		texts = texts[:len(texts)-1]
+	}
+
+	arguments := make([]string, 0, len(aliases)+len(texts)+len(named))
+	arguments = append(arguments, aliases...)
+	for _, text := range texts {
+		arguments = append(arguments, basediagram.Quote(text))
+	}
+	for _, argument := range named {
+		if argument.value != "" {
+			arguments = append(arguments, "$"+argument.name+"="+basediagram.Quote(argument.value))
+		}
+	}
+
+	return macro + "(" + strings.Join(arguments, ", ") + ")"
+}
@@ -0,0 +1,59 @@
+package c4
+
+import (
+	"testing"
+)
+
+func TestFormatCall(t *testing.T) {
+	tests := []struct {
+		name    string
+		macro   string
+		aliases []string
+		texts   []string
+		named   []namedArgument
+		want    string
+	}{
+		{
+			name:    "Aliases only",
+			macro:   "Rel",
+			aliases: []string{"a", "b"},
+			want:    "Rel(a, b)",
+		},
+		{
+			name:    "Trailing empty texts are dropped",
+			macro:   "Person",
+			aliases: []string{"user"},
+			texts:   []string{"User", "", ""},
+			want:    `Person(user, "User")`,
+		},
+		{
+			name:    "Inner empty texts are kept",
+			macro:   "Container",
+			aliases: []string{"api"},
+			texts:   []string{"API", "", "Serves requests"},
+			want:    `Container(api, "API", "", "Serves requests")`,
+		},
+		{
+			name:    "Named arguments",
+			macro:   "UpdateElementStyle",
+			aliases: []string{"user"},
+			named:   []namedArgument{{"bgColor", "grey"}, {"fontColor", ""}, {"borderColor", "#f00"}},
+			want:    `UpdateElementStyle(user, $bgColor="grey", $borderColor="#35;f00")`,
+		},
+		{
+			name:    "Texts that need escaping",
+			macro:   "System",
+			aliases: []string{"s"},
+			texts:   []string{`The "core"`},
+			want:    `System(s, "The #quot;core#quot;")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatCall(tt.macro, tt.aliases, tt.texts, tt.named...); got != tt.want {
+				t.Errorf("formatCall() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package c4
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	c4ConfigurationSection        string = "c4"
+	baseC4ConfigurationProperties string = basediagram.Indentation + c4ConfigurationSection + ":\n"
+
+	c4PropertyDiagramMarginX  string = "diagramMarginX"
+	c4PropertyDiagramMarginY  string = "diagramMarginY"
+	c4PropertyC4ShapeMargin   string = "c4ShapeMargin"
+	c4PropertyC4ShapePadding  string = "c4ShapePadding"
+	c4PropertyWidth           string = "width"
+	c4PropertyHeight          string = "height"
+	c4PropertyBoxMargin       string = "boxMargin"
+	c4PropertyC4ShapeInRow    string = "c4ShapeInRow"
+	c4PropertyC4BoundaryInRow string = "c4BoundaryInRow"
+	c4PropertyWrap            string = "wrap"
+	c4PropertyUseMaxWidth     string = "useMaxWidth"
+)
+
+// C4ConfigurationProperties holds c4-specific configuration
+type C4ConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewC4ConfigurationProperties() C4ConfigurationProperties {
+	return C4ConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *C4ConfigurationProperties) SetDiagramMarginX(v int) *C4ConfigurationProperties {
+	c.properties[c4PropertyDiagramMarginX] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: c4PropertyDiagramMarginX,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *C4ConfigurationProperties) SetDiagramMarginY(v int) *C4ConfigurationProperties {
+	c.properties[c4PropertyDiagramMarginY] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: c4PropertyDiagramMarginY,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *C4ConfigurationProperties) SetC4ShapeMargin(v int) *C4ConfigurationProperties {
+	c.properties[c4PropertyC4ShapeMargin] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: c4PropertyC4ShapeMargin,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *C4ConfigurationProperties) SetC4ShapePadding(v int) *C4ConfigurationProperties {
+	c.properties[c4PropertyC4ShapePadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: c4PropertyC4ShapePadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *C4ConfigurationProperties) SetWidth(v int) *C4ConfigurationProperties {
+	c.properties[c4PropertyWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: c4PropertyWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *C4ConfigurationProperties) SetHeight(v int) *C4ConfigurationProperties {
+	c.properties[c4PropertyHeight] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: c4PropertyHeight,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *C4ConfigurationProperties) SetBoxMargin(v int) *C4ConfigurationProperties {
+	c.properties[c4PropertyBoxMargin] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: c4PropertyBoxMargin,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *C4ConfigurationProperties) SetC4ShapeInRow(v int) *C4ConfigurationProperties {
+	c.properties[c4PropertyC4ShapeInRow] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: c4PropertyC4ShapeInRow,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *C4ConfigurationProperties) SetC4BoundaryInRow(v int) *C4ConfigurationProperties {
+	c.properties[c4PropertyC4BoundaryInRow] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: c4PropertyC4BoundaryInRow,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *C4ConfigurationProperties) SetWrap(v bool) *C4ConfigurationProperties {
+	c.properties[c4PropertyWrap] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: c4PropertyWrap,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *C4ConfigurationProperties) SetUseMaxWidth(v bool) *C4ConfigurationProperties {
+	c.properties[c4PropertyUseMaxWidth] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: c4PropertyUseMaxWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c C4ConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(baseC4ConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,226 @@
+package c4
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewC4ConfigurationProperties(t *testing.T) {
+	got := NewC4ConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewC4ConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewC4ConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestC4ConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   C4ConfigurationProperties
+		setup    func(*C4ConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewC4ConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewC4ConfigurationProperties(),
+			setup: func(c *C4ConfigurationProperties) {
+				c.SetDiagramMarginX(50)
+			},
+			contains: []string{
+				"c4:",
+				"diagramMarginX: 50",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewC4ConfigurationProperties(),
+			setup: func(c *C4ConfigurationProperties) {
+				c.SetDiagramMarginY(10)
+				c.SetUseMaxWidth(true)
+			},
+			contains: []string{
+				"c4:",
+				"diagramMarginY: 10",
+				"useMaxWidth: true",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewC4ConfigurationProperties(),
+			setup: func(c *C4ConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetDiagramMarginX(50)
+			},
+			contains: []string{
+				"fontSize: 12",
+				"c4:",
+				"diagramMarginX: 50",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestC4ConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*C4ConfigurationProperties) *C4ConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set diagram margin x",
+			setup: func(c *C4ConfigurationProperties) *C4ConfigurationProperties {
+				return c.SetDiagramMarginX(50)
+			},
+			property: c4PropertyDiagramMarginX,
+			value:    50,
+		},
+		{
+			name: "Set diagram margin y",
+			setup: func(c *C4ConfigurationProperties) *C4ConfigurationProperties {
+				return c.SetDiagramMarginY(10)
+			},
+			property: c4PropertyDiagramMarginY,
+			value:    10,
+		},
+		{
+			name: "Set c4 shape margin",
+			setup: func(c *C4ConfigurationProperties) *C4ConfigurationProperties {
+				return c.SetC4ShapeMargin(50)
+			},
+			property: c4PropertyC4ShapeMargin,
+			value:    50,
+		},
+		{
+			name: "Set c4 shape padding",
+			setup: func(c *C4ConfigurationProperties) *C4ConfigurationProperties {
+				return c.SetC4ShapePadding(20)
+			},
+			property: c4PropertyC4ShapePadding,
+			value:    20,
+		},
+		{
+			name: "Set width",
+			setup: func(c *C4ConfigurationProperties) *C4ConfigurationProperties {
+				return c.SetWidth(216)
+			},
+			property: c4PropertyWidth,
+			value:    216,
+		},
+		{
+			name: "Set height",
+			setup: func(c *C4ConfigurationProperties) *C4ConfigurationProperties {
+				return c.SetHeight(60)
+			},
+			property: c4PropertyHeight,
+			value:    60,
+		},
+		{
+			name: "Set box margin",
+			setup: func(c *C4ConfigurationProperties) *C4ConfigurationProperties {
+				return c.SetBoxMargin(10)
+			},
+			property: c4PropertyBoxMargin,
+			value:    10,
+		},
+		{
+			name: "Set c4 shape in row",
+			setup: func(c *C4ConfigurationProperties) *C4ConfigurationProperties {
+				return c.SetC4ShapeInRow(4)
+			},
+			property: c4PropertyC4ShapeInRow,
+			value:    4,
+		},
+		{
+			name: "Set c4 boundary in row",
+			setup: func(c *C4ConfigurationProperties) *C4ConfigurationProperties {
+				return c.SetC4BoundaryInRow(2)
+			},
+			property: c4PropertyC4BoundaryInRow,
+			value:    2,
+		},
+		{
+			name: "Set wrap",
+			setup: func(c *C4ConfigurationProperties) *C4ConfigurationProperties {
+				return c.SetWrap(true)
+			},
+			property: c4PropertyWrap,
+			value:    true,
+		},
+		{
+			name: "Set use max width",
+			setup: func(c *C4ConfigurationProperties) *C4ConfigurationProperties {
+				return c.SetUseMaxWidth(true)
+			},
+			property: c4PropertyUseMaxWidth,
+			value:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewC4ConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
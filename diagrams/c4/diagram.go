@@ -0,0 +1,121 @@
+// Package c4 provides functionality for creating Mermaid C4 diagrams
+package c4
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// diagramKind is the C4 diagram being drawn, which is also its keyword.
+type diagramKind string
+
+// List of C4 diagram kinds.
+const (
+	DiagramContext    diagramKind = "C4Context"
+	DiagramContainer  diagramKind = "C4Container"
+	DiagramComponent  diagramKind = "C4Component"
+	DiagramDynamic    diagramKind = "C4Dynamic"
+	DiagramDeployment diagramKind = "C4Deployment"
+)
+
+// Diagram represents a Mermaid C4 diagram. The shapes are declared through
+// the embedded Scope, and the relationships and styles refer to them.
+// Reference: https://mermaid.js.org/syntax/c4.html
+type Diagram struct {
+	basediagram.BaseDiagram[C4ConfigurationProperties]
+	Scope
+	Kind          diagramKind
+	Relationships []*Relationship
+	ElementStyles []*ElementStyle
+	RelStyles     []*RelStyle
+}
+
+// NewDiagram creates a new system context diagram
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram:   basediagram.NewBaseDiagram(NewC4ConfigurationProperties()),
+		Scope:         Scope{Shapes: make([]Shape, 0)},
+		Kind:          DiagramContext,
+		Relationships: make([]*Relationship, 0),
+		ElementStyles: make([]*ElementStyle, 0),
+		RelStyles:     make([]*RelStyle, 0),
+	}
+}
+
+// SetKind sets the C4 diagram kind and returns the diagram for chaining
+func (d *Diagram) SetKind(kind diagramKind) *Diagram {
+	d.Kind = kind
+	return d
+}
+
+// AddRelationship creates a new relationship between two shapes
+func (d *Diagram) AddRelationship(from Shape, to Shape, label string) *Relationship {
+	relationship := NewRelationship(from, to, label)
+	d.Relationships = append(d.Relationships, relationship)
+	return relationship
+}
+
+// UpdateElementStyle creates and adds a new style for the shape
+func (d *Diagram) UpdateElementStyle(element Shape) *ElementStyle {
+	style := NewElementStyle(element)
+	d.ElementStyles = append(d.ElementStyles, style)
+	return style
+}
+
+// UpdateRelStyle creates and adds a new style for the relationships from one shape to another
+func (d *Diagram) UpdateRelStyle(from Shape, to Shape) *RelStyle {
+	style := NewRelStyle(from, to)
+	d.RelStyles = append(d.RelStyles, style)
+	return style
+}
+
+// String generates the Mermaid syntax for the C4 diagram
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces the C4 diagram.
+func (d *Diagram) DiagramType() string {
+	return string(d.Kind)
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one element at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.Printf("%s\n", d.Kind)
+		d.Scope.writeTo(w, "")
+
+		if len(d.Relationships) > 0 {
+			w.WriteString("\n")
+			for _, relationship := range d.Relationships {
+				if relationship != nil && !isNil(relationship.From) && !isNil(relationship.To) {
+					w.WriteString(relationship.String())
+				}
+			}
+		}
+
+		if len(d.ElementStyles) > 0 || len(d.RelStyles) > 0 {
+			w.WriteString("\n")
+			for _, style := range d.ElementStyles {
+				if style != nil && !isNil(style.Element) {
+					w.WriteString(style.String())
+				}
+			}
+			for _, style := range d.RelStyles {
+				if style != nil && !isNil(style.From) && !isNil(style.To) {
+					w.WriteString(style.String())
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package c4
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if diagram.Kind != DiagramContext {
+		t.Errorf("NewDiagram() kind = %q, want %q", diagram.Kind, DiagramContext)
+	}
+
+	if len(diagram.Shapes) != 0 || len(diagram.Relationships) != 0 || len(diagram.ElementStyles) != 0 || len(diagram.RelStyles) != 0 {
+		t.Error("NewDiagram() should create empty shapes, relationships and styles slices")
+	}
+}
+
+func TestDiagram_SetKind(t *testing.T) {
+	diagram := NewDiagram()
+	result := diagram.SetKind(DiagramDeployment)
+
+	if result != diagram {
+		t.Error("SetKind() should return diagram for chaining")
+	}
+
+	if diagram.DiagramType() != "C4Deployment" {
+		t.Errorf("DiagramType() = %q, want C4Deployment", diagram.DiagramType())
+	}
+}
+
+func TestDiagram_Add(t *testing.T) {
+	diagram := NewDiagram()
+	user := diagram.AddPerson("user", "User")
+	sys := diagram.AddSystem("sys", "System")
+
+	relationship := diagram.AddRelationship(user, sys, "Uses")
+	if len(diagram.Relationships) != 1 || diagram.Relationships[0] != relationship {
+		t.Errorf("AddRelationship() relationships = %v, want [%v]", diagram.Relationships, relationship)
+	}
+
+	elementStyle := diagram.UpdateElementStyle(user)
+	if len(diagram.ElementStyles) != 1 || diagram.ElementStyles[0] != elementStyle || elementStyle.Element != user {
+		t.Errorf("UpdateElementStyle() styles = %v, want [%v]", diagram.ElementStyles, elementStyle)
+	}
+
+	relStyle := diagram.UpdateRelStyle(user, sys)
+	if len(diagram.RelStyles) != 1 || diagram.RelStyles[0] != relStyle || relStyle.From != user || relStyle.To != sys {
+		t.Errorf("UpdateRelStyle() styles = %v, want [%v]", diagram.RelStyles, relStyle)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func() *Diagram
+		want  string
+	}{
+		{
+			name: "Empty diagram",
+			setup: func() *Diagram {
+				return NewDiagram()
+			},
+			want: "C4Context\n",
+		},
+		{
+			name: "Complete diagram",
+			setup: func() *Diagram {
+				d := NewDiagram().SetKind(DiagramContainer)
+				user := d.AddPerson("user", "User")
+				b := d.AddBoundary(BoundarySystem, "b", "Banking")
+				api := b.AddContainer("api", "API").SetTechnology("Go")
+				d.AddRelationship(user, api, "Uses").SetType(RelationshipDown)
+				d.AddRelationship(user, nil, "Ignored")
+				d.UpdateElementStyle(user).SetBgColor("grey")
+				d.UpdateRelStyle(user, api).SetLineColor("red")
+				d.UpdateElementStyle(nil)
+				return d
+			},
+			want: "C4Container\n" +
+				"    Person(user, \"User\")\n" +
+				"    System_Boundary(b, \"Banking\") {\n" +
+				"        Container(api, \"API\", \"Go\")\n" +
+				"    }\n" +
+				"\n" +
+				"    Rel_D(user, api, \"Uses\")\n" +
+				"\n" +
+				"    UpdateElementStyle(user, $bgColor=\"grey\")\n" +
+				"    UpdateRelStyle(user, api, $lineColor=\"red\")\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.setup().String()
+			if !strings.HasSuffix(got, "---\n"+tt.want) {
+				t.Errorf("String() = %q, want suffix %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package c4
+
+import (
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// elementKind is the level of abstraction of an element.
+type elementKind string
+
+// List of element kinds.
+const (
+	KindPerson    elementKind = "Person"
+	KindSystem    elementKind = "System"
+	KindContainer elementKind = "Container"
+	KindComponent elementKind = "Component"
+)
+
+// elementVariant changes the shape of a system, container or component.
+type elementVariant string
+
+// List of element variants.
+const (
+	VariantDefault elementVariant = ""
+	VariantDb      elementVariant = "Db"
+	VariantQueue   elementVariant = "Queue"
+)
+
+// Suffix of the macros of elements outside of the described system
+const externalSuffix string = "_Ext"
+
+// Element represents a person, system, container or component. The macro
+// is made of the kind, the variant and, for external elements, the _Ext
+// suffix, e.g. SystemDb_Ext. Technology is only shown for containers and
+// components.
+type Element struct {
+	Kind        elementKind
+	Variant     elementVariant
+	External    bool
+	Alias       string
+	Label       string
+	Technology  string
+	Description string
+	Sprite      string
+	Tags        string
+	Link        string
+}
+
+// NewElement creates a new Element of the given kind
+func NewElement(kind elementKind, alias string, label string) *Element {
+	return &Element{
+		Kind:  kind,
+		Alias: alias,
+		Label: label,
+	}
+}
+
+// SetVariant sets the shape variant, such as a database, and returns the element for chaining
+func (e *Element) SetVariant(variant elementVariant) *Element {
+	e.Variant = variant
+	return e
+}
+
+// SetExternal sets whether the element is outside of the described system and returns the element for chaining
+func (e *Element) SetExternal(external bool) *Element {
+	e.External = external
+	return e
+}
+
+// SetTechnology sets the technology of a container or component and returns the element for chaining
+func (e *Element) SetTechnology(technology string) *Element {
+	e.Technology = technology
+	return e
+}
+
+// SetDescription sets the element description and returns the element for chaining
+func (e *Element) SetDescription(description string) *Element {
+	e.Description = description
+	return e
+}
+
+// SetSprite sets the icon of the element and returns the element for chaining
+func (e *Element) SetSprite(sprite string) *Element {
+	e.Sprite = sprite
+	return e
+}
+
+// SetTags sets the element tags and returns the element for chaining
+func (e *Element) SetTags(tags string) *Element {
+	e.Tags = tags
+	return e
+}
+
+// SetLink sets the URL the element links to and returns the element for chaining
+func (e *Element) SetLink(link string) *Element {
+	e.Link = link
+	return e
+}
+
+// Macro returns the name of the macro declaring the element, e.g. SystemDb_Ext.
+func (e *Element) Macro() string {
+	macro := string(e.Kind) + string(e.Variant)
+	if e.External {
+		macro += externalSuffix
+	}
+	return macro
+}
+
+func (e *Element) alias() string {
+	return e.Alias
+}
+
+// hasTechnology reports whether the macro of the element takes a technology.
+func (e *Element) hasTechnology() bool {
+	return e.Kind == KindContainer || e.Kind == KindComponent
+}
+
+// String generates the Mermaid syntax for the element
+func (e *Element) String() string {
+	texts := []string{e.Label, e.Description}
+	if e.hasTechnology() {
+		texts = []string{e.Label, e.Technology, e.Description}
+	}
+
+	return basediagram.Indentation + formatCall(e.Macro(), []string{e.Alias}, texts,
+		namedArgument{"sprite", e.Sprite},
+		namedArgument{"tags", e.Tags},
+		namedArgument{"link", e.Link},
+	) + "\n"
+}
+
+func (e *Element) writeTo(w *basediagram.Writer, curIndentation string) {
+	w.Printf("%s%s", curIndentation, e.String())
+}
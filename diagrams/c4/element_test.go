@@ -0,0 +1,113 @@
+package c4
+
+import (
+	"testing"
+)
+
+func TestNewElement(t *testing.T) {
+	got := NewElement(KindSystem, "banking", "Internet Banking")
+
+	if got.Kind != KindSystem || got.Alias != "banking" || got.Label != "Internet Banking" {
+		t.Errorf("NewElement() = %+v, want System banking", got)
+	}
+
+	if got.Variant != VariantDefault || got.External {
+		t.Errorf("NewElement() = %+v, want default internal element", got)
+	}
+}
+
+func TestElement_Setters(t *testing.T) {
+	element := NewElement(KindContainer, "db", "Database")
+	result := element.
+		SetVariant(VariantDb).
+		SetExternal(true).
+		SetTechnology("PostgreSQL").
+		SetDescription("Stores accounts").
+		SetSprite("postgres").
+		SetTags("v1").
+		SetLink("https://example.com")
+
+	if result != element {
+		t.Error("Setters should return element for chaining")
+	}
+
+	want := Element{
+		Kind:        KindContainer,
+		Variant:     VariantDb,
+		External:    true,
+		Alias:       "db",
+		Label:       "Database",
+		Technology:  "PostgreSQL",
+		Description: "Stores accounts",
+		Sprite:      "postgres",
+		Tags:        "v1",
+		Link:        "https://example.com",
+	}
+	if *element != want {
+		t.Errorf("Setters = %+v, want %+v", *element, want)
+	}
+}
+
+func TestElement_Macro(t *testing.T) {
+	tests := []struct {
+		name    string
+		element *Element
+		want    string
+	}{
+		{name: "Person", element: NewElement(KindPerson, "a", ""), want: "Person"},
+		{name: "External person", element: NewElement(KindPerson, "a", "").SetExternal(true), want: "Person_Ext"},
+		{name: "System database", element: NewElement(KindSystem, "a", "").SetVariant(VariantDb), want: "SystemDb"},
+		{name: "External container queue", element: NewElement(KindContainer, "a", "").SetVariant(VariantQueue).SetExternal(true), want: "ContainerQueue_Ext"},
+		{name: "Component", element: NewElement(KindComponent, "a", ""), want: "Component"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.element.Macro(); got != tt.want {
+				t.Errorf("Macro() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestElement_String(t *testing.T) {
+	tests := []struct {
+		name    string
+		element *Element
+		want    string
+	}{
+		{
+			name:    "Person with description",
+			element: NewElement(KindPerson, "customer", "Customer").SetDescription("A customer of the bank"),
+			want:    "    Person(customer, \"Customer\", \"A customer of the bank\")\n",
+		},
+		{
+			name:    "Technology is not written for systems",
+			element: NewElement(KindSystem, "mail", "E-mail").SetExternal(true).SetTechnology("SMTP"),
+			want:    "    System_Ext(mail, \"E-mail\")\n",
+		},
+		{
+			name:    "Container with technology only",
+			element: NewElement(KindContainer, "spa", "Single-Page App").SetTechnology("Angular"),
+			want:    "    Container(spa, \"Single-Page App\", \"Angular\")\n",
+		},
+		{
+			name:    "Component with description only",
+			element: NewElement(KindComponent, "sign", "Sign In").SetDescription("Signs users in"),
+			want:    "    Component(sign, \"Sign In\", \"\", \"Signs users in\")\n",
+		},
+		{
+			name:    "Element with named arguments",
+			element: NewElement(KindSystem, "s", "Core").SetVariant(VariantQueue).SetSprite("queue").SetTags("v1").SetLink("https://example.com"),
+			want:    "    SystemQueue(s, \"Core\", $sprite=\"queue\", $tags=\"v1\", $link=\"https://example.com\")\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.element.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
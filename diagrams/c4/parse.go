@@ -0,0 +1,516 @@
+package c4
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Parameters of the C4 macros, in positional order. Any of them may also be
+// passed by name as $name="value".
+var (
+	personParameters        = []string{"alias", "label", "descr", "sprite", "tags", "link"}
+	containerParameters     = []string{"alias", "label", "techn", "descr", "sprite", "tags", "link"}
+	boundaryParameters      = []string{"alias", "label", "type", "tags", "link"}
+	namedBoundaryParameters = []string{"alias", "label", "tags", "link"}
+	nodeParameters          = []string{"alias", "label", "type", "descr", "sprite", "tags", "link"}
+	relationshipParameters  = []string{"from", "to", "label", "techn", "descr", "sprite", "tags", "link"}
+	elementStyleParameters  = []string{"elementName", "bgColor", "fontColor", "borderColor", "shadowing", "shape", "sprite", "techn", "legendText", "legendSprite"}
+	relStyleParameters      = []string{"from", "to", "textColor", "lineColor", "offsetX", "offsetY"}
+	layoutParameters        = []string{"c4ShapeInRow", "c4BoundaryInRow"}
+)
+
+// relationshipMacros maps the relationship macros, including the long forms
+// of the directions, to their type.
+var relationshipMacros = map[string]relationshipType{
+	"Rel":       RelationshipDefault,
+	"BiRel":     RelationshipBidirectional,
+	"Rel_U":     RelationshipUp,
+	"Rel_Up":    RelationshipUp,
+	"Rel_D":     RelationshipDown,
+	"Rel_Down":  RelationshipDown,
+	"Rel_L":     RelationshipLeft,
+	"Rel_Left":  RelationshipLeft,
+	"Rel_R":     RelationshipRight,
+	"Rel_Right": RelationshipRight,
+	"Rel_Back":  RelationshipBack,
+}
+
+// argument is an argument of a macro call, with the name it was passed by
+// if any and its offset within the line.
+type argument struct {
+	name  string
+	value string
+	pos   int
+}
+
+// call is a parsed macro call such as `Person(alias, "Label")`.
+type call struct {
+	line      parser.Line
+	macro     string
+	pos       int
+	arguments []argument
+	block     bool
+}
+
+// reference is an alias whose shape is looked up once the whole diagram is
+// read, since shapes may be declared after their use.
+type reference struct {
+	shape *Shape
+	alias string
+	line  parser.Line
+	pos   int
+}
+
+type c4Parser struct {
+	diagram    *Diagram
+	scopes     []*Scope
+	blockLines []parser.Line
+	shapes     map[string]Shape
+	references []reference
+}
+
+// Parse reads Mermaid C4 diagram syntax and returns the corresponding Diagram.
+// It understands the syntax generated by Diagram.String as well as the `title`
+// statement, the long forms of the relationship directions and arguments
+// passed by name in any macro. Tags, boundary styles, the legend sprite and
+// element shapes are not supported by the model and are reported as errors.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(string(DiagramContext), string(DiagramContainer), string(DiagramComponent),
+		string(DiagramDynamic), string(DiagramDeployment))
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &c4Parser{
+		diagram: NewDiagram(),
+		shapes:  make(map[string]Shape),
+	}
+	p.diagram.Kind = diagramKind(strings.TrimSpace(header.Text))
+	p.diagram.Title = doc.Title
+	p.scopes = []*Scope{&p.diagram.Scope}
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, c4ConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.blockLines) > 0 {
+		return nil, p.blockLines[len(p.blockLines)-1].Errorf(0, "boundary is missing its '}'")
+	}
+
+	for _, ref := range p.references {
+		shape, ok := p.shapes[ref.alias]
+		if !ok {
+			return nil, ref.line.Errorf(ref.pos, "unknown shape %q", ref.alias)
+		}
+		*ref.shape = shape
+	}
+
+	return p.diagram, nil
+}
+
+func (p *c4Parser) parseLine(line parser.Line) error {
+	if line.Text == "}" {
+		if len(p.blockLines) == 0 {
+			return line.Errorf(0, "unexpected '}'")
+		}
+		p.scopes = p.scopes[:len(p.scopes)-1]
+		p.blockLines = p.blockLines[:len(p.blockLines)-1]
+		return nil
+	}
+
+	keyword, rest, _ := strings.Cut(line.Text, " ")
+	switch keyword {
+	case "title":
+		p.diagram.Title = strings.TrimSpace(rest)
+		return nil
+	case "accTitle", "accDescr", "accTitle:", "accDescr:":
+		return line.Errorf(0, "unsupported statement %q", strings.TrimSuffix(keyword, ":"))
+	}
+
+	c, err := readCall(line)
+	if err != nil {
+		return err
+	}
+
+	if boundaryType, ok := lookupBoundary(c.macro); ok {
+		if !c.block {
+			return line.Errorf(len(line.Text), "expected '{'")
+		}
+		return p.parseBoundary(c, boundaryType)
+	}
+	if c.block {
+		return line.Errorf(len(line.Text)-1, "unexpected '{'")
+	}
+
+	if kind, variant, external, ok := lookupElement(c.macro); ok {
+		return p.parseElement(c, kind, variant, external)
+	}
+	if relationshipType, ok := relationshipMacros[c.macro]; ok {
+		return p.parseRelationship(c, relationshipType)
+	}
+
+	switch c.macro {
+	case "UpdateElementStyle":
+		return p.parseElementStyle(c)
+	case "UpdateRelStyle":
+		return p.parseRelStyle(c)
+	case "UpdateLayoutConfig":
+		return p.parseLayout(c)
+	case "RelIndex", "UpdateBoundaryStyle", "AddElementTag", "AddRelTag", "AddBoundaryTag":
+		return line.Errorf(0, "unsupported statement %q", c.macro)
+	}
+
+	return line.Errorf(0, "unknown statement %q", c.macro)
+}
+
+// parseElement reads a person, system, container or component macro.
+func (p *c4Parser) parseElement(c *call, kind elementKind, variant elementVariant, external bool) error {
+	parameters := personParameters
+	if kind == KindContainer || kind == KindComponent {
+		parameters = containerParameters
+	}
+
+	args, err := c.bind(parameters)
+	if err != nil {
+		return err
+	}
+
+	alias, err := p.declare(c, args)
+	if err != nil {
+		return err
+	}
+
+	element := p.scope().AddElement(kind, alias, args["label"].value).
+		SetVariant(variant).
+		SetExternal(external).
+		SetTechnology(args["techn"].value).
+		SetDescription(args["descr"].value).
+		SetSprite(args["sprite"].value).
+		SetTags(args["tags"].value).
+		SetLink(args["link"].value)
+	p.shapes[alias] = element
+
+	return nil
+}
+
+// parseBoundary reads a boundary or deployment node macro and opens its block.
+func (p *c4Parser) parseBoundary(c *call, boundaryType boundaryType) error {
+	boundary := NewBoundary(boundaryType, "", "")
+
+	parameters := namedBoundaryParameters
+	switch {
+	case boundary.isDeploymentNode():
+		parameters = nodeParameters
+	case boundary.hasKind():
+		parameters = boundaryParameters
+	}
+
+	args, err := c.bind(parameters)
+	if err != nil {
+		return err
+	}
+
+	alias, err := p.declare(c, args)
+	if err != nil {
+		return err
+	}
+	if _, ok := args["sprite"]; ok {
+		return c.line.Errorf(args["sprite"].pos, "unsupported parameter %q", "sprite")
+	}
+
+	boundary = p.scope().AddBoundary(boundaryType, alias, args["label"].value).
+		SetKind(args["type"].value).
+		SetDescription(args["descr"].value).
+		SetTags(args["tags"].value).
+		SetLink(args["link"].value)
+	p.shapes[alias] = boundary
+
+	p.scopes = append(p.scopes, &boundary.Scope)
+	p.blockLines = append(p.blockLines, c.line)
+
+	return nil
+}
+
+// parseRelationship reads a relationship macro.
+func (p *c4Parser) parseRelationship(c *call, relationshipType relationshipType) error {
+	args, err := c.bind(relationshipParameters)
+	if err != nil {
+		return err
+	}
+
+	relationship := p.diagram.AddRelationship(nil, nil, args["label"].value).
+		SetType(relationshipType).
+		SetTechnology(args["techn"].value).
+		SetDescription(args["descr"].value).
+		SetSprite(args["sprite"].value).
+		SetTags(args["tags"].value).
+		SetLink(args["link"].value)
+
+	if err := p.refer(c, args, "from", &relationship.From); err != nil {
+		return err
+	}
+	return p.refer(c, args, "to", &relationship.To)
+}
+
+// parseElementStyle reads an UpdateElementStyle statement.
+func (p *c4Parser) parseElementStyle(c *call) error {
+	args, err := c.bind(elementStyleParameters)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []string{"shape", "sprite", "techn", "legendSprite"} {
+		if arg, ok := args[name]; ok {
+			return c.line.Errorf(arg.pos, "unsupported parameter %q", name)
+		}
+	}
+
+	style := p.diagram.UpdateElementStyle(nil).
+		SetBgColor(args["bgColor"].value).
+		SetFontColor(args["fontColor"].value).
+		SetBorderColor(args["borderColor"].value).
+		SetLegendText(args["legendText"].value)
+
+	if arg, ok := args["shadowing"]; ok {
+		shadowing, err := strconv.ParseBool(arg.value)
+		if err != nil {
+			return c.line.Errorf(arg.pos, "invalid shadowing %q", arg.value)
+		}
+		style.SetShadowing(shadowing)
+	}
+
+	return p.refer(c, args, "elementName", &style.Element)
+}
+
+// parseRelStyle reads an UpdateRelStyle statement.
+func (p *c4Parser) parseRelStyle(c *call) error {
+	args, err := c.bind(relStyleParameters)
+	if err != nil {
+		return err
+	}
+
+	style := p.diagram.UpdateRelStyle(nil, nil).
+		SetTextColor(args["textColor"].value).
+		SetLineColor(args["lineColor"].value)
+
+	offsets := []*int{&style.OffsetX, &style.OffsetY}
+	for i, name := range []string{"offsetX", "offsetY"} {
+		if arg, ok := args[name]; ok {
+			offset, err := strconv.Atoi(arg.value)
+			if err != nil {
+				return c.line.Errorf(arg.pos, "invalid offset %q", arg.value)
+			}
+			*offsets[i] = offset
+		}
+	}
+
+	if err := p.refer(c, args, "from", &style.From); err != nil {
+		return err
+	}
+	return p.refer(c, args, "to", &style.To)
+}
+
+// parseLayout reads an UpdateLayoutConfig statement into the configuration.
+func (p *c4Parser) parseLayout(c *call) error {
+	args, err := c.bind(layoutParameters)
+	if err != nil {
+		return err
+	}
+
+	setters := []func(int) *C4ConfigurationProperties{p.diagram.Config.SetC4ShapeInRow, p.diagram.Config.SetC4BoundaryInRow}
+	for i, name := range layoutParameters {
+		arg, ok := args[name]
+		if !ok {
+			continue
+		}
+
+		count, err := strconv.Atoi(arg.value)
+		if err != nil {
+			return c.line.Errorf(arg.pos, "invalid %s %q", name, arg.value)
+		}
+		setters[i](count)
+	}
+
+	return nil
+}
+
+// declare returns the alias of a new shape, which must be set and unique.
+func (p *c4Parser) declare(c *call, args map[string]argument) (string, error) {
+	arg, ok := args["alias"]
+	if !ok || arg.value == "" {
+		return "", c.line.Errorf(c.pos, "missing alias")
+	}
+	if _, ok := p.shapes[arg.value]; ok {
+		return "", c.line.Errorf(arg.pos, "alias %q is already used", arg.value)
+	}
+
+	return arg.value, nil
+}
+
+// refer records that the parameter name refers to a shape, to be stored in shape.
+func (p *c4Parser) refer(c *call, args map[string]argument, name string, shape *Shape) error {
+	arg, ok := args[name]
+	if !ok || arg.value == "" {
+		return c.line.Errorf(c.pos, "missing %s", name)
+	}
+
+	p.references = append(p.references, reference{shape: shape, alias: arg.value, line: c.line, pos: arg.pos})
+
+	return nil
+}
+
+// scope returns the diagram or boundary that new shapes are added to.
+func (p *c4Parser) scope() *Scope {
+	return p.scopes[len(p.scopes)-1]
+}
+
+// readCall reads `Macro(argument, ...)`, optionally followed by `{`.
+func readCall(line parser.Line) (*call, error) {
+	s := parser.NewScanner(line)
+
+	c := &call{line: line, macro: s.ReadWhile(parser.IsIdentifier)}
+	if c.macro == "" {
+		return nil, s.Errorf("expected statement")
+	}
+	s.SkipSpaces()
+
+	c.pos = s.Pos()
+	if !s.Consume("(") {
+		return nil, s.Errorf("expected '('")
+	}
+	s.SkipSpaces()
+
+	for !s.Consume(")") {
+		arg, err := readArgument(s)
+		if err != nil {
+			return nil, err
+		}
+		c.arguments = append(c.arguments, arg)
+
+		s.SkipSpaces()
+		if !s.Consume(",") && !s.HasPrefix(")") {
+			return nil, s.Errorf("expected ',' or ')'")
+		}
+		s.SkipSpaces()
+	}
+
+	s.SkipSpaces()
+	c.block = s.Consume("{")
+	if !s.EOF() {
+		return nil, s.Errorf("unexpected %q", s.Rest())
+	}
+
+	return c, nil
+}
+
+// readArgument reads a quoted or plain value, optionally passed by name
+// as $name=value.
+func readArgument(s *parser.Scanner) (argument, error) {
+	arg := argument{pos: s.Pos()}
+
+	if s.Consume("$") {
+		arg.name = s.ReadWhile(parser.IsIdentifier)
+		s.SkipSpaces()
+		if arg.name == "" || !s.Consume("=") {
+			return arg, s.Errorf("expected '='")
+		}
+		s.SkipSpaces()
+	}
+
+	if !s.HasPrefix(`"`) {
+		arg.value = strings.TrimSpace(s.ReadWhile(func(r rune) bool { return r != ',' && r != ')' }))
+		return arg, nil
+	}
+
+	value, err := s.ReadQuoted()
+	if err != nil {
+		return arg, err
+	}
+	arg.value = basediagram.Unescape(value)
+
+	return arg, nil
+}
+
+// bind matches the arguments of the call to parameters, positional ones in
+// order and named ones by name.
+func (c *call) bind(parameters []string) (map[string]argument, error) {
+	args := make(map[string]argument, len(c.arguments))
+
+	position := 0
+	for _, arg := range c.arguments {
+		name := arg.name
+		if name == "" {
+			if position >= len(parameters) {
+				return nil, c.line.Errorf(arg.pos, "too many arguments for %s", c.macro)
+			}
+			name = parameters[position]
+			position++
+		} else if !contains(parameters, name) {
+			return nil, c.line.Errorf(arg.pos, "unknown parameter %q for %s", name, c.macro)
+		}
+
+		args[name] = arg
+	}
+
+	return args, nil
+}
+
+// lookupElement splits an element macro such as SystemDb_Ext into its kind,
+// variant and whether it is external.
+func lookupElement(macro string) (elementKind, elementVariant, bool, bool) {
+	name, external := strings.CutSuffix(macro, externalSuffix)
+
+	for _, kind := range []elementKind{KindPerson, KindSystem, KindContainer, KindComponent} {
+		rest, found := strings.CutPrefix(name, string(kind))
+		if !found {
+			continue
+		}
+
+		switch variant := elementVariant(rest); {
+		case variant == VariantDefault:
+			return kind, variant, external, true
+		case kind != KindPerson && (variant == VariantDb || variant == VariantQueue):
+			return kind, variant, external, true
+		}
+	}
+
+	return "", "", false, false
+}
+
+// lookupBoundary returns the boundary type declared by macro.
+func lookupBoundary(macro string) (boundaryType, bool) {
+	switch boundaryType := boundaryType(macro); boundaryType {
+	case BoundaryGeneric, BoundaryEnterprise, BoundarySystem, BoundaryContainer,
+		BoundaryDeploymentNode, BoundaryNode, BoundaryNodeLeft, BoundaryNodeRight:
+		return boundaryType, true
+	}
+
+	return "", false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
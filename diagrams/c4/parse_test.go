@@ -0,0 +1,326 @@
+package c4
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Diagram with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "System Context"
+				d.Config.SetC4ShapeInRow(3).SetWrap(true)
+				d.EnableMarkdownFence()
+				d.AddPerson("user", "User")
+			},
+		},
+		{
+			name: "Diagram of every kind",
+			setup: func(d *Diagram) {
+				d.SetKind(DiagramDynamic)
+				d.AddPerson("p", "Person").SetDescription("d").SetExternal(true)
+				for _, variant := range []elementVariant{VariantDefault, VariantDb, VariantQueue} {
+					for _, external := range []bool{false, true} {
+						suffix := string(variant)
+						if external {
+							suffix += "_ext"
+						}
+						d.AddSystem("s"+suffix, "System").SetVariant(variant).SetExternal(external).SetDescription("d")
+						d.AddContainer("c"+suffix, "Container").SetVariant(variant).SetExternal(external).SetTechnology("t")
+						d.AddComponent("m"+suffix, "Component").SetVariant(variant).SetExternal(external).SetDescription("d")
+					}
+				}
+			},
+		},
+		{
+			name: "Diagram with nested boundaries",
+			setup: func(d *Diagram) {
+				d.SetKind(DiagramDeployment)
+				e := d.AddBoundary(BoundaryEnterprise, "e", "Enterprise").SetTags("t").SetLink("https://example.com")
+				s := e.AddBoundary(BoundarySystem, "s", "System")
+				c := s.AddBoundary(BoundaryContainer, "c", "Container")
+				g := c.AddBoundary(BoundaryGeneric, "g", "Generic").SetKind("Company")
+				n := g.AddBoundary(BoundaryDeploymentNode, "n", "Node").SetKind("Ubuntu").SetDescription("Server")
+				n.AddBoundary(BoundaryNode, "n1", "N1").AddBoundary(BoundaryNodeLeft, "n2", "N2").AddBoundary(BoundaryNodeRight, "n3", "N3")
+				n.AddContainer("api", "API").SetSprite("go").SetTags("v1").SetLink("https://example.com/api")
+				d.AddSystem("after", "After")
+			},
+		},
+		{
+			name: "Diagram with relationships and styles",
+			setup: func(d *Diagram) {
+				user := d.AddPerson("user", "User")
+				b := d.AddBoundary(BoundarySystem, "b", "Banking")
+				api := b.AddContainer("api", "API")
+				for _, relationshipType := range []relationshipType{
+					RelationshipDefault, RelationshipBidirectional, RelationshipUp, RelationshipDown,
+					RelationshipLeft, RelationshipRight, RelationshipBack,
+				} {
+					d.AddRelationship(user, api, "Uses").SetType(relationshipType)
+				}
+				d.AddRelationship(api, b, "").SetTechnology("HTTPS").SetDescription("JSON").SetSprite("s").SetTags("t").SetLink("l")
+				d.UpdateElementStyle(user).SetBgColor("grey").SetFontColor("red").SetBorderColor("#f00").SetShadowing(true).SetLegendText("Users")
+				d.UpdateElementStyle(b)
+				d.UpdateRelStyle(user, api).SetTextColor("blue").SetLineColor("blue").SetOffset(-40, 60)
+			},
+		},
+		{
+			name: "Diagram with text that needs escaping",
+			setup: func(d *Diagram) {
+				d.AddSystem("s", `Say "hi", (now)`).SetDescription("#1; $x=\"y\"")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `C4Context
+  title System Context diagram for Internet Banking System
+  Enterprise_Boundary(b0, "BankBoundary0") {
+    Person(customerA, "Banking Customer A", "A customer of the bank, with personal bank accounts.")
+    System(SystemAA, "Internet Banking System", $link="https://example.com")
+
+    System_Boundary(b1, "BankBoundary") {
+      SystemDb_Ext(SystemE, "Mainframe Banking System")
+    }
+  }
+
+  BiRel(customerA, SystemAA, "Uses")
+  Rel_Down(SystemAA, SystemE, "Uses", $techn="XML/HTTPS")
+  Rel(SystemC, customerA, "Sends e-mails to")
+  System_Ext(SystemC, "E-mail system")
+
+  UpdateElementStyle(customerA, $fontColor="red", $bgColor="grey", $borderColor="red")
+  UpdateRelStyle(customerA, SystemAA, $textColor="blue", $offsetY="-10")
+  UpdateLayoutConfig($c4ShapeInRow="3", $c4BoundaryInRow="1")
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if d.Title != "System Context diagram for Internet Banking System" {
+		t.Errorf("Parse() title = %q", d.Title)
+	}
+
+	if len(d.Shapes) != 2 {
+		t.Fatalf("Parse() got %d top-level shapes, want 2", len(d.Shapes))
+	}
+
+	b0 := d.Shapes[0].(*Boundary)
+	customer := b0.Shapes[0].(*Element)
+	if customer.Kind != KindPerson || customer.Description != "A customer of the bank, with personal bank accounts." {
+		t.Errorf("Parse() person = %+v", customer)
+	}
+
+	system := b0.Shapes[1].(*Element)
+	if system.Link != "https://example.com" {
+		t.Errorf("Parse() system link = %q", system.Link)
+	}
+
+	mainframe := b0.Shapes[2].(*Boundary).Shapes[0].(*Element)
+	if mainframe.Macro() != "SystemDb_Ext" {
+		t.Errorf("Parse() nested element = %s, want SystemDb_Ext", mainframe.Macro())
+	}
+
+	down := d.Relationships[1]
+	if down.Type != RelationshipDown || down.From != system || down.To != mainframe || down.Technology != "XML/HTTPS" {
+		t.Errorf("Parse() relationship = %+v", down)
+	}
+
+	if d.Relationships[2].From != d.Shapes[1] {
+		t.Errorf("Parse() relationship declared before its shape = %+v", d.Relationships[2])
+	}
+
+	style := d.ElementStyles[0]
+	if style.Element != customer || style.FontColor != "red" || style.BgColor != "grey" || style.BorderColor != "red" {
+		t.Errorf("Parse() element style = %+v", style)
+	}
+
+	if d.RelStyles[0].OffsetY != -10 || d.RelStyles[0].TextColor != "blue" {
+		t.Errorf("Parse() relationship style = %+v", d.RelStyles[0])
+	}
+
+	config := d.Config.String()
+	if !strings.Contains(config, "c4ShapeInRow: 3") || !strings.Contains(config, "c4BoundaryInRow: 1") {
+		t.Errorf("Parse() layout config missing in:\n%s", config)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "Person(a, \"A\")\n",
+			line:    1,
+			column:  1,
+			message: "expected C4Context declaration",
+		},
+		{
+			name:    "Header with arguments",
+			input:   "C4Context LR\n",
+			line:    1,
+			column:  11,
+			message: `unexpected "LR"`,
+		},
+		{
+			name:    "Unknown statement",
+			input:   "C4Context\n    Actor(a, \"A\")\n",
+			line:    2,
+			column:  5,
+			message: `unknown statement "Actor"`,
+		},
+		{
+			name:    "Unsupported statement",
+			input:   "C4Context\n    AddElementTag(\"v1\")\n",
+			line:    2,
+			column:  5,
+			message: `unsupported statement "AddElementTag"`,
+		},
+		{
+			name:    "Missing parenthesis",
+			input:   "C4Context\n    Person a\n",
+			line:    2,
+			column:  12,
+			message: "expected '('",
+		},
+		{
+			name:    "Unterminated call",
+			input:   "C4Context\n    Person(a, \"A\"\n",
+			line:    2,
+			column:  18,
+			message: "expected ',' or ')'",
+		},
+		{
+			name:    "Too many arguments",
+			input:   "C4Context\n    Person(a, \"A\", \"d\", \"s\", \"t\", \"l\", \"x\")\n",
+			line:    2,
+			column:  40,
+			message: "too many arguments for Person",
+		},
+		{
+			name:    "Unknown parameter",
+			input:   "C4Context\n    Person(a, $techn=\"Go\")\n",
+			line:    2,
+			column:  15,
+			message: `unknown parameter "techn" for Person`,
+		},
+		{
+			name:    "Missing alias",
+			input:   "C4Context\n    System()\n",
+			line:    2,
+			column:  11,
+			message: "missing alias",
+		},
+		{
+			name:    "Duplicate alias",
+			input:   "C4Context\n    System(a)\n    Person(a)\n",
+			line:    3,
+			column:  12,
+			message: `alias "a" is already used`,
+		},
+		{
+			name:    "Boundary without block",
+			input:   "C4Context\n    System_Boundary(b, \"B\")\n",
+			line:    2,
+			column:  28,
+			message: "expected '{'",
+		},
+		{
+			name:    "Element with block",
+			input:   "C4Context\n    System(s) {\n",
+			line:    2,
+			column:  15,
+			message: "unexpected '{'",
+		},
+		{
+			name:    "Unclosed boundary",
+			input:   "C4Context\n    Boundary(b, \"B\") {\n        System(s)\n",
+			line:    2,
+			column:  5,
+			message: "boundary is missing its '}'",
+		},
+		{
+			name:    "Unexpected closing brace",
+			input:   "C4Context\n    }\n",
+			line:    2,
+			column:  5,
+			message: "unexpected '}'",
+		},
+		{
+			name:    "Unknown shape",
+			input:   "C4Context\n    System(a)\n    Rel(a, b, \"Uses\")\n",
+			line:    3,
+			column:  12,
+			message: `unknown shape "b"`,
+		},
+		{
+			name:    "Invalid offset",
+			input:   "C4Context\n    UpdateRelStyle(a, b, $offsetX=\"left\")\n",
+			line:    2,
+			column:  26,
+			message: `invalid offset "left"`,
+		},
+		{
+			name:    "Unsupported style parameter",
+			input:   "C4Context\n    UpdateElementStyle(a, $shape=\"RoundedBoxShape()\")\n",
+			line:    2,
+			column:  27,
+			message: `unsupported parameter "shape"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package c4
+
+import (
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// relationshipType is the macro declaring a relationship, which sets the
+// arrows and the direction the target is placed in.
+type relationshipType string
+
+// List of relationship types.
+const (
+	RelationshipDefault       relationshipType = "Rel"
+	RelationshipBidirectional relationshipType = "BiRel"
+	RelationshipUp            relationshipType = "Rel_U"
+	RelationshipDown          relationshipType = "Rel_D"
+	RelationshipLeft          relationshipType = "Rel_L"
+	RelationshipRight         relationshipType = "Rel_R"
+	RelationshipBack          relationshipType = "Rel_Back"
+)
+
+// Relationship represents an arrow between two shapes.
+type Relationship struct {
+	Type        relationshipType
+	From        Shape
+	To          Shape
+	Label       string
+	Technology  string
+	Description string
+	Sprite      string
+	Tags        string
+	Link        string
+}
+
+// NewRelationship creates a new relationship between two shapes
+func NewRelationship(from Shape, to Shape, label string) *Relationship {
+	return &Relationship{
+		Type:  RelationshipDefault,
+		From:  from,
+		To:    to,
+		Label: label,
+	}
+}
+
+// SetType sets the relationship type and returns the relationship for chaining
+func (r *Relationship) SetType(relationshipType relationshipType) *Relationship {
+	r.Type = relationshipType
+	return r
+}
+
+// SetTechnology sets the technology of the relationship, such as "HTTPS", and returns the relationship for chaining
+func (r *Relationship) SetTechnology(technology string) *Relationship {
+	r.Technology = technology
+	return r
+}
+
+// SetDescription sets the relationship description and returns the relationship for chaining
+func (r *Relationship) SetDescription(description string) *Relationship {
+	r.Description = description
+	return r
+}
+
+// SetSprite sets the icon of the relationship and returns the relationship for chaining
+func (r *Relationship) SetSprite(sprite string) *Relationship {
+	r.Sprite = sprite
+	return r
+}
+
+// SetTags sets the relationship tags and returns the relationship for chaining
+func (r *Relationship) SetTags(tags string) *Relationship {
+	r.Tags = tags
+	return r
+}
+
+// SetLink sets the URL the relationship links to and returns the relationship for chaining
+func (r *Relationship) SetLink(link string) *Relationship {
+	r.Link = link
+	return r
+}
+
+// String generates the Mermaid syntax for the relationship
+func (r *Relationship) String() string {
+	return basediagram.Indentation + formatCall(string(r.Type),
+		[]string{r.From.alias(), r.To.alias()},
+		[]string{r.Label, r.Technology, r.Description},
+		namedArgument{"sprite", r.Sprite},
+		namedArgument{"tags", r.Tags},
+		namedArgument{"link", r.Link},
+	) + "\n"
+}
@@ -0,0 +1,70 @@
+package c4
+
+import (
+	"testing"
+)
+
+func TestNewRelationship(t *testing.T) {
+	from := NewElement(KindPerson, "user", "User")
+	to := NewElement(KindSystem, "sys", "System")
+	got := NewRelationship(from, to, "Uses")
+
+	if got.From != from || got.To != to || got.Label != "Uses" || got.Type != RelationshipDefault {
+		t.Errorf("NewRelationship() = %+v, want Rel from user to sys", got)
+	}
+}
+
+func TestRelationship_Setters(t *testing.T) {
+	relationship := NewRelationship(nil, nil, "Uses")
+	result := relationship.
+		SetType(RelationshipBidirectional).
+		SetTechnology("HTTPS").
+		SetDescription("JSON").
+		SetSprite("https").
+		SetTags("v2").
+		SetLink("https://example.com")
+
+	if result != relationship {
+		t.Error("Setters should return relationship for chaining")
+	}
+
+	if relationship.Type != RelationshipBidirectional || relationship.Technology != "HTTPS" || relationship.Description != "JSON" ||
+		relationship.Sprite != "https" || relationship.Tags != "v2" || relationship.Link != "https://example.com" {
+		t.Errorf("Setters = %+v", relationship)
+	}
+}
+
+func TestRelationship_String(t *testing.T) {
+	user := NewElement(KindPerson, "user", "User")
+	sys := NewElement(KindSystem, "sys", "System")
+
+	tests := []struct {
+		name         string
+		relationship *Relationship
+		want         string
+	}{
+		{
+			name:         "Default relationship",
+			relationship: NewRelationship(user, sys, "Uses"),
+			want:         "    Rel(user, sys, \"Uses\")\n",
+		},
+		{
+			name:         "Relationship without label",
+			relationship: NewRelationship(user, sys, "").SetType(RelationshipUp),
+			want:         "    Rel_U(user, sys)\n",
+		},
+		{
+			name:         "Relationship with technology and tags",
+			relationship: NewRelationship(user, sys, "Reads").SetType(RelationshipBack).SetTechnology("HTTPS").SetTags("v2"),
+			want:         "    Rel_Back(user, sys, \"Reads\", \"HTTPS\", $tags=\"v2\")\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.relationship.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package c4
+
+import (
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Shape is an element or a boundary, the blocks that relationships and
+// styles refer to by alias.
+type Shape interface {
+	alias() string
+	writeTo(w *basediagram.Writer, curIndentation string)
+}
+
+// Scope holds the elements and boundaries placed directly in a diagram or
+// a boundary, in the order they were added.
+type Scope struct {
+	Shapes []Shape
+}
+
+// AddPerson creates and adds a new person
+func (s *Scope) AddPerson(alias string, label string) *Element {
+	return s.AddElement(KindPerson, alias, label)
+}
+
+// AddSystem creates and adds a new software system
+func (s *Scope) AddSystem(alias string, label string) *Element {
+	return s.AddElement(KindSystem, alias, label)
+}
+
+// AddContainer creates and adds a new container, such as an application or a data store
+func (s *Scope) AddContainer(alias string, label string) *Element {
+	return s.AddElement(KindContainer, alias, label)
+}
+
+// AddComponent creates and adds a new component of a container
+func (s *Scope) AddComponent(alias string, label string) *Element {
+	return s.AddElement(KindComponent, alias, label)
+}
+
+// AddElement creates and adds a new element of the given kind
+func (s *Scope) AddElement(kind elementKind, alias string, label string) *Element {
+	element := NewElement(kind, alias, label)
+	s.Shapes = append(s.Shapes, element)
+	return element
+}
+
+// AddBoundary creates and adds a new empty boundary
+func (s *Scope) AddBoundary(boundaryType boundaryType, alias string, label string) *Boundary {
+	boundary := NewBoundary(boundaryType, alias, label)
+	s.Shapes = append(s.Shapes, boundary)
+	return boundary
+}
+
+// writeTo writes the shapes of the scope to w with the specified indentation.
+func (s *Scope) writeTo(w *basediagram.Writer, curIndentation string) {
+	for _, shape := range s.Shapes {
+		if !isNil(shape) {
+			shape.writeTo(w, curIndentation)
+		}
+	}
+}
+
+// isNil reports whether shape is nil or holds a nil element or boundary.
+func isNil(shape Shape) bool {
+	switch s := shape.(type) {
+	case *Element:
+		return s == nil
+	case *Boundary:
+		return s == nil
+	}
+
+	return shape == nil
+}
@@ -0,0 +1,44 @@
+package c4
+
+import (
+	"testing"
+)
+
+func TestScope_Add(t *testing.T) {
+	var scope Scope
+
+	person := scope.AddPerson("user", "User")
+	system := scope.AddSystem("sys", "System")
+	container := scope.AddContainer("app", "App")
+	component := scope.AddComponent("comp", "Component")
+	boundary := scope.AddBoundary(BoundarySystem, "b", "Boundary")
+
+	tests := []struct {
+		name  string
+		shape Shape
+		kind  elementKind
+		alias string
+	}{
+		{name: "AddPerson", shape: person, kind: KindPerson, alias: "user"},
+		{name: "AddSystem", shape: system, kind: KindSystem, alias: "sys"},
+		{name: "AddContainer", shape: container, kind: KindContainer, alias: "app"},
+		{name: "AddComponent", shape: component, kind: KindComponent, alias: "comp"},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			element := tt.shape.(*Element)
+			if element.Kind != tt.kind || element.Alias != tt.alias {
+				t.Errorf("%s() = %+v, want %s %s", tt.name, element, tt.kind, tt.alias)
+			}
+
+			if scope.Shapes[i] != tt.shape {
+				t.Errorf("%s() shape %d = %v, want %v", tt.name, i, scope.Shapes[i], tt.shape)
+			}
+		})
+	}
+
+	if len(scope.Shapes) != 5 || scope.Shapes[4] != boundary {
+		t.Errorf("AddBoundary() shapes = %v, want boundary last", scope.Shapes)
+	}
+}
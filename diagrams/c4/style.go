@@ -0,0 +1,126 @@
+package c4
+
+import (
+	"strconv"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// ElementStyle overrides the colors of an element or a boundary.
+// Only the fields that are set are rendered.
+type ElementStyle struct {
+	Element     Shape
+	BgColor     string
+	FontColor   string
+	BorderColor string
+	Shadowing   bool
+	LegendText  string
+}
+
+// NewElementStyle creates a new empty style for the shape
+func NewElementStyle(element Shape) *ElementStyle {
+	return &ElementStyle{
+		Element: element,
+	}
+}
+
+// SetBgColor sets the background color and returns the style for chaining
+func (s *ElementStyle) SetBgColor(color string) *ElementStyle {
+	s.BgColor = color
+	return s
+}
+
+// SetFontColor sets the text color and returns the style for chaining
+func (s *ElementStyle) SetFontColor(color string) *ElementStyle {
+	s.FontColor = color
+	return s
+}
+
+// SetBorderColor sets the border color and returns the style for chaining
+func (s *ElementStyle) SetBorderColor(color string) *ElementStyle {
+	s.BorderColor = color
+	return s
+}
+
+// SetShadowing sets whether the shape casts a shadow and returns the style for chaining
+func (s *ElementStyle) SetShadowing(shadowing bool) *ElementStyle {
+	s.Shadowing = shadowing
+	return s
+}
+
+// SetLegendText sets the text shown for the style in the legend and returns the style for chaining
+func (s *ElementStyle) SetLegendText(text string) *ElementStyle {
+	s.LegendText = text
+	return s
+}
+
+// String generates the Mermaid syntax for the style
+func (s *ElementStyle) String() string {
+	var shadowing string
+	if s.Shadowing {
+		shadowing = "true"
+	}
+
+	return basediagram.Indentation + formatCall("UpdateElementStyle", []string{s.Element.alias()}, nil,
+		namedArgument{"bgColor", s.BgColor},
+		namedArgument{"fontColor", s.FontColor},
+		namedArgument{"borderColor", s.BorderColor},
+		namedArgument{"shadowing", shadowing},
+		namedArgument{"legendText", s.LegendText},
+	) + "\n"
+}
+
+// RelStyle overrides the colors and the label position of the relationships
+// between two shapes. Only the fields that are set are rendered.
+type RelStyle struct {
+	From      Shape
+	To        Shape
+	TextColor string
+	LineColor string
+	OffsetX   int
+	OffsetY   int
+}
+
+// NewRelStyle creates a new empty style for the relationships from one shape to another
+func NewRelStyle(from Shape, to Shape) *RelStyle {
+	return &RelStyle{
+		From: from,
+		To:   to,
+	}
+}
+
+// SetTextColor sets the label color and returns the style for chaining
+func (s *RelStyle) SetTextColor(color string) *RelStyle {
+	s.TextColor = color
+	return s
+}
+
+// SetLineColor sets the arrow color and returns the style for chaining
+func (s *RelStyle) SetLineColor(color string) *RelStyle {
+	s.LineColor = color
+	return s
+}
+
+// SetOffset moves the label by x and y pixels and returns the style for chaining
+func (s *RelStyle) SetOffset(x int, y int) *RelStyle {
+	s.OffsetX = x
+	s.OffsetY = y
+	return s
+}
+
+// String generates the Mermaid syntax for the style
+func (s *RelStyle) String() string {
+	return basediagram.Indentation + formatCall("UpdateRelStyle", []string{s.From.alias(), s.To.alias()}, nil,
+		namedArgument{"textColor", s.TextColor},
+		namedArgument{"lineColor", s.LineColor},
+		namedArgument{"offsetX", formatOffset(s.OffsetX)},
+		namedArgument{"offsetY", formatOffset(s.OffsetY)},
+	) + "\n"
+}
+
+func formatOffset(offset int) string {
+	if offset == 0 {
+		return ""
+	}
+	return strconv.Itoa(offset)
+}
@@ -0,0 +1,74 @@
+package c4
+
+import (
+	"testing"
+)
+
+func TestElementStyle_String(t *testing.T) {
+	user := NewElement(KindPerson, "user", "User")
+
+	tests := []struct {
+		name  string
+		style *ElementStyle
+		want  string
+	}{
+		{
+			name:  "Empty style",
+			style: NewElementStyle(user),
+			want:  "    UpdateElementStyle(user)\n",
+		},
+		{
+			name: "Style with all fields",
+			style: NewElementStyle(user).
+				SetBgColor("grey").
+				SetFontColor("red").
+				SetBorderColor("blue").
+				SetShadowing(true).
+				SetLegendText("Customer"),
+			want: "    UpdateElementStyle(user, $bgColor=\"grey\", $fontColor=\"red\", $borderColor=\"blue\", $shadowing=\"true\", $legendText=\"Customer\")\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.style.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelStyle_String(t *testing.T) {
+	user := NewElement(KindPerson, "user", "User")
+	sys := NewElement(KindSystem, "sys", "System")
+
+	tests := []struct {
+		name  string
+		style *RelStyle
+		want  string
+	}{
+		{
+			name:  "Empty style",
+			style: NewRelStyle(user, sys),
+			want:  "    UpdateRelStyle(user, sys)\n",
+		},
+		{
+			name:  "Style with colors and offset",
+			style: NewRelStyle(user, sys).SetTextColor("blue").SetLineColor("red").SetOffset(-40, 10),
+			want:  "    UpdateRelStyle(user, sys, $textColor=\"blue\", $lineColor=\"red\", $offsetX=\"-40\", $offsetY=\"10\")\n",
+		},
+		{
+			name:  "Style with vertical offset only",
+			style: NewRelStyle(user, sys).SetOffset(0, 5),
+			want:  "    UpdateRelStyle(user, sys, $offsetY=\"5\")\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.style.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
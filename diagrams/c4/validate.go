@@ -0,0 +1,155 @@
+package c4
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// an unknown diagram kind, missing or duplicate aliases, which elements and
+// boundaries share, aliases that are not a single word, unknown element kinds,
+// variants, boundary and relationship types, values that the macro of a shape
+// does not take, and relationships and styles that refer to shapes that are
+// missing or were never added to the diagram.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	switch d.Kind {
+	case DiagramContext, DiagramContainer, DiagramComponent, DiagramDynamic, DiagramDeployment:
+	default:
+		v.Error(basediagram.CodeInvalidValue, "Kind", "unknown diagram kind %q", d.Kind)
+	}
+
+	shapes := make(map[Shape]bool)
+	validateScope(&v, "", &d.Scope, shapes)
+
+	for i, relationship := range d.Relationships {
+		path := fmt.Sprintf("Relationships[%d]", i)
+		if relationship == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing relationship")
+			continue
+		}
+
+		switch relationship.Type {
+		case RelationshipDefault, RelationshipBidirectional, RelationshipUp, RelationshipDown,
+			RelationshipLeft, RelationshipRight, RelationshipBack:
+		default:
+			v.Error(basediagram.CodeInvalidValue, path+".Type", "unknown relationship type %q", relationship.Type)
+		}
+
+		validateShape(&v, path+".From", relationship.From, shapes)
+		validateShape(&v, path+".To", relationship.To, shapes)
+	}
+
+	for i, style := range d.ElementStyles {
+		path := fmt.Sprintf("ElementStyles[%d]", i)
+		if style == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing style")
+			continue
+		}
+
+		validateShape(&v, path+".Element", style.Element, shapes)
+	}
+
+	for i, style := range d.RelStyles {
+		path := fmt.Sprintf("RelStyles[%d]", i)
+		if style == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing style")
+			continue
+		}
+
+		validateShape(&v, path+".From", style.From, shapes)
+		validateShape(&v, path+".To", style.To, shapes)
+	}
+
+	return v.Errors()
+}
+
+// validateScope checks the shapes of scope and, recursively, of its boundaries,
+// recording them in shapes.
+func validateScope(v *basediagram.Validator, prefix string, scope *Scope, shapes map[Shape]bool) {
+	for i, shape := range scope.Shapes {
+		path := fmt.Sprintf("%sShapes[%d]", prefix, i)
+
+		switch s := shape.(type) {
+		case *Element:
+			if s == nil {
+				break
+			}
+			shapes[s] = true
+			validateElement(v, path, s)
+			continue
+		case *Boundary:
+			if s == nil {
+				break
+			}
+			shapes[s] = true
+			validateBoundary(v, path, s)
+			validateScope(v, path+".", &s.Scope, shapes)
+			continue
+		}
+
+		v.Error(basediagram.CodeMissingReference, path, "missing shape")
+	}
+}
+
+func validateElement(v *basediagram.Validator, path string, element *Element) {
+	validateAlias(v, path+".Alias", element.Alias)
+
+	switch element.Kind {
+	case KindPerson:
+		if element.Variant != VariantDefault {
+			v.Error(basediagram.CodeInvalidValue, path+".Variant", "a person cannot have the %q variant", element.Variant)
+		}
+	case KindSystem, KindContainer, KindComponent:
+		switch element.Variant {
+		case VariantDefault, VariantDb, VariantQueue:
+		default:
+			v.Error(basediagram.CodeInvalidValue, path+".Variant", "unknown variant %q", element.Variant)
+		}
+	default:
+		v.Error(basediagram.CodeInvalidValue, path+".Kind", "unknown element kind %q", element.Kind)
+	}
+
+	if element.Technology != "" && !element.hasTechnology() {
+		v.Warning(basediagram.CodeIgnoredValue, path+".Technology", "technology is ignored for %s", element.Macro())
+	}
+}
+
+func validateBoundary(v *basediagram.Validator, path string, boundary *Boundary) {
+	validateAlias(v, path+".Alias", boundary.Alias)
+
+	switch boundary.Type {
+	case BoundaryGeneric, BoundaryEnterprise, BoundarySystem, BoundaryContainer,
+		BoundaryDeploymentNode, BoundaryNode, BoundaryNodeLeft, BoundaryNodeRight:
+	default:
+		v.Error(basediagram.CodeInvalidValue, path+".Type", "unknown boundary type %q", boundary.Type)
+	}
+
+	if boundary.Kind != "" && !boundary.hasKind() {
+		v.Warning(basediagram.CodeIgnoredValue, path+".Kind", "kind is ignored for %s", boundary.Type)
+	}
+	if boundary.Description != "" && !boundary.isDeploymentNode() {
+		v.Warning(basediagram.CodeIgnoredValue, path+".Description", "description is ignored for %s", boundary.Type)
+	}
+}
+
+func validateAlias(v *basediagram.Validator, path string, alias string) {
+	if strings.IndexFunc(alias, func(r rune) bool { return !parser.IsIdentifier(r) }) >= 0 {
+		v.Error(basediagram.CodeInvalidValue, path, "alias %q is not a single word", alias)
+	}
+
+	v.UniqueID(path, alias)
+}
+
+func validateShape(v *basediagram.Validator, path string, shape Shape, shapes map[Shape]bool) {
+	switch {
+	case isNil(shape):
+		v.Error(basediagram.CodeMissingReference, path, "missing shape")
+	case !shapes[shape]:
+		v.Error(basediagram.CodeUnknownReference, path, "shape %q is not part of the diagram", shape.alias())
+	}
+}
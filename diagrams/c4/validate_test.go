@@ -0,0 +1,96 @@
+package c4
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				user := d.AddPerson("user", "User").SetExternal(true)
+				b := d.AddBoundary(BoundaryEnterprise, "b0", "Bank")
+				inner := b.AddBoundary(BoundaryGeneric, "b1", "Core").SetKind("Company")
+				db := inner.AddContainer("db", "Database").SetVariant(VariantDb).SetTechnology("SQL")
+				d.AddRelationship(user, db, "Reads").SetType(RelationshipBidirectional)
+				d.AddRelationship(user, inner, "Calls")
+				d.UpdateElementStyle(inner).SetBgColor("grey")
+				d.UpdateRelStyle(user, db).SetOffset(1, 2)
+			},
+		},
+		{
+			name: "Invalid shapes",
+			setup: func(d *Diagram) {
+				d.Kind = "C4Class"
+				d.AddPerson("", "User").SetVariant(VariantDb).SetTechnology("Brain")
+				d.AddElement("Actor", "actor", "Actor")
+				b := d.AddBoundary("Zone", "two words", "Zone").SetKind("Area").SetDescription("Text")
+				b.AddSystem("sys", "System").SetVariant("Cache")
+				b.Shapes = append(b.Shapes, nil)
+				d.AddSystem("sys", "Duplicate")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Kind", Message: `unknown diagram kind "C4Class"`},
+				{Code: basediagram.CodeEmptyID, Severity: basediagram.SeverityError, Path: "Shapes[0].Alias", Message: "missing ID"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Shapes[0].Variant", Message: `a person cannot have the "Db" variant`},
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Shapes[0].Technology", Message: "technology is ignored for PersonDb"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Shapes[1].Kind", Message: `unknown element kind "Actor"`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Shapes[2].Alias", Message: `alias "two words" is not a single word`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Shapes[2].Type", Message: `unknown boundary type "Zone"`},
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Shapes[2].Kind", Message: "kind is ignored for Zone"},
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Shapes[2].Description", Message: "description is ignored for Zone"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Shapes[2].Shapes[0].Variant", Message: `unknown variant "Cache"`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Shapes[2].Shapes[1]", Message: "missing shape"},
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Shapes[3].Alias", Message: `ID "sys" is already used by Shapes[2].Shapes[0].Alias`},
+			},
+		},
+		{
+			name: "Invalid references",
+			setup: func(d *Diagram) {
+				user := d.AddPerson("user", "User")
+				outsider := NewElement(KindSystem, "outsider", "Outsider")
+				var boundary *Boundary
+				d.AddRelationship(user, outsider, "Uses").SetType("Rel_X")
+				d.AddRelationship(boundary, user, "Uses")
+				d.Relationships = append(d.Relationships, nil)
+				d.UpdateElementStyle(outsider)
+				d.ElementStyles = append(d.ElementStyles, nil)
+				d.UpdateRelStyle(nil, user)
+				d.RelStyles = append(d.RelStyles, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Relationships[0].Type", Message: `unknown relationship type "Rel_X"`},
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "Relationships[0].To", Message: `shape "outsider" is not part of the diagram`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Relationships[1].From", Message: "missing shape"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Relationships[2]", Message: "missing relationship"},
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "ElementStyles[0].Element", Message: `shape "outsider" is not part of the diagram`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "ElementStyles[1]", Message: "missing style"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "RelStyles[0].From", Message: "missing shape"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "RelStyles[1]", Message: "missing style"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -77,7 +77,7 @@ func (c *Class) String(curIndentation string) string {
 
 	label := ""
 	if len(c.Label) > 0 {
-		label = fmt.Sprintf(string(baseClassLabelString), c.Label)
+		label = fmt.Sprintf(string(baseClassLabelString), basediagram.Escape(c.Label))
 	}
 
 	sb.WriteString(fmt.Sprintf(curIndentation, fmt.Sprintf(string(baseClassStartString), c.Name, label)))
@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	baseClassConfigurationProperties string = basediagram.Indentation + "class:\n"
+	classConfigurationSection        string = "class"
+	baseClassConfigurationProperties string = basediagram.Indentation + classConfigurationSection + ":\n"
 	classPropertyTitleTopMargin      string = "titleTopMargin"
 	classPropertyArrowMarkerAbsolute string = "arrowMarkerAbsolute"
 	classPropertyDividerMargin       string = "dividerMargin"
@@ -151,9 +152,7 @@ func (c ClassConfigurationProperties) String() string {
 
 	if len(c.properties) > 0 {
 		sb.WriteString(baseClassConfigurationProperties)
-		for _, prop := range c.properties {
-			sb.WriteString(prop.Format())
-		}
+		sb.WriteString(basediagram.FormatProperties(c.properties))
 	}
 
 	return sb.String()
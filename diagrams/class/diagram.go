@@ -2,7 +2,7 @@
 package class
 
 import (
-	"fmt"
+	"io"
 	"strings"
 
 	"github.com/TyphonHill/go-mermaid/diagrams/utils"
@@ -21,7 +21,8 @@ const (
 )
 
 const (
-	baseDiagramType                 string = "classDiagram\n"
+	diagramType                     string = "classDiagram"
+	baseDiagramType                 string = diagramType + "\n"
 	baseClassDiagramDirectionString string = basediagram.Indentation + "direction %s\n"
 )
 
@@ -55,33 +56,43 @@ func NewClassDiagram() (newClassDiagram *ClassDiagram) {
 // String generates the Mermaid syntax representation of the class diagram.
 func (cd *ClassDiagram) String() string {
 	var sb strings.Builder
+	cd.WriteTo(&sb)
+	return sb.String()
+}
 
-	sb.WriteString(baseDiagramType)
+// DiagramType returns the Mermaid keyword that introduces a class diagram.
+func (cd *ClassDiagram) DiagramType() string {
+	return diagramType
+}
 
-	sb.WriteString(fmt.Sprintf(string(baseClassDiagramDirectionString), string(cd.Direction)))
+// RenderToFile saves the diagram to a file at the specified path.
+func (cd *ClassDiagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, cd)
+}
 
-	for _, note := range cd.notes {
-		sb.WriteString(note.String())
-	}
+// WriteTo streams the diagram to w one element at a time.
+func (cd *ClassDiagram) WriteTo(w io.Writer) (int64, error) {
+	return cd.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
 
-	for _, namespace := range cd.namespaces {
-		sb.WriteString(namespace.String(""))
-	}
+		w.Printf(string(baseClassDiagramDirectionString), string(cd.Direction))
 
-	for _, class := range cd.classes {
-		sb.WriteString(class.String("%s"))
-	}
+		for _, note := range cd.notes {
+			w.WriteString(note.String())
+		}
 
-	for _, relation := range cd.relations {
-		sb.WriteString(relation.String())
-	}
+		for _, namespace := range cd.namespaces {
+			w.WriteString(namespace.String(""))
+		}
 
-	return cd.BaseDiagram.String(sb.String())
-}
+		for _, class := range cd.classes {
+			w.WriteString(class.String("%s"))
+		}
 
-// RenderToFile saves the diagram to a file at the specified path.
-func (cd *ClassDiagram) RenderToFile(path string) error {
-	return utils.RenderToFile(path, cd.String())
+		for _, relation := range cd.relations {
+			w.WriteString(relation.String())
+		}
+	})
 }
 
 // AddNamespace creates and adds a new namespace to the class diagram.
@@ -38,9 +38,9 @@ func (n *Note) String() string {
 	var sb strings.Builder
 
 	if n.Class == nil {
-		sb.WriteString(fmt.Sprintf(string(baseDiagramNoteString), n.Text))
+		sb.WriteString(fmt.Sprintf(string(baseDiagramNoteString), basediagram.Escape(n.Text)))
 	} else {
-		sb.WriteString(fmt.Sprintf(string(baseClassNoteString), n.Class.Name, n.Text))
+		sb.WriteString(fmt.Sprintf(string(baseClassNoteString), n.Class.Name, basediagram.Escape(n.Text)))
 	}
 
 	return sb.String()
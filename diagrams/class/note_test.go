@@ -79,14 +79,14 @@ func TestNote_String(t *testing.T) {
 			name: "Note with special characters",
 			note: NewNote("Note with \"quotes\" and special chars", nil),
 			contains: []string{
-				`note "Note with "quotes" and special chars"`,
+				`note "Note with #quot;quotes#quot; and special chars"`,
 			},
 		},
 		{
 			name: "Multiline note",
 			note: NewNote("First line\nSecond line", nil),
 			contains: []string{
-				"note \"First line\nSecond line\"",
+				`note "First line#10;Second line"`,
 			},
 		},
 	}
@@ -0,0 +1,378 @@
+package class
+
+import (
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+var (
+	// relationPattern matches `A "1" <|-- "*" B : label`.
+	relationPattern = regexp.MustCompile(`^(\w+)\s*(?:"([^"]*)")?\s*(<\||\|>|\*|o|<|>)?(--|\.\.)(<\||\|>|\*|o|<|>)?\s*(?:"([^"]*)")?\s*(\w+)\s*(?::\s*(.*))?$`)
+	// memberPattern matches the `A : +int age` member statement.
+	memberPattern = regexp.MustCompile(`^(\w+)\s*:\s*(.*)$`)
+	// annotationPattern matches the `<<interface>> A` annotation statement.
+	annotationPattern = regexp.MustCompile(`^(<<[^>]+>>)\s*(\w+)$`)
+)
+
+// openBlock is a namespace or class body awaiting its closing brace.
+type openBlock struct {
+	namespace *Namespace
+	class     *Class
+	line      parser.Line
+}
+
+type classParser struct {
+	diagram  *ClassDiagram
+	classes  map[string]*Class
+	declared map[*Class]bool
+	blocks   []openBlock
+}
+
+// Parse reads Mermaid class diagram syntax and returns the corresponding ClassDiagram.
+// It understands the syntax generated by ClassDiagram.String as well as the
+// `A : +member` and `<<annotation>> A` statements. Classes referenced before
+// being declared are added to the diagram, or moved to the namespace that
+// later declares them.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*ClassDiagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType, diagramType+"-v2")
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &classParser{
+		diagram:  NewClassDiagram(),
+		classes:  make(map[string]*Class),
+		declared: make(map[*Class]bool),
+	}
+	p.diagram.Title = doc.Title
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, classConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.blocks) > 0 {
+		return nil, p.blocks[len(p.blocks)-1].line.Errorf(0, "missing '}'")
+	}
+
+	return p.diagram, nil
+}
+
+func (p *classParser) parseLine(line parser.Line) error {
+	if len(p.blocks) > 0 && p.blocks[len(p.blocks)-1].class != nil {
+		return p.parseClassBody(line, p.blocks[len(p.blocks)-1].class)
+	}
+
+	if line.Text == "}" {
+		if len(p.blocks) == 0 {
+			return line.Errorf(0, "unexpected '}'")
+		}
+		p.blocks = p.blocks[:len(p.blocks)-1]
+		return nil
+	}
+
+	s := parser.NewScanner(line)
+	keyword := s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) && r != '{' })
+	s.SkipSpaces()
+
+	switch keyword {
+	case "direction":
+		return p.parseDirection(s)
+	case "note":
+		return p.parseNote(s)
+	case "namespace":
+		return p.parseNamespace(s)
+	case "class":
+		return p.parseClass(s)
+	case "click", "link", "callback", "style", "classDef", "cssClass":
+		return line.Errorf(0, "unsupported statement %q", keyword)
+	}
+
+	if m := relationPattern.FindStringSubmatch(line.Text); m != nil {
+		p.parseRelation(m)
+		return nil
+	}
+
+	if m := annotationPattern.FindStringSubmatch(line.Text); m != nil {
+		p.class(m[2]).Annotation = classAnnotation(m[1])
+		return nil
+	}
+
+	if m := memberPattern.FindStringSubmatchIndex(line.Text); m != nil {
+		class := p.class(line.Text[m[2]:m[3]])
+		return p.parseMember(line, m[4], class)
+	}
+
+	return line.Errorf(0, "unexpected %q", line.Text)
+}
+
+func (p *classParser) parseDirection(s *parser.Scanner) error {
+	direction := classDiagramDirection(s.Rest())
+	switch direction {
+	case ClassDiagramDirectionTopToBottom, ClassDiagramDirectionBottomUp, ClassDiagramDirectionRightLeft, ClassDiagramDirectionLeftRight:
+		p.diagram.Direction = direction
+	default:
+		return s.Errorf("unknown direction %q", s.Rest())
+	}
+	return nil
+}
+
+// parseNote reads `note "text"` or `note for A "text"`.
+func (p *classParser) parseNote(s *parser.Scanner) error {
+	var class *Class
+	if s.Consume("for ") {
+		s.SkipSpaces()
+		name := s.ReadWhile(parser.IsIdentifier)
+		if name == "" {
+			return s.Errorf("expected class name")
+		}
+		class = p.class(name)
+		s.SkipSpaces()
+	}
+
+	text, err := s.ReadQuoted()
+	if err != nil {
+		return err
+	}
+	if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	p.diagram.AddNote(basediagram.Unescape(text), class)
+
+	return nil
+}
+
+// parseNamespace reads `namespace Name{`.
+func (p *classParser) parseNamespace(s *parser.Scanner) error {
+	name := s.ReadWhile(parser.IsIdentifier)
+	if name == "" {
+		return s.Errorf("expected namespace name")
+	}
+	s.SkipSpaces()
+	if !s.Consume("{") || !s.EOF() {
+		return s.Errorf("expected '{'")
+	}
+
+	var namespace *Namespace
+	if parent := p.namespace(); parent != nil {
+		namespace = parent.AddNamespace(name)
+	} else {
+		namespace = p.diagram.AddNamespace(name)
+	}
+
+	p.blocks = append(p.blocks, openBlock{namespace: namespace, line: s.Line()})
+
+	return nil
+}
+
+// parseClass reads `class Name`, optionally followed by a `["label"]` and a body.
+func (p *classParser) parseClass(s *parser.Scanner) error {
+	name := s.ReadWhile(parser.IsIdentifier)
+	if name == "" {
+		return s.Errorf("expected class name")
+	}
+	if s.HasPrefix("~") {
+		return s.Errorf("unsupported generic class %q", name)
+	}
+
+	class := p.declare(name)
+
+	if s.Consume("[") {
+		label, err := s.ReadQuoted()
+		if err != nil {
+			return err
+		}
+		if !s.Consume("]") {
+			return s.Errorf("expected ']'")
+		}
+		class.Label = basediagram.Unescape(label)
+	}
+
+	s.SkipSpaces()
+	if s.Consume("{") {
+		p.blocks = append(p.blocks, openBlock{class: class, line: s.Line()})
+	}
+	if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	return nil
+}
+
+// parseClassBody reads the annotation and members declared between the class braces.
+func (p *classParser) parseClassBody(line parser.Line, class *Class) error {
+	switch {
+	case line.Text == "}":
+		p.blocks = p.blocks[:len(p.blocks)-1]
+		return nil
+	case strings.HasPrefix(line.Text, "<<") && strings.HasSuffix(line.Text, ">>"):
+		class.Annotation = classAnnotation(line.Text)
+		return nil
+	}
+
+	return p.parseMember(line, 0, class)
+}
+
+// parseMember reads a field such as `+int age` or a method such as
+// `+eat(food:Food)* bool`, starting at byte offset pos of the line text.
+func (p *classParser) parseMember(line parser.Line, pos int, class *Class) error {
+	s := parser.NewScanner(line)
+	s.Advance(pos)
+
+	visibility := ""
+	if rest := s.Rest(); rest != "" && strings.ContainsAny(rest[:1], "+-#~") {
+		visibility = rest[:1]
+		s.Advance(1)
+	}
+	s.SkipSpaces()
+
+	if !strings.Contains(s.Rest(), "(") {
+		text := s.Rest()
+		if text == "" {
+			return s.Errorf("expected member")
+		}
+
+		field := &Field{Visibility: fieldVisibility(visibility)}
+		if strings.HasSuffix(text, string(FieldClassifierStatic)) {
+			field.Classifier = FieldClassifierStatic
+			text = strings.TrimSpace(strings.TrimSuffix(text, string(FieldClassifierStatic)))
+		}
+		if i := strings.LastIndexAny(text, " \t"); i >= 0 {
+			field.Type = strings.TrimSpace(text[:i])
+			field.Name = text[i+1:]
+		} else {
+			field.Name = text
+		}
+
+		class.fields = append(class.fields, field)
+		return nil
+	}
+
+	name, _ := s.ReadUntil("(")
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return s.Errorf("expected method name")
+	}
+
+	paramsPos := s.Pos()
+	params, ok := s.ReadUntil(")")
+	if !ok {
+		return s.ErrorAt(paramsPos-1, "missing ')'")
+	}
+
+	method := &Method{Name: name, Visibility: methodVisibility(visibility)}
+	for _, param := range strings.Split(params, ",") {
+		param = strings.TrimSpace(param)
+		if param == "" {
+			continue
+		}
+
+		var parameter Parameter
+		if paramName, paramType, found := strings.Cut(param, ":"); found {
+			parameter = Parameter{Name: strings.TrimSpace(paramName), Type: strings.TrimSpace(paramType)}
+		} else if i := strings.LastIndexAny(param, " \t"); i >= 0 {
+			parameter = Parameter{Name: param[i+1:], Type: strings.TrimSpace(param[:i])}
+		} else {
+			parameter = Parameter{Name: param}
+		}
+		method.Parameters = append(method.Parameters, parameter)
+	}
+
+	rest := strings.TrimSpace(s.Rest())
+	for _, classifier := range []methodClassifier{MethodClassifierAbstract, MethodClassifierStatic} {
+		if strings.HasPrefix(rest, string(classifier)) {
+			method.Classifier = classifier
+			rest = strings.TrimSpace(rest[1:])
+		} else if method.Classifier == "" && strings.HasSuffix(rest, string(classifier)) {
+			method.Classifier = classifier
+			rest = strings.TrimSpace(rest[:len(rest)-1])
+		}
+	}
+	method.ReturnType = rest
+
+	class.methods = append(class.methods, method)
+	return nil
+}
+
+func (p *classParser) parseRelation(m []string) {
+	relation := p.diagram.AddRelation(p.class(m[1]), p.class(m[7]))
+	relation.RelationToClassA = relationType(m[3])
+	relation.Link = relationLink(m[4])
+	relation.RelationToClassB = relationType(m[5])
+	relation.Label = basediagram.Unescape(strings.TrimSpace(m[8]))
+
+	if m[2] != "" {
+		relation.CardinalityToClassA = relationCardinality(`"` + m[2] + `"`)
+	}
+	if m[6] != "" {
+		relation.CardinalityToClassB = relationCardinality(`"` + m[6] + `"`)
+	}
+}
+
+// namespace returns the innermost open namespace, if any.
+func (p *classParser) namespace() *Namespace {
+	for i := len(p.blocks) - 1; i >= 0; i-- {
+		if p.blocks[i].namespace != nil {
+			return p.blocks[i].namespace
+		}
+	}
+	return nil
+}
+
+// class returns the class with the given name, adding it to the diagram on first use.
+func (p *classParser) class(name string) *Class {
+	if class, ok := p.classes[name]; ok {
+		return class
+	}
+
+	class := p.diagram.AddClass(name, nil)
+	p.classes[name] = class
+
+	return class
+}
+
+// declare returns the class declared with the given name in the current
+// namespace, moving it there if it was only referenced so far.
+func (p *classParser) declare(name string) *Class {
+	namespace := p.namespace()
+
+	class, ok := p.classes[name]
+	if !ok {
+		class = p.diagram.AddClass(name, namespace)
+		p.classes[name] = class
+		p.declared[class] = true
+		return class
+	}
+
+	if !p.declared[class] && namespace != nil {
+		for i, c := range p.diagram.classes {
+			if c == class {
+				p.diagram.classes = append(p.diagram.classes[:i], p.diagram.classes[i+1:]...)
+				break
+			}
+		}
+		namespace.AddClass(class)
+	}
+	p.declared[class] = true
+
+	return class
+}
@@ -0,0 +1,263 @@
+package class
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*ClassDiagram)
+	}{
+		{
+			name:  "Empty class diagram",
+			setup: func(cd *ClassDiagram) {},
+		},
+		{
+			name: "Class diagram with title, direction and markdown fence",
+			setup: func(cd *ClassDiagram) {
+				cd.Title = "My Classes"
+				cd.SetDirection(ClassDiagramDirectionLeftRight)
+				cd.EnableMarkdownFence()
+				cd.AddClass("Animal", nil)
+			},
+		},
+		{
+			name: "Class diagram with members, annotations and namespaces",
+			setup: func(cd *ClassDiagram) {
+				ns := cd.AddNamespace("Shapes")
+				animal := cd.AddClass("Animal", ns)
+				animal.SetLabel("An animal").SetAnnotation(ClassAnnotationAbstract)
+				animal.AddField("age", "int").Classifier = FieldClassifierStatic
+				animal.AddField("name", "String").SetVisibility(FieldVisibilityPrivate)
+				eat := animal.AddMethod("eat").SetReturnType("bool").SetClassifier(MethodClassifierAbstract)
+				eat.AddParameter("food", "Food")
+				eat.AddParameter("amount", "int")
+
+				dog := cd.AddClass("Dog", nil)
+				dog.AddMethod("bark").SetVisibility(MethodVisibilityProtected)
+				dog.AddMethod("create").SetClassifier(MethodClassifierStatic).SetReturnType("Dog")
+
+				cd.AddNote("general", nil)
+				cd.AddNote("dog note", dog)
+			},
+		},
+		{
+			name: "Class diagram with text that needs escaping",
+			setup: func(cd *ClassDiagram) {
+				duck := cd.AddClass("Duck", nil).SetLabel(`The "duck"`)
+				cd.AddRelation(cd.AddClass("Animal", nil), duck).Label = "is a; #1"
+				cd.AddNote("Quack \"loud\"\nand clear", duck)
+			},
+		},
+		{
+			name: "Class diagram with every relation end and cardinality",
+			setup: func(cd *ClassDiagram) {
+				a := cd.AddClass("A", nil)
+				b := cd.AddClass("B", nil)
+				types := []relationType{"", RelationTypeAssociation, RelationTypeAssociationLeft, RelationTypeInheritance, RelationTypeInheritanceLeft, RelationTypeComposition, RelationTypeAggregation}
+				for _, typeA := range types {
+					for _, typeB := range types {
+						relation := cd.AddRelation(a, b)
+						relation.RelationToClassA = typeA
+						relation.RelationToClassB = typeB
+					}
+				}
+				cardinalities := []relationCardinality{RelationCardinalityOnlyOne, RelationCardinalityZeroOrOne, RelationCardinalityOneOrMore, RelationCardinalityMany, RelationCardinalityN, RelationCardinalityZeroToN, RelationCardinalityOneToN}
+				for _, cardinality := range cardinalities {
+					relation := cd.AddRelation(a, b)
+					relation.CardinalityToClassA = cardinality
+					relation.CardinalityToClassB = RelationCardinalityMany
+					relation.Link = RelationLinkDashed
+					relation.Label = "uses"
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewClassDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `classDiagram
+    %% a comment
+    Animal <|-- Duck
+    Animal "1" *-- "many" Leg : has
+    Animal : +int age
+    Animal : +isMammal() bool
+    <<interface>> Animal
+    namespace Zoo {
+        class Duck {
+            +String beakColor
+            -swim(int meters)$
+        }
+    }
+    note for Duck "can fly"
+`
+
+	cd, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if len(cd.classes) != 2 || cd.classes[0].Name != "Animal" || cd.classes[1].Name != "Leg" {
+		t.Fatalf("Parse() classes = %v, want [Animal Leg]", cd.classes)
+	}
+
+	animal := cd.classes[0]
+	if animal.Annotation != "<<interface>>" {
+		t.Errorf("Parse() annotation = %v, want %v", animal.Annotation, "<<interface>>")
+	}
+	if len(animal.fields) != 1 || animal.fields[0].Type != "int" || animal.fields[0].Name != "age" {
+		t.Errorf("Parse() fields = %+v, want +int age", animal.fields)
+	}
+	if len(animal.methods) != 1 || animal.methods[0].Name != "isMammal" || animal.methods[0].ReturnType != "bool" {
+		t.Errorf("Parse() methods = %+v, want +isMammal() bool", animal.methods)
+	}
+
+	if len(cd.namespaces) != 1 || len(cd.namespaces[0].Classes) != 1 {
+		t.Fatalf("Parse() namespaces = %+v, want Zoo with Duck", cd.namespaces)
+	}
+
+	duck := cd.namespaces[0].Classes[0]
+	if duck.Name != "Duck" || len(duck.fields) != 1 || len(duck.methods) != 1 {
+		t.Fatalf("Parse() Duck = %+v, want one field and one method", duck)
+	}
+
+	swim := duck.methods[0]
+	if swim.Visibility != MethodVisibilityPrivate || swim.Classifier != MethodClassifierStatic ||
+		len(swim.Parameters) != 1 || swim.Parameters[0] != (Parameter{Name: "meters", Type: "int"}) {
+		t.Errorf("Parse() swim = %+v, want -swim(meters:int)$", swim)
+	}
+
+	wantRelations := []string{
+		"Animal <|-- Duck",
+		`Animal "1"*--"many" Leg : has`,
+	}
+	if len(cd.relations) != len(wantRelations) {
+		t.Fatalf("Parse() got %d relations, want %d", len(cd.relations), len(wantRelations))
+	}
+	for i, want := range wantRelations {
+		if got := strings.TrimSpace(cd.relations[i].String()); got != want {
+			t.Errorf("Parse() relation %d = %q, want %q", i, got, want)
+		}
+	}
+	if cd.relations[0].ClassB != duck {
+		t.Errorf("Parse() relation target = %p, want declared Duck %p", cd.relations[0].ClassB, duck)
+	}
+
+	if len(cd.notes) != 1 || cd.notes[0].Class != duck || cd.notes[0].Text != "can fly" {
+		t.Errorf("Parse() notes = %+v, want note for Duck", cd.notes)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "class A\n",
+			line:    1,
+			column:  1,
+			message: "expected classDiagram declaration",
+		},
+		{
+			name:    "Unknown direction",
+			input:   "classDiagram\n    direction XY\n",
+			line:    2,
+			column:  15,
+			message: `unknown direction "XY"`,
+		},
+		{
+			name:    "Unterminated note",
+			input:   "classDiagram\n    note for A \"text\n",
+			line:    2,
+			column:  16,
+			message: "unterminated string",
+		},
+		{
+			name:    "Unbalanced brace",
+			input:   "classDiagram\n    }\n",
+			line:    2,
+			column:  5,
+			message: "unexpected '}'",
+		},
+		{
+			name:    "Unterminated class body",
+			input:   "classDiagram\n    class A{\n    +int age\n",
+			line:    2,
+			column:  5,
+			message: "missing '}'",
+		},
+		{
+			name:    "Unterminated method parameters",
+			input:   "classDiagram\n    class A{\n    +eat(food\n    }\n",
+			line:    3,
+			column:  9,
+			message: "missing ')'",
+		},
+		{
+			name:    "Generic class",
+			input:   "classDiagram\n    class Square~Shape~\n",
+			line:    2,
+			column:  17,
+			message: `unsupported generic class "Square"`,
+		},
+		{
+			name:    "Invalid relation",
+			input:   "classDiagram\n    A -> B\n",
+			line:    2,
+			column:  5,
+			message: `unexpected "A -> B"`,
+		},
+		{
+			name:    "Unsupported statement",
+			input:   "classDiagram\n    click A call callback()\n",
+			line:    2,
+			column:  5,
+			message: `unsupported statement "click"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
@@ -82,7 +82,7 @@ func (r *Relation) String() string {
 
 	label := ""
 	if len(r.Label) > 0 {
-		label = fmt.Sprintf(string(baseRelationTextString), r.Label)
+		label = fmt.Sprintf(string(baseRelationTextString), basediagram.Escape(r.Label))
 	}
 
 	sb.WriteString(fmt.Sprintf(string(baseRelationString), r.ClassA.Name, r.CardinalityToClassA, r.RelationToClassA, r.Link, r.RelationToClassB, r.CardinalityToClassB, r.ClassB.Name, label))
@@ -0,0 +1,57 @@
+package class
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the class diagram for problems that String would render
+// silently: duplicate class names, relations and notes referring to classes
+// that are missing or were never added, and nested namespaces, which are
+// dropped from the output.
+// Paths name the unexported fields of the diagram, e.g. "relations[0].ClassB".
+func (cd *ClassDiagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	classes := make(map[*Class]bool)
+	for i, class := range cd.classes {
+		path := fmt.Sprintf("classes[%d]", i)
+		v.UniqueID(path, class.Name)
+		classes[class] = true
+	}
+
+	for i, namespace := range cd.namespaces {
+		path := fmt.Sprintf("namespaces[%d]", i)
+		for j, class := range namespace.Classes {
+			v.UniqueID(fmt.Sprintf("%s.Classes[%d]", path, j), class.Name)
+			classes[class] = true
+		}
+		for j, child := range namespace.Children {
+			v.Warning(basediagram.CodeIgnoredValue, fmt.Sprintf("%s.Children[%d]", path, j), "nested namespace %q is not rendered", child.Name)
+		}
+	}
+
+	for i, relation := range cd.relations {
+		path := fmt.Sprintf("relations[%d]", i)
+		validateClass(&v, path+".ClassA", relation.ClassA, classes)
+		validateClass(&v, path+".ClassB", relation.ClassB, classes)
+	}
+
+	for i, note := range cd.notes {
+		if note.Class != nil {
+			validateClass(&v, fmt.Sprintf("notes[%d].Class", i), note.Class, classes)
+		}
+	}
+
+	return v.Errors()
+}
+
+func validateClass(v *basediagram.Validator, path string, class *Class, classes map[*Class]bool) {
+	switch {
+	case class == nil:
+		v.Error(basediagram.CodeMissingReference, path, "missing class")
+	case !classes[class]:
+		v.Error(basediagram.CodeUnknownReference, path, "class %q is not part of the diagram", class.Name)
+	}
+}
@@ -0,0 +1,75 @@
+package class
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestClassDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*ClassDiagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(cd *ClassDiagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(cd *ClassDiagram) {
+				animal := cd.AddClass("Animal", nil)
+				duck := cd.AddClass("Duck", cd.AddNamespace("Birds"))
+				cd.AddRelation(animal, duck)
+				cd.AddNote("Quack", duck)
+				cd.AddNote("General", nil)
+			},
+		},
+		{
+			name: "Relation to a class that was never added",
+			setup: func(cd *ClassDiagram) {
+				cd.AddRelation(cd.AddClass("Animal", nil), NewClass("Ghost"))
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "relations[0].ClassB", Message: `class "Ghost" is not part of the diagram`},
+			},
+		},
+		{
+			name: "Relation without class and note on an unknown class",
+			setup: func(cd *ClassDiagram) {
+				cd.AddRelation(nil, cd.AddClass("Animal", nil))
+				cd.AddNote("Boo", NewClass("Ghost"))
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "relations[0].ClassA", Message: "missing class"},
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "notes[0].Class", Message: `class "Ghost" is not part of the diagram`},
+			},
+		},
+		{
+			name: "Duplicate class names and nested namespace",
+			setup: func(cd *ClassDiagram) {
+				cd.AddClass("Animal", nil)
+				zoo := cd.AddNamespace("Zoo")
+				cd.AddClass("Animal", zoo)
+				zoo.AddNamespace("Aquarium")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "namespaces[0].Classes[0]", Message: `ID "Animal" is already used by classes[0]`},
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "namespaces[0].Children[0]", Message: `nested namespace "Aquarium" is not rendered`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cd := NewClassDiagram()
+			tt.setup(cd)
+
+			if got := cd.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
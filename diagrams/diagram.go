@@ -0,0 +1,36 @@
+// Package diagrams defines the contract shared by every Mermaid diagram type
+package diagrams
+
+import (
+	"io"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Diagram is implemented by every diagram type of this module, allowing
+// diagrams of different kinds to be handled uniformly.
+type Diagram interface {
+	// DiagramType returns the Mermaid keyword that introduces the diagram,
+	// e.g. "flowchart" or "sequenceDiagram".
+	DiagramType() string
+
+	// GetTitle returns the diagram title rendered in the frontmatter.
+	GetTitle() string
+
+	// GetConfig returns the diagram configuration rendered in the frontmatter.
+	GetConfig() basediagram.DiagramProperties
+
+	// String generates the Mermaid syntax for the diagram.
+	String() string
+
+	// WriteTo writes the Mermaid syntax for the diagram to w.
+	io.WriterTo
+
+	// Validate reports the problems of the diagram model that would render
+	// incorrectly, or not at all, in Mermaid. An empty result means no problem
+	// was found.
+	Validate() []basediagram.ValidationError
+
+	// RenderToFile saves the diagram to a file at the specified path.
+	RenderToFile(path string) error
+}
@@ -0,0 +1,475 @@
+package diagrams_test
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams"
+	"github.com/TyphonHill/go-mermaid/diagrams/architecture"
+	"github.com/TyphonHill/go-mermaid/diagrams/block"
+	"github.com/TyphonHill/go-mermaid/diagrams/c4"
+	"github.com/TyphonHill/go-mermaid/diagrams/class"
+	"github.com/TyphonHill/go-mermaid/diagrams/entityrelationship"
+	"github.com/TyphonHill/go-mermaid/diagrams/flowchart"
+	"github.com/TyphonHill/go-mermaid/diagrams/gantt"
+	"github.com/TyphonHill/go-mermaid/diagrams/gitgraph"
+	"github.com/TyphonHill/go-mermaid/diagrams/kanban"
+	"github.com/TyphonHill/go-mermaid/diagrams/mindmap"
+	"github.com/TyphonHill/go-mermaid/diagrams/packet"
+	"github.com/TyphonHill/go-mermaid/diagrams/pie"
+	"github.com/TyphonHill/go-mermaid/diagrams/quadrant"
+	"github.com/TyphonHill/go-mermaid/diagrams/radar"
+	"github.com/TyphonHill/go-mermaid/diagrams/requirement"
+	"github.com/TyphonHill/go-mermaid/diagrams/sankey"
+	"github.com/TyphonHill/go-mermaid/diagrams/sequence"
+	"github.com/TyphonHill/go-mermaid/diagrams/state"
+	"github.com/TyphonHill/go-mermaid/diagrams/timeline"
+	"github.com/TyphonHill/go-mermaid/diagrams/treemap"
+	"github.com/TyphonHill/go-mermaid/diagrams/userjourney"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/testutils"
+	"github.com/TyphonHill/go-mermaid/diagrams/xychart"
+)
+
+func TestDiagram_Implementations(t *testing.T) {
+	tests := []struct {
+		name        string
+		diagram     diagrams.Diagram
+		diagramType string
+	}{
+		{
+			name:        "Flowchart",
+			diagram:     flowchart.NewFlowchart(),
+			diagramType: "flowchart",
+		},
+		{
+			name:        "Sequence diagram",
+			diagram:     sequence.NewDiagram(),
+			diagramType: "sequenceDiagram",
+		},
+		{
+			name:        "Class diagram",
+			diagram:     class.NewClassDiagram(),
+			diagramType: "classDiagram",
+		},
+		{
+			name:        "State diagram",
+			diagram:     state.NewDiagram(),
+			diagramType: "stateDiagram-v2",
+		},
+		{
+			name:        "Entity relationship diagram",
+			diagram:     entityrelationship.NewDiagram(),
+			diagramType: "erDiagram",
+		},
+		{
+			name:        "Block diagram",
+			diagram:     block.NewDiagram(),
+			diagramType: "block-beta",
+		},
+		{
+			name:        "Timeline diagram",
+			diagram:     timeline.NewDiagram(),
+			diagramType: "timeline",
+		},
+		{
+			name:        "User journey diagram",
+			diagram:     userjourney.NewDiagram(),
+			diagramType: "journey",
+		},
+		{
+			name:        "Gantt chart",
+			diagram:     gantt.NewDiagram(),
+			diagramType: "gantt",
+		},
+		{
+			name:        "Pie chart",
+			diagram:     pie.NewDiagram(),
+			diagramType: "pie",
+		},
+		{
+			name:        "Mindmap",
+			diagram:     mindmap.NewDiagram(),
+			diagramType: "mindmap",
+		},
+		{
+			name:        "Git graph",
+			diagram:     gitgraph.NewDiagram(),
+			diagramType: "gitGraph",
+		},
+		{
+			name:        "Kanban board",
+			diagram:     kanban.NewDiagram(),
+			diagramType: "kanban",
+		},
+		{
+			name:        "Quadrant chart",
+			diagram:     quadrant.NewDiagram(),
+			diagramType: "quadrantChart",
+		},
+		{
+			name:        "XY chart",
+			diagram:     xychart.NewDiagram(),
+			diagramType: "xychart-beta",
+		},
+		{
+			name:        "Sankey diagram",
+			diagram:     sankey.NewDiagram(),
+			diagramType: "sankey-beta",
+		},
+		{
+			name:        "Requirement diagram",
+			diagram:     requirement.NewDiagram(),
+			diagramType: "requirementDiagram",
+		},
+		{
+			name:        "C4 diagram",
+			diagram:     c4.NewDiagram(),
+			diagramType: "C4Context",
+		},
+		{
+			name:        "architecture diagram",
+			diagram:     architecture.NewDiagram(),
+			diagramType: "architecture-beta",
+		},
+		{
+			name:        "packet diagram",
+			diagram:     packet.NewDiagram(),
+			diagramType: "packet-beta",
+		},
+		{
+			name:        "radar chart",
+			diagram:     radar.NewDiagram(),
+			diagramType: "radar-beta",
+		},
+		{
+			name:        "treemap",
+			diagram:     treemap.NewDiagram(),
+			diagramType: "treemap-beta",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.diagram.DiagramType(); got != tt.diagramType {
+				t.Errorf("DiagramType() = %v, want %v", got, tt.diagramType)
+			}
+
+			if got := tt.diagram.GetTitle(); got != "" {
+				t.Errorf("GetTitle() = %v, want empty title", got)
+			}
+
+			if got := tt.diagram.GetConfig().String(); !strings.Contains(got, "theme: default") {
+				t.Errorf("GetConfig() missing expected content %q in:\n%s", "theme: default", got)
+			}
+
+			want := tt.diagram.String()
+			if !strings.Contains(want, tt.diagramType+"\n") && !strings.Contains(want, tt.diagramType+" ") {
+				t.Errorf("String() missing diagram type %q in:\n%s", tt.diagramType, want)
+			}
+
+			var sb strings.Builder
+			n, err := tt.diagram.WriteTo(&sb)
+			if err != nil {
+				t.Fatalf("WriteTo() error = %v", err)
+			}
+			if n != int64(len(want)) {
+				t.Errorf("WriteTo() wrote %d bytes, want %d", n, len(want))
+			}
+			if sb.String() != want {
+				t.Errorf("WriteTo() = %q, want %q", sb.String(), want)
+			}
+
+			if got := tt.diagram.Validate(); len(got) != 0 {
+				t.Errorf("Validate() = %+v, want no problem for an empty diagram", got)
+			}
+
+			path := filepath.Join(t.TempDir(), "diagram.md")
+			if err := tt.diagram.RenderToFile(path); err != nil {
+				t.Fatalf("RenderToFile() error = %v", err)
+			}
+			testutils.AssertFileContent(t, path, want)
+		})
+	}
+}
+
+func TestDiagram_StableOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		diagram func() diagrams.Diagram
+	}{
+		{
+			name: "Flowchart",
+			diagram: func() diagrams.Diagram {
+				d := flowchart.NewFlowchart()
+				d.Config.SetTitleTopMargin(10).SetDiagramPadding(8).SetHtmlLabels(false)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Sequence diagram",
+			diagram: func() diagrams.Diagram {
+				d := sequence.NewDiagram()
+				d.Config.SetArrowMarkerAbsolute(true).SetHideUnusedParticipants(true).SetActivationWidth(12)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Class diagram",
+			diagram: func() diagrams.Diagram {
+				d := class.NewClassDiagram()
+				d.Config.SetTitleTopMargin(10).SetArrowMarkerAbsolute(true).SetDividerMargin(4)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "State diagram",
+			diagram: func() diagrams.Diagram {
+				d := state.NewDiagram()
+				d.Config.SetTitleTopMargin(10).SetArrowMarkerAbsolute(true).SetDividerMargin(4)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Entity relationship diagram",
+			diagram: func() diagrams.Diagram {
+				d := entityrelationship.NewDiagram()
+				d.Config.SetTitleTopMargin(10).SetDiagramPadding(8).SetLayoutDirection("LR")
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Block diagram",
+			diagram: func() diagrams.Diagram {
+				d := block.NewDiagram()
+				d.Config.SetPadding(8)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Timeline diagram",
+			diagram: func() diagrams.Diagram {
+				d := timeline.NewDiagram()
+				d.Config.SetDisableMulticolor(true).SetDiagramMarginX(20).SetDiagramMarginY(30)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "User journey diagram",
+			diagram: func() diagrams.Diagram {
+				d := userjourney.NewDiagram()
+				d.Config.SetDiagramMarginX(20).SetDiagramMarginY(30).SetLeftMargin(40)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Gantt chart",
+			diagram: func() diagrams.Diagram {
+				d := gantt.NewDiagram()
+				d.Config.SetBarHeight(20).SetBarGap(4).SetTopPadding(50)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Pie chart",
+			diagram: func() diagrams.Diagram {
+				d := pie.NewDiagram()
+				d.Config.SetTextPosition(0.5).SetUseMaxWidth(false).SetUseWidth(800)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Mindmap",
+			diagram: func() diagrams.Diagram {
+				d := mindmap.NewDiagram()
+				d.Config.SetPadding(12).SetMaxNodeWidth(150).SetUseMaxWidth(false)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Git graph",
+			diagram: func() diagrams.Diagram {
+				d := gitgraph.NewDiagram()
+				d.Config.SetShowBranches(false).SetMainBranchName("trunk").SetRotateCommitLabel(false)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Kanban board",
+			diagram: func() diagrams.Diagram {
+				d := kanban.NewDiagram()
+				d.Config.SetTicketBaseURL("https://example.com/#TICKET#").SetPadding(8).SetSectionWidth(200)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Quadrant chart",
+			diagram: func() diagrams.Diagram {
+				d := quadrant.NewDiagram()
+				d.Config.SetChartWidth(500).SetChartHeight(400).SetQuadrantExternalBorderStrokeWidth(2)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "XY chart",
+			diagram: func() diagrams.Diagram {
+				d := xychart.NewDiagram()
+				d.Config.SetWidth(700).SetHeight(500).SetShowTitle(false)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Sankey diagram",
+			diagram: func() diagrams.Diagram {
+				d := sankey.NewDiagram()
+				d.Config.SetWidth(900).SetHeight(500).SetNodeAlignment("left")
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "Requirement diagram",
+			diagram: func() diagrams.Diagram {
+				d := requirement.NewDiagram()
+				d.Config.SetRectMinWidth(250).SetRectPadding(12).SetLineHeight(24)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "C4 diagram",
+			diagram: func() diagrams.Diagram {
+				d := c4.NewDiagram()
+				d.Config.SetC4ShapeInRow(3).SetC4BoundaryInRow(2).SetWrap(true)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "architecture diagram",
+			diagram: func() diagrams.Diagram {
+				d := architecture.NewDiagram()
+				d.Config.SetPadding(20).SetIconSize(60).SetFontSize(14).SetUseMaxWidth(false)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "packet diagram",
+			diagram: func() diagrams.Diagram {
+				d := packet.NewDiagram()
+				d.Config.SetBitsPerRow(16).SetBitWidth(24).SetRowHeight(28).SetShowBits(false)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "radar chart",
+			diagram: func() diagrams.Diagram {
+				d := radar.NewDiagram()
+				d.Config.SetWidth(500).SetHeight(500).SetAxisScaleFactor(1.2).SetCurveTension(0.3)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+		{
+			name: "treemap",
+			diagram: func() diagrams.Diagram {
+				d := treemap.NewDiagram()
+				d.Config.SetPadding(12).SetShowValues(true).SetValueFormat(",.2f").SetLabelFontSize(16)
+				d.Config.SetDarkMode(true).SetPrimaryColor("#f96").SetLineColor("#333")
+				return d
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.diagram().String()
+
+			for i := 0; i < 50; i++ {
+				if got := tt.diagram().String(); got != want {
+					t.Fatalf("String() differs between runs:\nfirst:\n%s\nnow:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+// failingWriter accepts limit bytes and then fails.
+type failingWriter struct {
+	limit   int
+	written int
+}
+
+var errWriteFailed = errors.New("write failed")
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	if f.written+len(p) > f.limit {
+		n := f.limit - f.written
+		f.written = f.limit
+		return n, errWriteFailed
+	}
+	f.written += len(p)
+	return len(p), nil
+}
+
+func TestDiagram_WriteTo_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		diagram diagrams.Diagram
+	}{
+		{name: "Flowchart", diagram: flowchart.NewFlowchart()},
+		{name: "Sequence diagram", diagram: sequence.NewDiagram()},
+		{name: "Class diagram", diagram: class.NewClassDiagram()},
+		{name: "State diagram", diagram: state.NewDiagram()},
+		{name: "Entity relationship diagram", diagram: entityrelationship.NewDiagram()},
+		{name: "Block diagram", diagram: block.NewDiagram()},
+		{name: "Timeline diagram", diagram: timeline.NewDiagram()},
+		{name: "User journey diagram", diagram: userjourney.NewDiagram()},
+		{name: "Gantt chart", diagram: gantt.NewDiagram()},
+		{name: "Pie chart", diagram: pie.NewDiagram()},
+		{name: "Mindmap", diagram: mindmap.NewDiagram()},
+		{name: "Git graph", diagram: gitgraph.NewDiagram()},
+		{name: "Kanban board", diagram: kanban.NewDiagram()},
+		{name: "Quadrant chart", diagram: quadrant.NewDiagram()},
+		{name: "XY chart", diagram: xychart.NewDiagram()},
+		{name: "Sankey diagram", diagram: sankey.NewDiagram()},
+		{name: "Requirement diagram", diagram: requirement.NewDiagram()},
+		{name: "C4 diagram", diagram: c4.NewDiagram()},
+		{name: "architecture diagram", diagram: architecture.NewDiagram()},
+		{name: "packet diagram", diagram: packet.NewDiagram()},
+		{name: "radar chart", diagram: radar.NewDiagram()},
+		{name: "treemap", diagram: treemap.NewDiagram()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit := len(tt.diagram.String()) - 1
+
+			n, err := tt.diagram.WriteTo(&failingWriter{limit: limit})
+			if !errors.Is(err, errWriteFailed) {
+				t.Errorf("WriteTo() error = %v, want %v", err, errWriteFailed)
+			}
+			if n != int64(limit) {
+				t.Errorf("WriteTo() wrote %d bytes, want %d", n, limit)
+			}
+		})
+	}
+}
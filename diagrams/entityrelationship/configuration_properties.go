@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	baseErConfigurationProperties string = basediagram.Indentation + "er:\n"
+	erConfigurationSection        string = "er"
+	baseErConfigurationProperties string = basediagram.Indentation + erConfigurationSection + ":\n"
 	erPropertyTitleTopMargin      string = "titleTopMargin"
 	erPropertyDiagramPadding      string = "diagramPadding"
 	erPropertyLayoutDirection     string = "layoutDirection"
@@ -128,9 +129,7 @@ func (c ErConfigurationProperties) String() string {
 
 	if len(c.properties) > 0 {
 		sb.WriteString(baseErConfigurationProperties)
-		for _, prop := range c.properties {
-			sb.WriteString(prop.Format())
-		}
+		sb.WriteString(basediagram.FormatProperties(c.properties))
 	}
 
 	return sb.String()
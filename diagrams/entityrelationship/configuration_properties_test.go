@@ -55,8 +55,8 @@ func TestErConfigurationProperties_String(t *testing.T) {
 			contains: []string{
 				"er:",
 				"fontSize: 12",
-				"stroke: #333",
-				"fill: #fff",
+				`stroke: "#333"`,
+				`fill: "#fff"`,
 			},
 		},
 		{
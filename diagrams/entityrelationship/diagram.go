@@ -2,6 +2,7 @@
 package entityrelationship
 
 import (
+	"io"
 	"strings"
 
 	"github.com/TyphonHill/go-mermaid/diagrams/utils"
@@ -10,7 +11,8 @@ import (
 
 // Base string formats for entity relationship diagrams
 const (
-	baseDiagramType string = "erDiagram\n"
+	diagramType     string = "erDiagram"
+	baseDiagramType string = diagramType + "\n"
 )
 
 // Diagram represents an entity relationship diagram
@@ -46,26 +48,36 @@ func (d *Diagram) AddRelationship(from, to *Entity) *Relationship {
 // String generates the Mermaid syntax for the diagram
 func (d *Diagram) String() string {
 	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
 
-	sb.WriteString(baseDiagramType)
-
-	// Add entities
-	for _, entity := range d.Entities {
-		sb.WriteString(entity.String())
-	}
-
-	// Add relationships
-	if len(d.Relationships) > 0 {
-		sb.WriteString("\n")
-		for _, rel := range d.Relationships {
-			sb.WriteString(rel.String())
-		}
-	}
-
-	return d.BaseDiagram.String(sb.String())
+// DiagramType returns the Mermaid keyword that introduces an entity relationship diagram.
+func (d *Diagram) DiagramType() string {
+	return diagramType
 }
 
 // RenderToFile saves the diagram to a file at the specified path.
 func (d *Diagram) RenderToFile(path string) error {
-	return utils.RenderToFile(path, d.String())
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one element at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		// Add entities
+		for _, entity := range d.Entities {
+			w.WriteString(entity.String())
+		}
+
+		// Add relationships
+		if len(d.Relationships) > 0 {
+			w.WriteString("\n")
+			for _, rel := range d.Relationships {
+				w.WriteString(rel.String())
+			}
+		}
+	})
 }
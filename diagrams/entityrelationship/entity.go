@@ -3,6 +3,7 @@ package entityrelationship
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
 )
@@ -87,7 +88,7 @@ func (e *Entity) String() string {
 	var sb strings.Builder
 
 	if e.Alias != "" {
-		sb.WriteString(fmt.Sprintf(string(baseEntityWithAliasString), e.Name, e.Alias))
+		sb.WriteString(fmt.Sprintf(string(baseEntityWithAliasString), e.Name, quoteIfNeeded(e.Alias)))
 	} else {
 		sb.WriteString(fmt.Sprintf(string(baseEntityNoAliasString), e.Name))
 	}
@@ -107,3 +108,16 @@ func (e *Entity) String() string {
 	sb.WriteString(basediagram.Indentation + "}\n")
 	return sb.String()
 }
+
+// quoteIfNeeded returns text as is when it is a single word, and quoted
+// otherwise, since the entity relationship grammar only reads quoted
+// aliases and labels past the first word.
+func quoteIfNeeded(text string) string {
+	word := text != "" && strings.IndexFunc(text, func(r rune) bool {
+		return r != '_' && r != '-' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) < 0
+	if word {
+		return text
+	}
+	return basediagram.Quote(text)
+}
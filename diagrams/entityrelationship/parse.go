@@ -0,0 +1,203 @@
+package entityrelationship
+
+import (
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// cardinalityPattern matches crow's foot relationship tokens such as `||--o{`
+// or `}|..|{`, as well as the bare cardinality symbols.
+var cardinalityPattern = regexp.MustCompile(`^(?:(?:\|o|\|\||\}o|\}\|)(?:--|\.\.)(?:o\||\|\||o\{|\|\{)|\|o|\|\||o\{|\|\{)$`)
+
+type erParser struct {
+	diagram     *Diagram
+	entities    map[string]*Entity
+	current     *Entity
+	currentLine parser.Line
+}
+
+// Parse reads Mermaid entity relationship diagram syntax and returns the
+// corresponding Diagram. Entities referenced by relationships are declared on
+// first use. Attribute comments and unique keys are not supported by the model
+// and are reported as errors.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &erParser{
+		diagram:  NewDiagram(),
+		entities: make(map[string]*Entity),
+	}
+	p.diagram.Title = doc.Title
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, erConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.current != nil {
+		return nil, p.currentLine.Errorf(0, "entity %q is missing its '}'", p.current.Name)
+	}
+
+	return p.diagram, nil
+}
+
+func (p *erParser) parseLine(line parser.Line) error {
+	if p.current != nil {
+		if line.Text == "}" {
+			p.current = nil
+			return nil
+		}
+		return p.parseAttribute(line)
+	}
+
+	s := parser.NewScanner(line)
+	name := s.ReadWhile(isEntityName)
+	if name == "" {
+		return s.Errorf("expected entity name")
+	}
+	s.SkipSpaces()
+
+	switch name {
+	case "direction", "style", "classDef", "class":
+		return line.Errorf(0, "unsupported statement %q", name)
+	}
+
+	if s.EOF() || s.HasPrefix("[") || s.HasPrefix("{") {
+		return p.parseEntity(s, name)
+	}
+
+	return p.parseRelationship(s, name)
+}
+
+// parseEntity reads `Name`, `Name [Alias]` or `Name [Alias] {`.
+func (p *erParser) parseEntity(s *parser.Scanner, name string) error {
+	entity := p.entity(name)
+
+	if s.HasPrefix("[") {
+		aliasPos := s.Pos()
+		s.Advance(1)
+		alias, ok := s.ReadUntil("]")
+		if !ok {
+			return s.ErrorAt(aliasPos, "missing ']'")
+		}
+		entity.Alias = basediagram.Unescape(unquote(strings.TrimSpace(alias)))
+		s.SkipSpaces()
+	}
+
+	if s.Consume("{") {
+		p.current = entity
+		p.currentLine = s.Line()
+	}
+	if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	return nil
+}
+
+// parseAttribute reads `type name` followed by optional `PK`/`FK` keys.
+func (p *erParser) parseAttribute(line parser.Line) error {
+	s := parser.NewScanner(line)
+
+	dataType := s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) })
+	s.SkipSpaces()
+
+	name := s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) })
+	if name == "" {
+		return s.Errorf("expected attribute name")
+	}
+	s.SkipSpaces()
+
+	attribute := p.current.AddAttribute(name, DataType(dataType))
+
+	for !s.EOF() {
+		if s.HasPrefix(`"`) {
+			return s.Errorf("unsupported attribute comment")
+		}
+
+		keyPos := s.Pos()
+		key := strings.TrimSpace(s.ReadWhile(func(r rune) bool { return r != ',' && r != '"' }))
+		switch key {
+		case "PK":
+			attribute.SetPrimaryKey()
+		case "FK":
+			attribute.SetForeignKey()
+		default:
+			return s.ErrorAt(keyPos, "unsupported attribute key %q", key)
+		}
+		s.Consume(",")
+		s.SkipSpaces()
+	}
+
+	return nil
+}
+
+// parseRelationship reads `A ||--o{ B : label`.
+func (p *erParser) parseRelationship(s *parser.Scanner, from string) error {
+	cardinalityPos := s.Pos()
+	cardinality := s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) })
+	if !cardinalityPattern.MatchString(cardinality) {
+		return s.ErrorAt(cardinalityPos, "unknown cardinality %q", cardinality)
+	}
+	s.SkipSpaces()
+
+	to := s.ReadWhile(isEntityName)
+	if to == "" {
+		return s.Errorf("expected entity name")
+	}
+	s.SkipSpaces()
+
+	if !s.Consume(":") {
+		return s.Errorf("expected ':'")
+	}
+
+	relationship := p.diagram.AddRelationship(p.entity(from), p.entity(to))
+	relationship.SetCardinality(Cardinality(cardinality))
+	relationship.SetLabel(basediagram.Unescape(unquote(strings.TrimSpace(s.Rest()))))
+
+	return nil
+}
+
+// entity returns the entity with the given name, declaring it on first use.
+func (p *erParser) entity(name string) *Entity {
+	if entity, ok := p.entities[name]; ok {
+		return entity
+	}
+
+	entity := p.diagram.AddEntity(name)
+	p.entities[name] = entity
+
+	return entity
+}
+
+func isEntityName(r rune) bool {
+	return r == '-' || parser.IsIdentifier(r)
+}
+
+func unquote(text string) string {
+	if len(text) >= 2 && strings.HasPrefix(text, `"`) && strings.HasSuffix(text, `"`) {
+		return text[1 : len(text)-1]
+	}
+	return text
+}
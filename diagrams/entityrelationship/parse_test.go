@@ -0,0 +1,207 @@
+package entityrelationship
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Diagram with title and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Orders"
+				d.EnableMarkdownFence()
+				d.AddEntity("CUSTOMER")
+			},
+		},
+		{
+			name: "Diagram with text that needs quoting",
+			setup: func(d *Diagram) {
+				customer := d.AddEntity("CUSTOMER").SetAlias("Customer Account")
+				d.AddRelationship(customer, d.AddEntity("ORDER")).SetLabel(`places "many": #1`)
+			},
+		},
+		{
+			name: "Diagram with aliases, keys and every cardinality",
+			setup: func(d *Diagram) {
+				customer := d.AddEntity("CUSTOMER").SetAlias("Customer")
+				customer.AddAttribute("id", TypeInteger).SetPrimaryKey()
+				customer.AddAttribute("name", TypeString)
+				order := d.AddEntity("ORDER")
+				order.AddAttribute("id", TypeInteger).SetPrimaryKey()
+				order.AddAttribute("customer_id", TypeInteger).SetPrimaryKey().SetForeignKey()
+				order.AddAttribute("placed", TypeDateTime).SetForeignKey()
+
+				d.AddRelationship(customer, order)
+				for _, cardinality := range []Cardinality{
+					OneToZeroOrMore, OneToOneOrMore, OneToExactlyOne, ZeroOrOneToMany, ManyToMany,
+					ZeroOrOne, ExactlyOne, ZeroOrMore, OneOrMore,
+					"}|..|{", "|o..o|",
+				} {
+					d.AddRelationship(customer, order).SetCardinality(cardinality).SetLabel("places")
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `erDiagram
+    %% a comment
+    CUSTOMER ||--o{ ORDER : "places"
+    ORDER ||--|{ LINE-ITEM : contains
+    p["Person"] {
+        string firstName
+        int companyId PK, FK
+    }
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	wantEntities := []string{"CUSTOMER", "ORDER", "LINE-ITEM", "p"}
+	if len(d.Entities) != len(wantEntities) {
+		t.Fatalf("Parse() got %d entities, want %d", len(d.Entities), len(wantEntities))
+	}
+	for i, want := range wantEntities {
+		if d.Entities[i].Name != want {
+			t.Errorf("Parse() entity %d = %v, want %v", i, d.Entities[i].Name, want)
+		}
+	}
+
+	person := d.Entities[3]
+	if person.Alias != "Person" || len(person.Attributes) != 2 {
+		t.Fatalf("Parse() entity p = %+v, want alias Person with 2 attributes", person)
+	}
+	if attr := person.Attributes[1]; attr.Name != "companyId" || attr.Type != TypeInteger || !attr.PK || !attr.FK {
+		t.Errorf("Parse() attribute = %+v, want int companyId PK,FK", attr)
+	}
+
+	wantRelationships := []string{
+		"CUSTOMER ||--o{ ORDER : places",
+		"ORDER ||--|{ LINE-ITEM : contains",
+	}
+	if len(d.Relationships) != len(wantRelationships) {
+		t.Fatalf("Parse() got %d relationships, want %d", len(d.Relationships), len(wantRelationships))
+	}
+	for i, want := range wantRelationships {
+		if got := strings.TrimSpace(d.Relationships[i].String()); got != want {
+			t.Errorf("Parse() relationship %d = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "CUSTOMER ||--o{ ORDER : places\n",
+			line:    1,
+			column:  1,
+			message: "expected erDiagram declaration",
+		},
+		{
+			name:    "Unknown cardinality",
+			input:   "erDiagram\n    A ||->o{ B : rel\n",
+			line:    2,
+			column:  7,
+			message: `unknown cardinality "||->o{"`,
+		},
+		{
+			name:    "Missing label",
+			input:   "erDiagram\n    A ||--o{ B\n",
+			line:    2,
+			column:  15,
+			message: "expected ':'",
+		},
+		{
+			name:    "Unterminated alias",
+			input:   "erDiagram\n    A [Alias {\n",
+			line:    2,
+			column:  7,
+			message: "missing ']'",
+		},
+		{
+			name:    "Unsupported key",
+			input:   "erDiagram\n    A {\n        string email UK\n    }\n",
+			line:    3,
+			column:  22,
+			message: `unsupported attribute key "UK"`,
+		},
+		{
+			name:    "Unsupported comment",
+			input:   "erDiagram\n    A {\n        string email \"login\"\n    }\n",
+			line:    3,
+			column:  22,
+			message: "unsupported attribute comment",
+		},
+		{
+			name:    "Unterminated entity",
+			input:   "erDiagram\n    A {\n        string name\n",
+			line:    2,
+			column:  5,
+			message: `entity "A" is missing its '}'`,
+		},
+		{
+			name:    "Unsupported statement",
+			input:   "erDiagram\n    direction LR\n",
+			line:    2,
+			column:  5,
+			message: `unsupported statement "direction"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
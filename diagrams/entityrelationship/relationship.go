@@ -63,5 +63,5 @@ func (r *Relationship) String() string {
 	if label == "" {
 		label = "relates"
 	}
-	return fmt.Sprintf(string(baseRelationshipString), r.From.Name, string(r.Cardinality), r.To.Name, label)
+	return fmt.Sprintf(string(baseRelationshipString), r.From.Name, string(r.Cardinality), r.To.Name, quoteIfNeeded(label))
 }
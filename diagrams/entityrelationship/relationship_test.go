@@ -74,6 +74,15 @@ func TestRelationship_String(t *testing.T) {
 				string(ExactlyOne),
 			},
 		},
+		{
+			name: "Relationship with label that needs quoting",
+			setup: func() *Relationship {
+				return NewRelationship(NewEntity("A"), NewEntity("B")).SetLabel("belongs to: many")
+			},
+			contains: []string{
+				`A || B : "belongs to: many"`,
+			},
+		},
 		{
 			name: "Relationship with label",
 			setup: func() *Relationship {
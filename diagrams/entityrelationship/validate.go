@@ -0,0 +1,47 @@
+package entityrelationship
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// missing entities and relationships, duplicate entity names and
+// relationships between entities that are missing or were never added to the
+// diagram.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	entities := make(map[*Entity]bool, len(d.Entities))
+	for i, entity := range d.Entities {
+		path := fmt.Sprintf("Entities[%d]", i)
+		if entity == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing entity")
+			continue
+		}
+		v.UniqueID(path, entity.Name)
+		entities[entity] = true
+	}
+
+	for i, relationship := range d.Relationships {
+		path := fmt.Sprintf("Relationships[%d]", i)
+		if relationship == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing relationship")
+			continue
+		}
+		validateEntity(&v, path+".From", relationship.From, entities)
+		validateEntity(&v, path+".To", relationship.To, entities)
+	}
+
+	return v.Errors()
+}
+
+func validateEntity(v *basediagram.Validator, path string, entity *Entity, entities map[*Entity]bool) {
+	switch {
+	case entity == nil:
+		v.Error(basediagram.CodeMissingReference, path, "missing entity")
+	case !entities[entity]:
+		v.Error(basediagram.CodeUnknownReference, path, "entity %q is not part of the diagram", entity.Name)
+	}
+}
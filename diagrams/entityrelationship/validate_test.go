@@ -0,0 +1,78 @@
+package entityrelationship
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				d.AddRelationship(d.AddEntity("CUSTOMER"), d.AddEntity("ORDER")).SetLabel("places")
+			},
+		},
+		{
+			name: "Relationship to an entity that was never added",
+			setup: func(d *Diagram) {
+				d.AddRelationship(NewEntity("GHOST"), d.AddEntity("ORDER"))
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "Relationships[0].From", Message: `entity "GHOST" is not part of the diagram`},
+			},
+		},
+		{
+			name: "Relationship without target",
+			setup: func(d *Diagram) {
+				d.AddRelationship(d.AddEntity("CUSTOMER"), nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Relationships[0].To", Message: "missing entity"},
+			},
+		},
+		{
+			name: "Duplicate entity names",
+			setup: func(d *Diagram) {
+				d.AddEntity("CUSTOMER")
+				d.AddEntity("CUSTOMER")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Entities[1]", Message: `ID "CUSTOMER" is already used by Entities[0]`},
+			},
+		},
+		{
+			name: "Missing entity and relationship",
+			setup: func(d *Diagram) {
+				d.AddEntity("CUSTOMER")
+				d.Entities = append(d.Entities, nil)
+				d.Relationships = append(d.Relationships, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Entities[1]", Message: "missing entity"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Relationships[0]", Message: "missing relationship"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
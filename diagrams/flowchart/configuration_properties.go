@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	baseFlowchartConfigurationProperties string = basediagram.Indentation + "flowchart:\n"
+	flowchartConfigurationSection        string = "flowchart"
+	baseFlowchartConfigurationProperties string = basediagram.Indentation + flowchartConfigurationSection + ":\n"
 	flowchartPropertyTitleTopMargin      string = "titleTopMargin"
 	flowchartPropertyDiagramPadding      string = "diagramPadding"
 	flowchartPropertyHtmlLabels          string = "htmlLabels"
@@ -139,9 +140,7 @@ func (c FlowchartConfigurationProperties) String() string {
 
 	if len(c.properties) > 0 {
 		sb.WriteString(baseFlowchartConfigurationProperties)
-		for _, prop := range c.properties {
-			sb.WriteString(prop.Format())
-		}
+		sb.WriteString(basediagram.FormatProperties(c.properties))
 	}
 
 	return sb.String()
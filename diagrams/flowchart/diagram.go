@@ -2,7 +2,7 @@
 package flowchart
 
 import (
-	"fmt"
+	"io"
 	"strings"
 
 	"github.com/TyphonHill/go-mermaid/diagrams/utils"
@@ -41,7 +41,8 @@ const (
 )
 
 const (
-	baseFlowchartDirectionString string = "flowchart %s\n"
+	diagramType                  string = "flowchart"
+	baseFlowchartDirectionString string = diagramType + " %s\n"
 )
 
 // Flowcharts are composed of nodes (geometric shapes) and links (arrows or lines).
@@ -79,9 +80,37 @@ func (f *Flowchart) SetDirection(direction flowchartDirection) *Flowchart {
 	return f
 }
 
+// DiagramType returns the Mermaid keyword that introduces a flowchart.
+func (f *Flowchart) DiagramType() string {
+	return diagramType
+}
+
 // RenderToFile saves the flowchart diagram to a file at the specified path.
 func (f *Flowchart) RenderToFile(path string) error {
-	return utils.RenderToFile(path, f.String())
+	return utils.WriteToFile(path, f)
+}
+
+// WriteTo streams the flowchart diagram to w one element at a time.
+func (f *Flowchart) WriteTo(w io.Writer) (int64, error) {
+	return f.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.Printf(string(baseFlowchartDirectionString), string(f.Direction))
+
+		for _, class := range f.classes {
+			w.WriteString(class.String())
+		}
+
+		for _, node := range f.nodes {
+			node.WriteTo(w)
+		}
+
+		for _, subgraph := range f.subgraphs {
+			subgraph.WriteTo(w)
+		}
+
+		for _, link := range f.links {
+			link.WriteTo(w)
+		}
+	})
 }
 
 // AddSubgraph adds a new subgraph to the flowchart and returns the created subgraph.
@@ -123,24 +152,6 @@ func (f *Flowchart) AddClass(name string) (newClass *Class) {
 // String generates a Mermaid flowchart string representation
 func (f *Flowchart) String() string {
 	var sb strings.Builder
-
-	sb.WriteString(fmt.Sprintf(string(baseFlowchartDirectionString), string(f.Direction)))
-
-	for _, class := range f.classes {
-		sb.WriteString(class.String())
-	}
-
-	for _, node := range f.nodes {
-		sb.WriteString(node.String())
-	}
-
-	for _, subgraph := range f.subgraphs {
-		sb.WriteString(subgraph.String("%s"))
-	}
-
-	for _, link := range f.links {
-		sb.WriteString(link.String())
-	}
-
-	return f.BaseDiagram.String(sb.String())
+	f.WriteTo(&sb)
+	return sb.String()
 }
@@ -83,7 +83,7 @@ func TestFlowchart_String(t *testing.T) {
 			},
 			contains: []string{
 				"flowchart TB",
-				"subgraph 0 [My Subgraph]",
+				`subgraph 0 ["My Subgraph"]`,
 			},
 		},
 		{
@@ -99,7 +99,7 @@ func TestFlowchart_String(t *testing.T) {
 				"flowchart TB",
 				"0@{ shape: rect, label: \"My Node 1\"}",
 				"1@{ shape: rect, label: \"My Node 2\"}",
-				"subgraph 2 [My Subgraph]",
+				`subgraph 2 ["My Subgraph"]`,
 				"0 --> 1",
 			},
 		},
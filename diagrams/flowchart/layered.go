@@ -0,0 +1,532 @@
+package flowchart
+
+import (
+	"sort"
+)
+
+// Number of sweeps used to order the layers and to place the vertices.
+const (
+	orderingSweeps  = 8
+	placementSweeps = 8
+)
+
+// point is a position in the drawing, in pixels.
+type point struct {
+	x, y float64
+}
+
+// vertex is a box placed by the layered layout: a node, a collapsed
+// subgraph, or a dummy standing for a link where it crosses a layer.
+// The size is given in the orientation of the drawing, and x and y are
+// the coordinates of the center once the graph is laid out.
+type vertex struct {
+	width, height float64
+	x, y          float64
+	dummy         bool
+	layer, order  int
+	cross, rank   float64
+	above, below  []*vertex
+}
+
+// edge connects two vertices of a graph. A positive label size reserves
+// room for the label on the middle dummy of the edge.
+type edge struct {
+	from, to                *vertex
+	minLength               int
+	labelWidth, labelHeight float64
+	reversed                bool
+	chain                   []*vertex
+	label                   *vertex
+	points                  []point
+	labelAt                 point
+}
+
+// graph is a directed graph laid out in layers with a Sugiyama-style
+// algorithm: cycles are broken by reversing back edges, vertices are assigned
+// to layers along the longest path, edges spanning several layers are split by
+// dummy vertices, the order inside each layer is improved by barycenter sweeps
+// and each vertex is finally moved as close as possible to the average
+// position of its neighbours.
+type graph struct {
+	vertices []*vertex
+	edges    []*edge
+}
+
+// addVertex adds a vertex of the given size to the graph and returns it.
+func (g *graph) addVertex(width, height float64) *vertex {
+	v := &vertex{width: width, height: height}
+	g.vertices = append(g.vertices, v)
+	return v
+}
+
+// addEdge adds an edge between two vertices of the graph and returns it.
+// The edge spans at least minLength layers.
+func (g *graph) addEdge(from, to *vertex, minLength int) *edge {
+	if minLength < 1 {
+		minLength = 1
+	}
+	e := &edge{from: from, to: to, minLength: minLength}
+	g.edges = append(g.edges, e)
+	return e
+}
+
+// source returns the vertex the edge starts from once cycles are broken.
+func (e *edge) source() *vertex {
+	if e.reversed {
+		return e.to
+	}
+	return e.from
+}
+
+// target returns the vertex the edge ends at once cycles are broken.
+func (e *edge) target() *vertex {
+	if e.reversed {
+		return e.from
+	}
+	return e.to
+}
+
+// layout places the vertices and routes the edges of the graph so that it
+// flows in the given direction, with the top left corner of the drawing at the
+// origin. It returns the width and height of the drawing.
+// Self-loops are not supported and must be left out of the graph.
+func (g *graph) layout(direction flowchartDirection, nodeSpacing, rankSpacing float64) (float64, float64) {
+	if len(g.vertices) == 0 {
+		return 0, 0
+	}
+
+	horizontal := direction == FlowchartDirectionLeftRight || direction == FlowchartDirectionRightLeft
+
+	g.breakCycles()
+	g.assignLayers()
+	layers := g.splitEdges()
+	orderLayers(layers)
+	crossExtent, rankExtent := placeLayers(layers, horizontal, nodeSpacing, rankSpacing)
+
+	for _, layer := range layers {
+		for _, v := range layer {
+			switch direction {
+			case FlowchartDirectionBottomUp:
+				v.x, v.y = v.cross, rankExtent-v.rank
+			case FlowchartDirectionLeftRight:
+				v.x, v.y = v.rank, v.cross
+			case FlowchartDirectionRightLeft:
+				v.x, v.y = rankExtent-v.rank, v.cross
+			default:
+				v.x, v.y = v.cross, v.rank
+			}
+		}
+	}
+
+	g.route()
+
+	if horizontal {
+		return rankExtent, crossExtent
+	}
+	return crossExtent, rankExtent
+}
+
+// breakCycles reverses the edges closing a cycle during a depth-first search
+// starting from the sources, visiting the vertices and edges in the order they
+// were added.
+func (g *graph) breakCycles() {
+	outgoing := make(map[*vertex][]*edge, len(g.vertices))
+	incoming := make(map[*vertex]bool, len(g.vertices))
+	for _, e := range g.edges {
+		outgoing[e.from] = append(outgoing[e.from], e)
+		incoming[e.to] = true
+	}
+
+	const (
+		unvisited = iota
+		active
+		visited
+	)
+	state := make(map[*vertex]int, len(g.vertices))
+
+	var visit func(v *vertex)
+	visit = func(v *vertex) {
+		state[v] = active
+		for _, e := range outgoing[v] {
+			switch state[e.to] {
+			case active:
+				e.reversed = true
+			case unvisited:
+				visit(e.to)
+			}
+		}
+		state[v] = visited
+	}
+
+	for _, v := range g.vertices {
+		if !incoming[v] && state[v] == unvisited {
+			visit(v)
+		}
+	}
+	for _, v := range g.vertices {
+		if state[v] == unvisited {
+			visit(v)
+		}
+	}
+}
+
+// assignLayers puts every vertex on the lowest layer allowed by the edges
+// coming into it, then moves the sources down next to their closest target.
+func (g *graph) assignLayers() {
+	indegree := make(map[*vertex]int, len(g.vertices))
+	outgoing := make(map[*vertex][]*edge, len(g.vertices))
+	for _, e := range g.edges {
+		indegree[e.target()]++
+		outgoing[e.source()] = append(outgoing[e.source()], e)
+	}
+
+	var order []*vertex
+	for _, v := range g.vertices {
+		v.layer = 0
+		if indegree[v] == 0 {
+			order = append(order, v)
+		}
+	}
+	sources := len(order)
+
+	for i := 0; i < len(order); i++ {
+		v := order[i]
+		for _, e := range outgoing[v] {
+			t := e.target()
+			if layer := v.layer + e.minLength; layer > t.layer {
+				t.layer = layer
+			}
+			indegree[t]--
+			if indegree[t] == 0 {
+				order = append(order, t)
+			}
+		}
+	}
+
+	for _, v := range order[:sources] {
+		if len(outgoing[v]) == 0 {
+			continue
+		}
+		layer := -1
+		for _, e := range outgoing[v] {
+			if l := e.target().layer - e.minLength; layer < 0 || l < layer {
+				layer = l
+			}
+		}
+		v.layer = layer
+	}
+
+	lowest := order[0].layer
+	for _, v := range order {
+		if v.layer < lowest {
+			lowest = v.layer
+		}
+	}
+	for _, v := range order {
+		v.layer -= lowest
+	}
+}
+
+// splitEdges replaces the edges spanning several layers by chains of dummy
+// vertices and returns the vertices of each layer.
+func (g *graph) splitEdges() [][]*vertex {
+	var layers [][]*vertex
+	add := func(v *vertex) {
+		for len(layers) <= v.layer {
+			layers = append(layers, nil)
+		}
+		v.order = len(layers[v.layer])
+		layers[v.layer] = append(layers[v.layer], v)
+	}
+
+	for _, v := range g.vertices {
+		add(v)
+	}
+
+	for _, e := range g.edges {
+		source, target := e.source(), e.target()
+		e.chain = []*vertex{source}
+		for layer := source.layer + 1; layer < target.layer; layer++ {
+			dummy := &vertex{dummy: true, layer: layer}
+			add(dummy)
+			e.chain = append(e.chain, dummy)
+		}
+		e.chain = append(e.chain, target)
+
+		if e.labelWidth > 0 && len(e.chain) > 2 {
+			e.label = e.chain[len(e.chain)/2]
+			e.label.width, e.label.height = e.labelWidth, e.labelHeight
+		}
+
+		for i := 1; i < len(e.chain); i++ {
+			e.chain[i-1].below = append(e.chain[i-1].below, e.chain[i])
+			e.chain[i].above = append(e.chain[i].above, e.chain[i-1])
+		}
+	}
+
+	return layers
+}
+
+// orderLayers sorts each layer by the barycenter of the neighbours of its
+// vertices, sweeping down and up, and keeps the order with fewest crossings.
+func orderLayers(layers [][]*vertex) {
+	best := copyLayers(layers)
+	bestCrossings := countCrossings(layers)
+
+	for sweep := 0; sweep < orderingSweeps && bestCrossings > 0; sweep++ {
+		if sweep%2 == 0 {
+			for i := 1; i < len(layers); i++ {
+				sortByBarycenter(layers[i], func(v *vertex) []*vertex { return v.above })
+			}
+		} else {
+			for i := len(layers) - 2; i >= 0; i-- {
+				sortByBarycenter(layers[i], func(v *vertex) []*vertex { return v.below })
+			}
+		}
+
+		if crossings := countCrossings(layers); crossings < bestCrossings {
+			best = copyLayers(layers)
+			bestCrossings = crossings
+		}
+	}
+
+	for i, layer := range best {
+		copy(layers[i], layer)
+		for order, v := range layers[i] {
+			v.order = order
+		}
+	}
+}
+
+// sortByBarycenter sorts the layer by the average order of the neighbours of
+// each vertex. Vertices without neighbours keep their position.
+func sortByBarycenter(layer []*vertex, neighbours func(*vertex) []*vertex) {
+	barycenters := make(map[*vertex]float64, len(layer))
+	for _, v := range layer {
+		barycenters[v] = float64(v.order)
+		if adjacent := neighbours(v); len(adjacent) > 0 {
+			sum := 0.0
+			for _, n := range adjacent {
+				sum += float64(n.order)
+			}
+			barycenters[v] = sum / float64(len(adjacent))
+		}
+	}
+
+	sort.SliceStable(layer, func(i, j int) bool {
+		return barycenters[layer[i]] < barycenters[layer[j]]
+	})
+
+	for order, v := range layer {
+		v.order = order
+	}
+}
+
+// countCrossings returns the number of crossing segments between each pair of
+// adjacent layers.
+func countCrossings(layers [][]*vertex) int {
+	crossings := 0
+
+	for _, layer := range layers {
+		var segments [][2]int
+		for _, v := range layer {
+			for _, n := range v.below {
+				segments = append(segments, [2]int{v.order, n.order})
+			}
+		}
+
+		for i := range segments {
+			for j := i + 1; j < len(segments); j++ {
+				if (segments[i][0]-segments[j][0])*(segments[i][1]-segments[j][1]) < 0 {
+					crossings++
+				}
+			}
+		}
+	}
+
+	return crossings
+}
+
+func copyLayers(layers [][]*vertex) [][]*vertex {
+	c := make([][]*vertex, len(layers))
+	for i, layer := range layers {
+		c[i] = append([]*vertex(nil), layer...)
+	}
+	return c
+}
+
+// placeLayers sets the cross and rank coordinates of the vertices and returns
+// the extent of the drawing along both axes. Layers are stacked along the rank
+// axis; along the cross axis each vertex is pulled toward its neighbours in the
+// adjacent layers while keeping the spacing with the vertices next to it.
+func placeLayers(layers [][]*vertex, horizontal bool, nodeSpacing, rankSpacing float64) (float64, float64) {
+	crossSize := func(v *vertex) float64 {
+		if horizontal {
+			return v.height
+		}
+		return v.width
+	}
+	rankSize := func(v *vertex) float64 {
+		if horizontal {
+			return v.width
+		}
+		return v.height
+	}
+	gap := func(a, b *vertex) float64 {
+		spacing := nodeSpacing
+		if a.dummy && b.dummy {
+			spacing /= 2
+		}
+		return (crossSize(a)+crossSize(b))/2 + spacing
+	}
+
+	rankExtent := 0.0
+	for i, layer := range layers {
+		thickness := 0.0
+		for _, v := range layer {
+			if size := rankSize(v); size > thickness {
+				thickness = size
+			}
+		}
+		if i > 0 {
+			rankExtent += rankSpacing
+		}
+		for _, v := range layer {
+			v.rank = rankExtent + thickness/2
+		}
+		rankExtent += thickness
+	}
+
+	for _, layer := range layers {
+		for i, v := range layer {
+			v.cross = 0
+			if i > 0 {
+				v.cross = layer[i-1].cross + gap(layer[i-1], v)
+			}
+		}
+	}
+
+	for sweep := 0; sweep <= placementSweeps; sweep++ {
+		switch {
+		case sweep == placementSweeps:
+			for _, layer := range layers {
+				alignLayer(layer, gap, func(v *vertex) []*vertex {
+					return append(append([]*vertex(nil), v.above...), v.below...)
+				})
+			}
+		case sweep%2 == 0:
+			for _, layer := range layers {
+				alignLayer(layer, gap, func(v *vertex) []*vertex { return v.above })
+			}
+		default:
+			for i := len(layers) - 1; i >= 0; i-- {
+				alignLayer(layers[i], gap, func(v *vertex) []*vertex { return v.below })
+			}
+		}
+	}
+
+	lowest, highest := 0.0, 0.0
+	first := true
+	for _, layer := range layers {
+		for _, v := range layer {
+			low, high := v.cross-crossSize(v)/2, v.cross+crossSize(v)/2
+			if first || low < lowest {
+				lowest = low
+			}
+			if first || high > highest {
+				highest = high
+			}
+			first = false
+		}
+	}
+	for _, layer := range layers {
+		for _, v := range layer {
+			v.cross -= lowest
+		}
+	}
+
+	return highest - lowest, rankExtent
+}
+
+// alignLayer moves each vertex of the layer toward the average position of its
+// neighbours.
+func alignLayer(layer []*vertex, gap func(a, b *vertex) float64, neighbours func(*vertex) []*vertex) {
+	desired := make([]float64, len(layer))
+	gaps := make([]float64, len(layer))
+	for i, v := range layer {
+		desired[i] = v.cross
+		if adjacent := neighbours(v); len(adjacent) > 0 {
+			sum := 0.0
+			for _, n := range adjacent {
+				sum += n.cross
+			}
+			desired[i] = sum / float64(len(adjacent))
+		}
+		if i > 0 {
+			gaps[i] = gap(layer[i-1], v)
+		}
+	}
+	placeLayer(layer, desired, gaps)
+}
+
+// placeLayer moves the vertices of the layer as close as possible to their
+// desired positions, in the least squares sense, while keeping at least gaps[i]
+// between the vertices i-1 and i. Shifting each position by the sum of the gaps
+// before it turns this into an isotonic regression, solved by pooling adjacent
+// violators.
+func placeLayer(layer []*vertex, desired []float64, gaps []float64) {
+	type block struct {
+		sum   float64
+		count int
+	}
+	mean := func(b block) float64 {
+		return b.sum / float64(b.count)
+	}
+
+	offsets := make([]float64, len(layer))
+	blocks := make([]block, 0, len(layer))
+	for i := range layer {
+		if i > 0 {
+			offsets[i] = offsets[i-1] + gaps[i]
+		}
+		blocks = append(blocks, block{sum: desired[i] - offsets[i], count: 1})
+
+		for len(blocks) > 1 && mean(blocks[len(blocks)-2]) > mean(blocks[len(blocks)-1]) {
+			last := blocks[len(blocks)-1]
+			blocks = blocks[:len(blocks)-1]
+			blocks[len(blocks)-1].sum += last.sum
+			blocks[len(blocks)-1].count += last.count
+		}
+	}
+
+	i := 0
+	for _, b := range blocks {
+		for k := 0; k < b.count; k++ {
+			layer[i].cross = mean(b) + offsets[i]
+			i++
+		}
+	}
+}
+
+// route sets the points of each edge, from the center of its first vertex to
+// the center of its last one through its dummies, and the position of its label.
+func (g *graph) route() {
+	for _, e := range g.edges {
+		e.points = make([]point, len(e.chain))
+		for i, v := range e.chain {
+			e.points[i] = point{v.x, v.y}
+		}
+		if e.reversed {
+			for i, j := 0, len(e.points)-1; i < j; i, j = i+1, j-1 {
+				e.points[i], e.points[j] = e.points[j], e.points[i]
+			}
+		}
+
+		if e.label != nil {
+			e.labelAt = point{e.label.x, e.label.y}
+		} else {
+			a, b := e.points[(len(e.points)-1)/2], e.points[len(e.points)/2]
+			e.labelAt = point{(a.x + b.x) / 2, (a.y + b.y) / 2}
+		}
+	}
+}
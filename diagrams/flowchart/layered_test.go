@@ -0,0 +1,260 @@
+package flowchart
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGraph_Layout(t *testing.T) {
+	tests := []struct {
+		name       string
+		direction  flowchartDirection
+		wantWidth  float64
+		wantHeight float64
+		check      func(a, b *vertex) bool
+	}{
+		{
+			name:       "Top to bottom",
+			direction:  FlowchartDirectionTopToBottom,
+			wantWidth:  40,
+			wantHeight: 70,
+			check:      func(a, b *vertex) bool { return a.x == b.x && b.y > a.y },
+		},
+		{
+			name:       "Top down",
+			direction:  FlowchartDirectionTopDown,
+			wantWidth:  40,
+			wantHeight: 70,
+			check:      func(a, b *vertex) bool { return a.x == b.x && b.y > a.y },
+		},
+		{
+			name:       "Bottom up",
+			direction:  FlowchartDirectionBottomUp,
+			wantWidth:  40,
+			wantHeight: 70,
+			check:      func(a, b *vertex) bool { return a.x == b.x && b.y < a.y },
+		},
+		{
+			name:       "Left to right",
+			direction:  FlowchartDirectionLeftRight,
+			wantWidth:  110,
+			wantHeight: 20,
+			check:      func(a, b *vertex) bool { return a.y == b.y && b.x > a.x },
+		},
+		{
+			name:       "Right to left",
+			direction:  FlowchartDirectionRightLeft,
+			wantWidth:  110,
+			wantHeight: 20,
+			check:      func(a, b *vertex) bool { return a.y == b.y && b.x < a.x },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &graph{}
+			a := g.addVertex(40, 20)
+			b := g.addVertex(40, 20)
+			g.addEdge(a, b, 1)
+
+			width, height := g.layout(tt.direction, 50, 30)
+			if width != tt.wantWidth || height != tt.wantHeight {
+				t.Errorf("layout() = %v, %v, want %v, %v", width, height, tt.wantWidth, tt.wantHeight)
+			}
+			if !tt.check(a, b) {
+				t.Errorf("layout() placed a at (%v, %v) and b at (%v, %v)", a.x, a.y, b.x, b.y)
+			}
+		})
+	}
+}
+
+func TestGraph_Layout_Empty(t *testing.T) {
+	g := &graph{}
+	if width, height := g.layout(FlowchartDirectionTopToBottom, 50, 50); width != 0 || height != 0 {
+		t.Errorf("layout() = %v, %v, want 0, 0", width, height)
+	}
+}
+
+func TestGraph_BreakCycles(t *testing.T) {
+	g := &graph{}
+	a := g.addVertex(10, 10)
+	b := g.addVertex(10, 10)
+	c := g.addVertex(10, 10)
+	ab := g.addEdge(a, b, 1)
+	bc := g.addEdge(b, c, 1)
+	ca := g.addEdge(c, a, 1)
+
+	g.breakCycles()
+
+	if ab.reversed || bc.reversed || !ca.reversed {
+		t.Errorf("breakCycles() reversed = %v, %v, %v, want false, false, true", ab.reversed, bc.reversed, ca.reversed)
+	}
+
+	g.assignLayers()
+	if a.layer != 0 || b.layer != 1 || c.layer != 2 {
+		t.Errorf("assignLayers() = %v, %v, %v, want 0, 1, 2", a.layer, b.layer, c.layer)
+	}
+}
+
+func TestGraph_BreakCycles_StartsFromSources(t *testing.T) {
+	g := &graph{}
+	b := g.addVertex(10, 10)
+	c := g.addVertex(10, 10)
+	a := g.addVertex(10, 10)
+	bc := g.addEdge(b, c, 1)
+	cb := g.addEdge(c, b, 1)
+	ab := g.addEdge(a, b, 1)
+
+	g.breakCycles()
+
+	if bc.reversed || !cb.reversed || ab.reversed {
+		t.Errorf("breakCycles() reversed = %v, %v, %v, want false, true, false", bc.reversed, cb.reversed, ab.reversed)
+	}
+}
+
+func TestGraph_AssignLayers(t *testing.T) {
+	g := &graph{}
+	a := g.addVertex(10, 10)
+	b := g.addVertex(10, 10)
+	c := g.addVertex(10, 10)
+	d := g.addVertex(10, 10)
+	e := g.addVertex(10, 10)
+	g.addEdge(a, b, 1)
+	g.addEdge(b, c, 2)
+	g.addEdge(d, c, 1)
+
+	g.assignLayers()
+
+	got := []int{a.layer, b.layer, c.layer, d.layer, e.layer}
+	want := []int{0, 1, 3, 2, 0}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("assignLayers() = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestGraph_SplitEdges(t *testing.T) {
+	g := &graph{}
+	a := g.addVertex(10, 10)
+	b := g.addVertex(10, 10)
+	e := g.addEdge(a, b, 4)
+	e.labelWidth, e.labelHeight = 30, 20
+
+	g.assignLayers()
+	layers := g.splitEdges()
+
+	if len(layers) != 5 {
+		t.Fatalf("splitEdges() returned %d layers, want 5", len(layers))
+	}
+	if len(e.chain) != 5 || e.chain[0] != a || e.chain[4] != b {
+		t.Fatalf("splitEdges() chain = %v, want a, 3 dummies, b", e.chain)
+	}
+	for i, v := range e.chain[1:4] {
+		if !v.dummy || v.layer != i+1 {
+			t.Errorf("splitEdges() chain[%d] = %+v, want a dummy on layer %d", i+1, v, i+1)
+		}
+	}
+	if e.label != e.chain[2] || e.label.width != 30 || e.label.height != 20 {
+		t.Errorf("splitEdges() label = %+v, want the middle dummy sized 30x20", e.label)
+	}
+}
+
+func TestOrderLayers(t *testing.T) {
+	g := &graph{}
+	a := g.addVertex(10, 10)
+	b := g.addVertex(10, 10)
+	c := g.addVertex(10, 10)
+	d := g.addVertex(10, 10)
+	g.addEdge(a, d, 1)
+	g.addEdge(b, c, 1)
+
+	g.assignLayers()
+	layers := g.splitEdges()
+	if got := countCrossings(layers); got != 1 {
+		t.Fatalf("countCrossings() before ordering = %d, want 1", got)
+	}
+
+	orderLayers(layers)
+
+	if got := countCrossings(layers); got != 0 {
+		t.Errorf("countCrossings() after ordering = %d, want 0", got)
+	}
+	for i, layer := range layers {
+		for order, v := range layer {
+			if v.order != order {
+				t.Errorf("layers[%d][%d].order = %d, want %d", i, order, v.order, order)
+			}
+		}
+	}
+}
+
+func TestPlaceLayer(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired []float64
+		gaps    []float64
+		want    []float64
+	}{
+		{
+			name:    "Desired positions far enough apart",
+			desired: []float64{0, 100},
+			gaps:    []float64{0, 50},
+			want:    []float64{0, 100},
+		},
+		{
+			name:    "Same desired position",
+			desired: []float64{0, 0},
+			gaps:    []float64{0, 50},
+			want:    []float64{-25, 25},
+		},
+		{
+			name:    "Desired positions in the wrong order",
+			desired: []float64{30, 0, 90},
+			gaps:    []float64{0, 10, 10},
+			want:    []float64{10, 20, 90},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layer := make([]*vertex, len(tt.desired))
+			for i := range layer {
+				layer[i] = &vertex{}
+			}
+
+			placeLayer(layer, tt.desired, tt.gaps)
+
+			for i, v := range layer {
+				if math.Abs(v.cross-tt.want[i]) > 1e-9 {
+					t.Errorf("placeLayer() position %d = %v, want %v", i, v.cross, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGraph_Route(t *testing.T) {
+	g := &graph{}
+	a := g.addVertex(10, 10)
+	b := g.addVertex(10, 10)
+	forward := g.addEdge(a, b, 1)
+	backward := g.addEdge(b, a, 1)
+
+	g.layout(FlowchartDirectionTopToBottom, 50, 50)
+
+	if !backward.reversed {
+		t.Fatal("layout() did not reverse the edge closing the cycle")
+	}
+	if got := forward.points; got[0] != (point{a.x, a.y}) || got[len(got)-1] != (point{b.x, b.y}) {
+		t.Errorf("layout() forward points = %v, want from a to b", got)
+	}
+	if got := backward.points; got[0] != (point{b.x, b.y}) || got[len(got)-1] != (point{a.x, a.y}) {
+		t.Errorf("layout() backward points = %v, want from b to a", got)
+	}
+	want := point{(a.x + b.x) / 2, (a.y + b.y) / 2}
+	if forward.labelAt != want {
+		t.Errorf("layout() labelAt = %v, want %v", forward.labelAt, want)
+	}
+}
@@ -0,0 +1,537 @@
+package flowchart
+
+import (
+	"math"
+	"regexp"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Default sizes of the layout, matching the Mermaid defaults.
+const (
+	defaultFontSize       = 16
+	defaultNodeSpacing    = 50
+	defaultRankSpacing    = 50
+	defaultPadding        = 15
+	defaultDiagramPadding = 8
+	lineHeightFactor      = 1.5
+	charWidthFactor       = 0.6
+	smallCircleSize       = 14
+	forkLength            = 70
+	forkThickness         = 10
+	selfLoopSize          = 20
+)
+
+var lineBreak = regexp.MustCompile(`(?i)\n|<br\s*/?>`)
+
+// layoutMetrics holds the sizes used to lay out a flowchart.
+type layoutMetrics struct {
+	fontSize       float64
+	lineHeight     float64
+	nodeSpacing    float64
+	rankSpacing    float64
+	padding        float64
+	diagramPadding float64
+}
+
+// nodeLayout is the box of a node in the drawing.
+type nodeLayout struct {
+	node          *Node
+	lines         []string
+	x, y          float64
+	width, height float64
+}
+
+// subgraphLayout is the box of a subgraph in the drawing, with its title at
+// the top.
+type subgraphLayout struct {
+	subgraph      *Subgraph
+	lines         []string
+	x, y          float64
+	width, height float64
+}
+
+// linkLayout is the route of a link in the drawing, from its From node to its
+// To node, with its label centered on labelAt.
+type linkLayout struct {
+	link                    *Link
+	points                  []point
+	lines                   []string
+	labelAt                 point
+	labelWidth, labelHeight float64
+}
+
+// flowchartLayout is the geometry of a flowchart drawing. Subgraphs are listed
+// parents first so that they can be drawn in order.
+type flowchartLayout struct {
+	width, height float64
+	title         []string
+	titleAt       point
+	nodes         []*nodeLayout
+	subgraphs     []*subgraphLayout
+	links         []*linkLayout
+}
+
+// cluster is a subgraph, or the flowchart itself, while it is laid out: its
+// direct nodes and child clusters are laid out together as one graph, and the
+// cluster is then placed as a single vertex of its parent.
+type cluster struct {
+	subgraph  *Subgraph
+	parent    *cluster
+	depth     int
+	children  []*cluster
+	nodes     []*Node
+	links     []*Link
+	crossed   bool
+	direction flowchartDirection
+	vertex    *vertex
+	graph     *graph
+	layout    *subgraphLayout
+	width     float64
+	height    float64
+	content   float64
+	titleSize float64
+}
+
+// metrics returns the sizes used to lay out the flowchart, read from its
+// configuration when set.
+func (f *Flowchart) metrics() layoutMetrics {
+	return layoutMetrics{
+		fontSize:       defaultFontSize,
+		lineHeight:     defaultFontSize * lineHeightFactor,
+		nodeSpacing:    f.intProperty(flowchartPropertyNodeSpacing, defaultNodeSpacing),
+		rankSpacing:    f.intProperty(flowchartPropertyRankSpacing, defaultRankSpacing),
+		padding:        f.intProperty(flowchartPropertyPadding, defaultPadding),
+		diagramPadding: f.intProperty(flowchartPropertyDiagramPadding, defaultDiagramPadding),
+	}
+}
+
+// intProperty returns the value of an integer configuration property, or
+// fallback when it is unset or negative.
+func (f *Flowchart) intProperty(name string, fallback int) float64 {
+	if property, ok := f.Config.properties[name].(*basediagram.IntProperty); ok {
+		if v, ok := property.Val.(int); ok && v >= 0 {
+			return float64(v)
+		}
+	}
+	return float64(fallback)
+}
+
+// measure splits the text into lines and returns them with the size of the
+// text block. Text without lines is one line high.
+func (m layoutMetrics) measure(text string) ([]string, float64, float64) {
+	if text == "" {
+		return nil, 0, m.lineHeight
+	}
+
+	lines := lineBreak.Split(text, -1)
+	width := 0.0
+	for _, line := range lines {
+		if w := float64(len([]rune(line))) * m.fontSize * charWidthFactor; w > width {
+			width = w
+		}
+	}
+
+	return lines, width, float64(len(lines)) * m.lineHeight
+}
+
+// nodeSize returns the size of the node drawn with its shape around its text.
+func (m layoutMetrics) nodeSize(node *Node, horizontal bool) (float64, float64) {
+	_, textWidth, textHeight := m.measure(node.Text)
+	width, height := textWidth+2*m.padding, textHeight+m.padding
+
+	switch node.Shape {
+	case NodeShapeStart, NodeShapeStopDouble, NodeShapeStopFramed, NodeShapeSummary:
+		diameter := math.Max(textWidth, textHeight) + m.padding
+		if node.Shape == NodeShapeStopDouble || node.Shape == NodeShapeStopFramed {
+			diameter += 10
+		}
+		return diameter, diameter
+	case NodeShapeStartSmall, NodeShapeJunction:
+		return smallCircleSize, smallCircleSize
+	case NodeShapeForkJoin:
+		if horizontal {
+			return forkThickness, forkLength
+		}
+		return forkLength, forkThickness
+	case NodeShapeDecision:
+		side := textWidth + textHeight + 2*m.padding
+		return side, side
+	case NodeShapeExtract, NodeShapeManualFile:
+		side := textWidth + textHeight + 2*m.padding
+		return side, side * 0.75
+	case NodeShapePrepare, NodeShapeOdd, NodeShapeDelay, NodeShapeTerminal:
+		return width + height/2, height
+	case NodeShapeInputOutput, NodeShapeOutputInput:
+		return width + height/2, height
+	case NodeShapeManualOperation, NodeShapeManual:
+		return width + height, height
+	case NodeShapeDatabase:
+		return width, height + 2*cylinderRadius(width)
+	case NodeShapeDocument:
+		return width, height + height/4
+	case NodeShapeText:
+		return textWidth + m.padding, textHeight
+	}
+
+	return width, height
+}
+
+// cylinderRadius returns the vertical radius of the ellipses closing a
+// cylinder of the given width.
+func cylinderRadius(width float64) float64 {
+	return width / 2 / (2.5 + width/50)
+}
+
+// hidesText reports whether nodes of the shape are drawn without their text.
+func hidesText(shape nodeShape) bool {
+	return shape == NodeShapeStartSmall || shape == NodeShapeJunction || shape == NodeShapeForkJoin
+}
+
+// layout computes the geometry of the flowchart. Nodes belong to the first
+// subgraph mentioning them in one of its links, nested subgraphs first, as in
+// Mermaid; the other nodes and the nodes only referenced by links belong to
+// the flowchart itself. A subgraph direction is only honored when no link
+// crosses the subgraph, as in Mermaid.
+func (f *Flowchart) layout() *flowchartLayout {
+	m := f.metrics()
+	l := &flowchartLayout{}
+
+	root := &cluster{direction: f.Direction}
+	owners := make(map[*Node]*cluster)
+	var nodes []*Node
+	addNode := func(node *Node) {
+		if _, ok := owners[node]; !ok {
+			owners[node] = root
+			nodes = append(nodes, node)
+		}
+	}
+	for _, node := range f.nodes {
+		if node != nil {
+			addNode(node)
+		}
+	}
+
+	var links []*Link
+	var addSubgraph func(parent *cluster, subgraph *Subgraph)
+	addSubgraph = func(parent *cluster, subgraph *Subgraph) {
+		c := &cluster{subgraph: subgraph, parent: parent, depth: parent.depth + 1}
+		c.layout = &subgraphLayout{subgraph: subgraph}
+		c.layout.lines, _, _ = m.measure(subgraph.Title)
+		l.subgraphs = append(l.subgraphs, c.layout)
+		parent.children = append(parent.children, c)
+
+		for _, child := range subgraph.subgraphs {
+			if child != nil {
+				addSubgraph(c, child)
+			}
+		}
+		for _, link := range subgraph.links {
+			if link == nil {
+				continue
+			}
+			links = append(links, link)
+			for _, node := range []*Node{link.From, link.To} {
+				if node == nil {
+					continue
+				}
+				addNode(node)
+				if owners[node] == root {
+					owners[node] = c
+				}
+			}
+		}
+	}
+	for _, subgraph := range f.subgraphs {
+		if subgraph != nil {
+			addSubgraph(root, subgraph)
+		}
+	}
+
+	for _, link := range f.links {
+		if link == nil {
+			continue
+		}
+		links = append(links, link)
+		for _, node := range []*Node{link.From, link.To} {
+			if node != nil {
+				addNode(node)
+			}
+		}
+	}
+
+	nodeLayouts := make(map[*Node]*nodeLayout, len(nodes))
+	for _, node := range nodes {
+		nl := &nodeLayout{node: node}
+		nl.lines, _, _ = m.measure(node.Text)
+		nodeLayouts[node] = nl
+		l.nodes = append(l.nodes, nl)
+		owners[node].nodes = append(owners[node].nodes, node)
+	}
+
+	// loops holds the room taken by the self-loops on the right of each node.
+	loops := make(map[*Node]float64)
+	for _, link := range links {
+		if link.From == nil || link.To == nil {
+			continue
+		}
+
+		ll := &linkLayout{link: link}
+		ll.lines, ll.labelWidth, ll.labelHeight = m.measure(link.Text)
+		if ll.lines == nil {
+			ll.labelWidth, ll.labelHeight = 0, 0
+		} else {
+			ll.labelWidth += m.padding / 2
+		}
+		l.links = append(l.links, ll)
+
+		if link.From == link.To {
+			loops[link.From] += selfLoopSize + ll.labelWidth
+			continue
+		}
+
+		from, to := owners[link.From], owners[link.To]
+		for from.depth > to.depth {
+			from.crossed = true
+			from = from.parent
+		}
+		for to.depth > from.depth {
+			to.crossed = true
+			to = to.parent
+		}
+		for from != to {
+			from.crossed, to.crossed = true, true
+			from, to = from.parent, to.parent
+		}
+		from.links = append(from.links, link)
+	}
+
+	// Lay out the clusters from the innermost ones, each as one graph.
+	linkEdges := make(map[*Link]*edge, len(links))
+	vertices := make(map[*Node]*vertex, len(nodes))
+	var layoutCluster func(c *cluster)
+	layoutCluster = func(c *cluster) {
+		if c.parent != nil {
+			c.direction = c.parent.direction
+			if c.subgraph.Direction != SubgraphDirectionNone && !c.crossed {
+				c.direction = flowchartDirection(c.subgraph.Direction)
+			}
+		}
+		horizontal := c.direction == FlowchartDirectionLeftRight || c.direction == FlowchartDirectionRightLeft
+
+		c.graph = &graph{}
+		for _, node := range c.nodes {
+			width, height := m.nodeSize(node, horizontal)
+			nodeLayouts[node].width, nodeLayouts[node].height = width, height
+			vertices[node] = c.graph.addVertex(width+2*loops[node], height)
+		}
+		for _, child := range c.children {
+			layoutCluster(child)
+			child.vertex = c.graph.addVertex(child.width, child.height)
+		}
+
+		// item returns the vertex standing for the node in the graph of c.
+		item := func(node *Node) *vertex {
+			owner := owners[node]
+			if owner == c {
+				return vertices[node]
+			}
+			for owner.parent != c {
+				owner = owner.parent
+			}
+			return owner.vertex
+		}
+
+		labelled := false
+		for _, link := range c.links {
+			labelled = labelled || link.Text != ""
+		}
+		rankSpacing := m.rankSpacing
+		if labelled {
+			rankSpacing /= 2
+		}
+
+		for _, link := range c.links {
+			minLength := 1
+			if link.Length > 0 {
+				minLength += link.Length
+			}
+			if labelled {
+				minLength *= 2
+			}
+			e := c.graph.addEdge(item(link.From), item(link.To), minLength)
+			if link.Text != "" {
+				_, width, height := m.measure(link.Text)
+				e.labelWidth, e.labelHeight = width+m.padding/2, height
+			}
+			linkEdges[link] = e
+		}
+
+		width, height := c.graph.layout(c.direction, m.nodeSpacing, rankSpacing)
+		c.width, c.height, c.content = width, height, width
+		if c.parent != nil {
+			_, titleWidth, titleHeight := m.measure(c.subgraph.Title)
+			c.titleSize = titleHeight
+			c.width = math.Max(width, titleWidth) + 2*m.padding
+			c.height = height + titleHeight + 2*m.padding
+		}
+	}
+	layoutCluster(root)
+
+	// Place the clusters from the outermost ones, translating the graph of
+	// each cluster to the top left corner of its content.
+	var placeCluster func(c *cluster, left, top float64)
+	placeCluster = func(c *cluster, left, top float64) {
+		originX, originY := left, top
+		if c.parent != nil {
+			c.layout.x, c.layout.y = left, top
+			c.layout.width, c.layout.height = c.width, c.height
+			originX += (c.width - c.content) / 2
+			originY += c.titleSize + m.padding
+		}
+
+		for _, v := range c.graph.vertices {
+			v.x += originX
+			v.y += originY
+		}
+		for _, e := range c.graph.edges {
+			for i := range e.points {
+				e.points[i].x += originX
+				e.points[i].y += originY
+			}
+			e.labelAt.x += originX
+			e.labelAt.y += originY
+		}
+
+		for _, node := range c.nodes {
+			nodeLayouts[node].x, nodeLayouts[node].y = vertices[node].x, vertices[node].y
+		}
+		for _, child := range c.children {
+			placeCluster(child, child.vertex.x-child.width/2, child.vertex.y-child.height/2)
+		}
+	}
+	placeCluster(root, 0, 0)
+
+	for _, ll := range l.links {
+		from, to := nodeLayouts[ll.link.From], nodeLayouts[ll.link.To]
+		if from == to {
+			ll.points = []point{
+				{from.x + from.width/2, from.y - from.height/4},
+				{from.x + from.width/2 + selfLoopSize, from.y - from.height/4},
+				{from.x + from.width/2 + selfLoopSize, from.y + from.height/4},
+				{from.x + from.width/2, from.y + from.height/4},
+			}
+			ll.labelAt = point{from.x + from.width/2 + selfLoopSize + ll.labelWidth/2, from.y}
+			continue
+		}
+
+		e := linkEdges[ll.link]
+		ll.points = append([]point(nil), e.points...)
+		ll.labelAt = e.labelAt
+
+		last := len(ll.points) - 1
+		ll.points[0] = point{from.x, from.y}
+		ll.points[last] = point{to.x, to.y}
+		start, end := ll.points[1], ll.points[last-1]
+		ll.points[0] = from.clip(start)
+		ll.points[last] = to.clip(end)
+	}
+
+	l.normalize(m, f.Title)
+
+	return l
+}
+
+// clip returns the point where the segment from the center of the node
+// toward p leaves the outline of the node.
+func (n *nodeLayout) clip(p point) point {
+	dx, dy := p.x-n.x, p.y-n.y
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return p
+	}
+	ux, uy := dx/length, dy/length
+	halfWidth, halfHeight := n.width/2, n.height/2
+
+	var t float64
+	switch n.node.Shape {
+	case NodeShapeStart, NodeShapeStopDouble, NodeShapeStopFramed, NodeShapeSummary,
+		NodeShapeStartSmall, NodeShapeJunction:
+		t = math.Min(halfWidth, halfHeight)
+	case NodeShapeDecision:
+		t = 1 / (math.Abs(ux)/halfWidth + math.Abs(uy)/halfHeight)
+	default:
+		t = math.Inf(1)
+		if ux != 0 {
+			t = halfWidth / math.Abs(ux)
+		}
+		if uy != 0 {
+			t = math.Min(t, halfHeight/math.Abs(uy))
+		}
+	}
+	t = math.Min(t, length)
+
+	return point{n.x + ux*t, n.y + uy*t}
+}
+
+// normalize moves the drawing so that everything it contains, the title
+// included, lies inside its size after the diagram padding.
+func (l *flowchartLayout) normalize(m layoutMetrics, title string) {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	extend := func(x, y, width, height float64) {
+		minX, minY = math.Min(minX, x), math.Min(minY, y)
+		maxX, maxY = math.Max(maxX, x+width), math.Max(maxY, y+height)
+	}
+
+	for _, n := range l.nodes {
+		extend(n.x-n.width/2, n.y-n.height/2, n.width, n.height)
+	}
+	for _, s := range l.subgraphs {
+		extend(s.x, s.y, s.width, s.height)
+	}
+	for _, link := range l.links {
+		for _, p := range link.points {
+			extend(p.x, p.y, 0, 0)
+		}
+		if link.lines != nil {
+			extend(link.labelAt.x-link.labelWidth/2, link.labelAt.y-link.labelHeight/2, link.labelWidth, link.labelHeight)
+		}
+	}
+	if math.IsInf(minX, 1) {
+		minX, minY, maxX, maxY = 0, 0, 0, 0
+	}
+
+	titleHeight := 0.0
+	if title != "" {
+		var titleWidth float64
+		l.title, titleWidth, titleHeight = m.measure(title)
+		titleHeight += m.padding
+		if titleWidth > maxX-minX {
+			minX -= (titleWidth - (maxX - minX)) / 2
+			maxX = minX + titleWidth
+		}
+	}
+
+	dx, dy := m.diagramPadding-minX, m.diagramPadding+titleHeight-minY
+	for _, n := range l.nodes {
+		n.x += dx
+		n.y += dy
+	}
+	for _, s := range l.subgraphs {
+		s.x += dx
+		s.y += dy
+	}
+	for _, link := range l.links {
+		for i := range link.points {
+			link.points[i].x += dx
+			link.points[i].y += dy
+		}
+		link.labelAt.x += dx
+		link.labelAt.y += dy
+	}
+
+	l.width = maxX - minX + 2*m.diagramPadding
+	l.height = maxY - minY + titleHeight + 2*m.diagramPadding
+	l.titleAt = point{l.width / 2, m.diagramPadding + (titleHeight-m.padding)/2}
+}
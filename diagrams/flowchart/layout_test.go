@@ -0,0 +1,337 @@
+package flowchart
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// overlaps reports whether two node boxes overlap.
+func overlaps(a, b *nodeLayout) bool {
+	return math.Abs(a.x-b.x) < (a.width+b.width)/2 && math.Abs(a.y-b.y) < (a.height+b.height)/2
+}
+
+// inside reports whether the node box lies inside the subgraph box.
+func inside(n *nodeLayout, s *subgraphLayout) bool {
+	return n.x-n.width/2 >= s.x && n.x+n.width/2 <= s.x+s.width &&
+		n.y-n.height/2 >= s.y && n.y+n.height/2 <= s.y+s.height
+}
+
+func findNode(l *flowchartLayout, node *Node) *nodeLayout {
+	for _, n := range l.nodes {
+		if n.node == node {
+			return n
+		}
+	}
+	return nil
+}
+
+func findSubgraph(l *flowchartLayout, subgraph *Subgraph) *subgraphLayout {
+	for _, s := range l.subgraphs {
+		if s.subgraph == subgraph {
+			return s
+		}
+	}
+	return nil
+}
+
+func TestFlowchart_Layout(t *testing.T) {
+	tests := []struct {
+		name      string
+		direction flowchartDirection
+		setup     func(*Flowchart)
+	}{
+		{
+			name:  "Empty flowchart",
+			setup: func(f *Flowchart) {},
+		},
+		{
+			name: "Chain with a cycle",
+			setup: func(f *Flowchart) {
+				a := f.AddNode("A")
+				b := f.AddNode("B")
+				c := f.AddNode("C")
+				f.AddLink(a, b)
+				f.AddLink(b, c)
+				f.AddLink(c, a)
+			},
+		},
+		{
+			name:      "Left to right with labels and long links",
+			direction: FlowchartDirectionLeftRight,
+			setup: func(f *Flowchart) {
+				a := f.AddNode("Start").SetShape(NodeShapeTerminal)
+				b := f.AddNode("Is it?").SetShape(NodeShapeDecision)
+				c := f.AddNode("Yes")
+				d := f.AddNode("No")
+				f.AddLink(a, b)
+				f.AddLink(b, c).SetText("yes")
+				f.AddLink(b, d).SetText("no").SetLength(2)
+				f.AddLink(a, d)
+			},
+		},
+		{
+			name: "Nested subgraphs",
+			setup: func(f *Flowchart) {
+				a := f.AddNode("A")
+				b := f.AddNode("B")
+				c := f.AddNode("C")
+				d := f.AddNode("D")
+				outer := f.AddSubgraph("Outer")
+				inner := outer.AddSubgraph("Inner")
+				inner.AddLink(a, b)
+				outer.AddLink(b, c)
+				f.AddLink(c, d)
+				f.AddLink(d, a)
+				f.AddLink(a, a).SetText("again")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFlowchart()
+			if tt.direction != "" {
+				f.SetDirection(tt.direction)
+			}
+			tt.setup(f)
+
+			l := f.layout()
+
+			if len(l.nodes) != len(f.nodes) {
+				t.Fatalf("layout() has %d nodes, want %d", len(l.nodes), len(f.nodes))
+			}
+			for i, a := range l.nodes {
+				if a.x-a.width/2 < 0 || a.y-a.height/2 < 0 || a.x+a.width/2 > l.width || a.y+a.height/2 > l.height {
+					t.Errorf("node %q lies outside the %vx%v drawing", a.node.Text, l.width, l.height)
+				}
+				for _, b := range l.nodes[i+1:] {
+					if overlaps(a, b) {
+						t.Errorf("nodes %q and %q overlap", a.node.Text, b.node.Text)
+					}
+				}
+			}
+			for _, link := range l.links {
+				if len(link.points) < 2 {
+					t.Errorf("link from %q to %q has %d points", link.link.From.Text, link.link.To.Text, len(link.points))
+				}
+			}
+		})
+	}
+}
+
+func TestFlowchart_Layout_Subgraphs(t *testing.T) {
+	f := NewFlowchart()
+	a := f.AddNode("A")
+	b := f.AddNode("B")
+	c := f.AddNode("C")
+	d := f.AddNode("D")
+	e := f.AddNode("E")
+	outer := f.AddSubgraph("Outer")
+	inner := outer.AddSubgraph("Inner")
+	other := f.AddSubgraph("Other")
+	inner.AddLink(a, b)
+	outer.AddLink(b, c)
+	other.AddLink(c, d)
+	f.AddLink(d, e)
+
+	l := f.layout()
+
+	outerBox, innerBox, otherBox := findSubgraph(l, outer), findSubgraph(l, inner), findSubgraph(l, other)
+	if !reflect.DeepEqual(l.subgraphs, []*subgraphLayout{outerBox, innerBox, otherBox}) {
+		t.Errorf("layout() subgraphs are not listed parents first")
+	}
+
+	for _, tt := range []struct {
+		node     *Node
+		subgraph *subgraphLayout
+		want     bool
+	}{
+		{a, innerBox, true},
+		{b, innerBox, true},
+		{c, innerBox, false},
+		{c, outerBox, true},
+		{d, outerBox, false},
+		{d, otherBox, true},
+		{e, outerBox, false},
+		{e, otherBox, false},
+	} {
+		if got := inside(findNode(l, tt.node), tt.subgraph); got != tt.want {
+			t.Errorf("node %q inside subgraph %q = %v, want %v", tt.node.Text, tt.subgraph.subgraph.Title, got, tt.want)
+		}
+	}
+
+	if innerBox.x < outerBox.x || innerBox.y < outerBox.y ||
+		innerBox.x+innerBox.width > outerBox.x+outerBox.width || innerBox.y+innerBox.height > outerBox.y+outerBox.height {
+		t.Errorf("subgraph %q does not lie inside subgraph %q", inner.Title, outer.Title)
+	}
+}
+
+func TestFlowchart_Layout_SubgraphDirection(t *testing.T) {
+	tests := []struct {
+		name       string
+		crossed    bool
+		horizontal bool
+	}{
+		{
+			name:       "Direction of a standalone subgraph is honored",
+			crossed:    false,
+			horizontal: true,
+		},
+		{
+			name:       "Direction of a crossed subgraph is ignored",
+			crossed:    true,
+			horizontal: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFlowchart()
+			a := f.AddNode("A")
+			b := f.AddNode("B")
+			c := f.AddNode("C")
+			subgraph := f.AddSubgraph("Sub")
+			subgraph.Direction = SubgraphDirectionLeftRight
+			subgraph.AddLink(a, b)
+			if tt.crossed {
+				f.AddLink(b, c)
+			}
+
+			l := f.layout()
+			na, nb := findNode(l, a), findNode(l, b)
+			if got := na.y == nb.y && nb.x > na.x; got != tt.horizontal {
+				t.Errorf("A at (%v, %v) and B at (%v, %v), want horizontal = %v", na.x, na.y, nb.x, nb.y, tt.horizontal)
+			}
+		})
+	}
+}
+
+func TestFlowchart_Metrics(t *testing.T) {
+	f := NewFlowchart()
+	f.Config.SetNodeSpacing(80).SetRankSpacing(20).SetPadding(10).SetDiagramPadding(4)
+
+	want := layoutMetrics{
+		fontSize:       16,
+		lineHeight:     24,
+		nodeSpacing:    80,
+		rankSpacing:    20,
+		padding:        10,
+		diagramPadding: 4,
+	}
+	if got := f.metrics(); got != want {
+		t.Errorf("metrics() = %+v, want %+v", got, want)
+	}
+
+	defaults := layoutMetrics{
+		fontSize:       defaultFontSize,
+		lineHeight:     defaultFontSize * lineHeightFactor,
+		nodeSpacing:    defaultNodeSpacing,
+		rankSpacing:    defaultRankSpacing,
+		padding:        defaultPadding,
+		diagramPadding: defaultDiagramPadding,
+	}
+	if got := NewFlowchart().metrics(); got != defaults {
+		t.Errorf("metrics() = %+v, want %+v", got, defaults)
+	}
+}
+
+func TestLayoutMetrics_Measure(t *testing.T) {
+	m := NewFlowchart().metrics()
+
+	tests := []struct {
+		name       string
+		text       string
+		wantLines  []string
+		wantWidth  float64
+		wantHeight float64
+	}{
+		{
+			name:       "Empty text",
+			text:       "",
+			wantLines:  nil,
+			wantWidth:  0,
+			wantHeight: 24,
+		},
+		{
+			name:       "Single line",
+			text:       "Hello",
+			wantLines:  []string{"Hello"},
+			wantWidth:  48,
+			wantHeight: 24,
+		},
+		{
+			name:       "Line breaks",
+			text:       "One<br>Two<BR/>Three\nFour",
+			wantLines:  []string{"One", "Two", "Three", "Four"},
+			wantWidth:  48,
+			wantHeight: 96,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, width, height := m.measure(tt.text)
+			if !reflect.DeepEqual(lines, tt.wantLines) || math.Abs(width-tt.wantWidth) > 1e-9 || height != tt.wantHeight {
+				t.Errorf("measure() = %q, %v, %v, want %q, %v, %v", lines, width, height, tt.wantLines, tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+}
+
+func TestLayoutMetrics_NodeSize(t *testing.T) {
+	m := NewFlowchart().metrics()
+
+	tests := []struct {
+		name       string
+		shape      nodeShape
+		horizontal bool
+		wantWidth  float64
+		wantHeight float64
+	}{
+		{"Process", NodeShapeProcess, false, 78, 39},
+		{"Circle", NodeShapeStart, false, 63, 63},
+		{"Decision", NodeShapeDecision, false, 102, 102},
+		{"Junction", NodeShapeJunction, false, 14, 14},
+		{"Fork", NodeShapeForkJoin, false, 70, 10},
+		{"Fork left to right", NodeShapeForkJoin, true, 10, 70},
+		{"Text", NodeShapeText, false, 63, 24},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := NewNode("id", "Hello").SetShape(tt.shape)
+			width, height := m.nodeSize(node, tt.horizontal)
+			if math.Abs(width-tt.wantWidth) > 1e-9 || math.Abs(height-tt.wantHeight) > 1e-9 {
+				t.Errorf("nodeSize() = %v, %v, want %v, %v", width, height, tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+}
+
+func TestNodeLayout_Clip(t *testing.T) {
+	tests := []struct {
+		name  string
+		shape nodeShape
+		to    point
+		want  point
+	}{
+		{"Rectangle side", NodeShapeProcess, point{100, 0}, point{20, 0}},
+		{"Rectangle top", NodeShapeProcess, point{0, -100}, point{0, -10}},
+		{"Rectangle corner", NodeShapeProcess, point{40, 20}, point{20, 10}},
+		{"Circle", NodeShapeStart, point{0, 100}, point{0, 10}},
+		{"Diamond", NodeShapeDecision, point{100, 100}, point{20.0 / 3, 20.0 / 3}},
+		{"Point inside", NodeShapeProcess, point{5, 0}, point{5, 0}},
+		{"Center", NodeShapeProcess, point{0, 0}, point{0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := &nodeLayout{node: NewNode("id", "").SetShape(tt.shape), width: 40, height: 20}
+			got := n.clip(tt.to)
+			if math.Abs(got.x-tt.want.x) > 1e-9 || math.Abs(got.y-tt.want.y) > 1e-9 {
+				t.Errorf("clip() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
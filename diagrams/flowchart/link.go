@@ -2,6 +2,7 @@ package flowchart
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
@@ -93,7 +94,12 @@ func (l *Link) SetTail(arrowType linkArrowType) *Link {
 // including its shape, arrow types, text, and length.
 func (l *Link) String() string {
 	var sb strings.Builder
+	l.WriteTo(&sb)
+	return sb.String()
+}
 
+// WriteTo writes the Mermaid representation of the link to w.
+func (l *Link) WriteTo(w io.Writer) (int64, error) {
 	extension := ""
 	for i := 0; i < l.Length; i++ {
 		extension += string(l.Shape[1])
@@ -101,10 +107,9 @@ func (l *Link) String() string {
 
 	text := ""
 	if len(l.Text) > 0 {
-		text = fmt.Sprintf(string(baseLinkTextString), l.Text)
+		text = fmt.Sprintf(string(baseLinkTextString), basediagram.Escape(l.Text))
 	}
 
-	sb.WriteString(fmt.Sprintf(string(baseLinkString), l.From.ID, string(l.Tail), fmt.Sprintf(string(l.Shape), extension), string(l.Head), text, l.To.ID))
-
-	return sb.String()
+	n, err := fmt.Fprintf(w, string(baseLinkString), l.From.ID, string(l.Tail), fmt.Sprintf(string(l.Shape), extension), string(l.Head), text, l.To.ID)
+	return int64(n), err
 }
@@ -78,6 +78,16 @@ func TestLink_String(t *testing.T) {
 				"1 --> 2",
 			},
 		},
+		{
+			name: "Link with pipe in text",
+			link: NewLink(from, to),
+			setup: func(l *Link) {
+				l.SetText("yes|no")
+			},
+			contains: []string{
+				"1 -->|yes#124;no| 2",
+			},
+		},
 		{
 			name: "Link with text",
 			link: &Link{
@@ -175,3 +185,22 @@ func TestLink_String(t *testing.T) {
 		})
 	}
 }
+
+func TestLink_WriteTo(t *testing.T) {
+	link := NewLink(NewNode("1", "Start"), NewNode("2", "End"))
+	link.SetText("Yes").SetShape(LinkShapeThick).SetLength(2)
+
+	var sb strings.Builder
+	n, err := link.WriteTo(&sb)
+	if err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+
+	want := link.String()
+	if sb.String() != want {
+		t.Errorf("WriteTo() = %q, want %q", sb.String(), want)
+	}
+	if n != int64(len(want)) {
+		t.Errorf("WriteTo() wrote %d bytes, want %d", n, len(want))
+	}
+}
@@ -1,7 +1,7 @@
 package flowchart
 
 import (
-	"fmt"
+	"io"
 	"strings"
 
 	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
@@ -113,18 +113,25 @@ func (n *Node) SetShape(shape nodeShape) *Node {
 // String generates a Mermaid string representation of the node, including its shape, class, and style.
 func (n *Node) String() string {
 	var sb strings.Builder
+	n.WriteTo(&sb)
+	return sb.String()
+}
 
-	sb.WriteString(fmt.Sprintf(string(baseNodeShapeString), n.ID, string(n.Shape), n.Text))
+// WriteTo writes the Mermaid representation of the node to w.
+func (n *Node) WriteTo(w io.Writer) (int64, error) {
+	cw := basediagram.NewWriter(w)
+
+	cw.Printf(string(baseNodeShapeString), n.ID, string(n.Shape), basediagram.Escape(n.Text))
 
 	if n.Class != nil {
-		sb.WriteString(fmt.Sprintf(string(baseNodeClassString), n.Class.Name))
+		cw.Printf(string(baseNodeClassString), n.Class.Name)
 	}
 
-	sb.WriteByte('\n')
+	cw.WriteString("\n")
 
 	if n.Style != nil {
-		sb.WriteString(fmt.Sprintf(string(baseNodeStyleString), n.ID, n.Style.String()))
+		cw.Printf(string(baseNodeStyleString), n.ID, n.Style.String())
 	}
 
-	return sb.String()
+	return cw.Result()
 }
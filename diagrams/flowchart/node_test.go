@@ -60,6 +60,13 @@ func TestNode_String(t *testing.T) {
 				"1@{ shape: rect, label: \"Test Node\"}",
 			},
 		},
+		{
+			name: "Node with quotes in text",
+			node: NewNode("1", `Say "hi"; #1`),
+			contains: []string{
+				"1@{ shape: rect, label: \"Say #quot;hi#quot;#59; #35;1\"}",
+			},
+		},
 		{
 			name: "Node with custom shape",
 			node: NewNode("2", "Diamond Node"),
@@ -219,3 +226,22 @@ func TestNode_SetShape(t *testing.T) {
 		})
 	}
 }
+
+func TestNode_WriteTo(t *testing.T) {
+	node := NewNode("5", "Complex Node")
+	node.SetShape(NodeShapeDatabase).SetClass(NewClass("db")).SetStyle(NewNodeStyle())
+
+	var sb strings.Builder
+	n, err := node.WriteTo(&sb)
+	if err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+
+	want := node.String()
+	if sb.String() != want {
+		t.Errorf("WriteTo() = %q, want %q", sb.String(), want)
+	}
+	if n != int64(len(want)) {
+		t.Errorf("WriteTo() wrote %d bytes, want %d", n, len(want))
+	}
+}
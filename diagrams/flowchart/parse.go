@@ -0,0 +1,649 @@
+package flowchart
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Node shape delimiters of the classic bracket syntax, longest first.
+// Reference: https://mermaid.js.org/syntax/flowchart.html#node-shapes
+var nodeShapeDelimiters = []struct {
+	open  string
+	close string
+	shape nodeShape
+}{
+	{"(((", ")))", NodeShapeStopDouble},
+	{"((", "))", NodeShapeStart},
+	{"([", "])", NodeShapeTerminal},
+	{"[[", "]]", NodeShapeSubprocess},
+	{"[(", ")]", NodeShapeDatabase},
+	{"{{", "}}", NodeShapePrepare},
+	{"[/", "/]", NodeShapeInputOutput},
+	{"[/", `\]`, NodeShapeManualOperation},
+	{`[\`, `\]`, NodeShapeOutputInput},
+	{`[\`, "/]", NodeShapeManual},
+	{"[", "]", NodeShapeProcess},
+	{"(", ")", NodeShapeEvent},
+	{"{", "}", NodeShapeDecision},
+	{">", "]", NodeShapeOdd},
+}
+
+var (
+	linkPattern        = regexp.MustCompile(`^([<ox]?)(-\.+-|-{2,}|={2,}|~{2,})([>ox]?)`)
+	linkOpeningPattern = regexp.MustCompile(`^([<ox]?)(--|-\.|==)\s`)
+	linkClosingPattern = map[string]*regexp.Regexp{
+		"--": regexp.MustCompile(`\s(-{2,})([>ox]?)(\s|$)`),
+		"-.": regexp.MustCompile(`\s(\.+-)([>ox]?)(\s|$)`),
+		"==": regexp.MustCompile(`\s(={2,})([>ox]?)(\s|$)`),
+	}
+)
+
+type openSubgraph struct {
+	subgraph *Subgraph
+	line     parser.Line
+}
+
+type flowchartParser struct {
+	flowchart *Flowchart
+	nodes     map[string]*Node
+	classes   map[string]*Class
+	subgraphs []openSubgraph
+	nextID    int
+}
+
+// Parse reads Mermaid flowchart syntax and returns the corresponding Flowchart.
+// It understands the syntax generated by Flowchart.String as well as the classic
+// bracket node shapes, `graph` headers, node chains and `&` node groups.
+// Nodes declared inside a subgraph are added to the flowchart itself.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Flowchart, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, direction, err := doc.Header(diagramType, "graph")
+	if err != nil {
+		return nil, err
+	}
+
+	p := &flowchartParser{
+		flowchart: NewFlowchart(),
+		nodes:     make(map[string]*Node),
+		classes:   make(map[string]*Class),
+	}
+	p.flowchart.Title = doc.Title
+	if err := doc.Config.Apply(&p.flowchart.Config.ConfigurationProperties, flowchartConfigurationSection, p.flowchart.Config.properties); err != nil {
+		return nil, err
+	}
+
+	if direction != "" {
+		if !p.setDirection(direction) {
+			return nil, header.Errorf(len(header.Text)-len(direction), "unknown direction %q", direction)
+		}
+	}
+
+	for _, line := range doc.Body() {
+		for _, statement := range splitStatements(line) {
+			if err := p.parseLine(statement); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(p.subgraphs) > 0 {
+		open := p.subgraphs[len(p.subgraphs)-1]
+		return nil, open.line.Errorf(0, "subgraph %q is missing its end", open.subgraph.ID)
+	}
+
+	// Skip the IDs taken by parsed nodes and subgraphs so that elements
+	// added afterwards do not collide with them.
+	for i := 0; i < p.nextID; i++ {
+		p.flowchart.idGenerator.NextID()
+	}
+
+	return p.flowchart, nil
+}
+
+// splitStatements splits line into the statements separated by ";", such as
+// `A-->B;B-->C`. Semicolons in quoted text, node labels and link labels do
+// not separate statements. Empty statements are dropped.
+func splitStatements(line parser.Line) []parser.Line {
+	var statements []parser.Line
+	add := func(start, end int) {
+		text := strings.TrimRightFunc(line.Text[start:end], unicode.IsSpace)
+		trimmed := strings.TrimLeftFunc(text, unicode.IsSpace)
+		if trimmed == "" {
+			return
+		}
+		statement := line
+		statement.Text = trimmed
+		statement.Offset = line.Offset + start + len(text) - len(trimmed)
+		statements = append(statements, statement)
+	}
+
+	start, depth := 0, 0
+	quoted, piped := false, false
+	for i := 0; i < len(line.Text); i++ {
+		switch c := line.Text[i]; {
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '|' && depth == 0:
+			piped = !piped
+		case piped:
+		case c == '[' || c == '(' || c == '{':
+			depth++
+		case (c == ']' || c == ')' || c == '}') && depth > 0:
+			depth--
+		case c == ';' && depth == 0:
+			add(start, i)
+			start = i + 1
+		}
+	}
+	add(start, len(line.Text))
+
+	return statements
+}
+
+func (p *flowchartParser) parseLine(line parser.Line) error {
+	s := parser.NewScanner(line)
+	keyword := s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) })
+	s.SkipSpaces()
+
+	switch keyword {
+	case "subgraph":
+		return p.parseSubgraph(s)
+	case "end":
+		if len(p.subgraphs) == 0 {
+			return line.Errorf(0, "end without subgraph")
+		}
+		p.subgraphs = p.subgraphs[:len(p.subgraphs)-1]
+		return nil
+	case "direction":
+		if !p.setDirection(s.Rest()) {
+			return s.Errorf("unknown direction %q", s.Rest())
+		}
+		return nil
+	case "classDef":
+		return p.parseClassDef(s)
+	case "class":
+		return p.parseClassAssignment(s)
+	case "style":
+		return p.parseStyle(s)
+	case "linkStyle", "click", "accTitle", "accDescr", "accTitle:", "accDescr:":
+		return line.Errorf(0, "unsupported statement %q", keyword)
+	}
+
+	return p.parseStatement(parser.NewScanner(line))
+}
+
+// setDirection sets the direction of the innermost open subgraph, or of the
+// flowchart itself outside of subgraphs. It reports whether direction is valid.
+func (p *flowchartParser) setDirection(direction string) bool {
+	if len(p.subgraphs) > 0 {
+		subgraph := p.subgraphs[len(p.subgraphs)-1].subgraph
+		switch subgraphDirection(direction) {
+		case SubgraphDirectionTopToBottom, SubgraphDirectionBottomUp, SubgraphDirectionRightLeft, SubgraphDirectionLeftRight:
+			subgraph.Direction = subgraphDirection(direction)
+		case subgraphDirection(FlowchartDirectionTopDown):
+			subgraph.Direction = SubgraphDirectionTopToBottom
+		default:
+			return false
+		}
+		return true
+	}
+
+	switch flowchartDirection(direction) {
+	case FlowchartDirectionTopToBottom, FlowchartDirectionTopDown, FlowchartDirectionBottomUp, FlowchartDirectionRightLeft, FlowchartDirectionLeftRight:
+		p.flowchart.Direction = flowchartDirection(direction)
+	default:
+		return false
+	}
+	return true
+}
+
+func (p *flowchartParser) parseSubgraph(s *parser.Scanner) error {
+	id := s.ReadWhile(parser.IsIdentifier)
+	if id == "" {
+		return s.Errorf("expected subgraph identifier")
+	}
+	s.SkipSpaces()
+
+	title := id
+	if s.Consume("[") {
+		rest := s.Rest()
+		if !strings.HasSuffix(rest, "]") {
+			return s.ErrorAt(s.Pos()+len(rest), "expected ']'")
+		}
+		title = basediagram.Unescape(unquote(rest[:len(rest)-1]))
+	} else if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	subgraph := NewSubgraph(id, title)
+	subgraph.idGenerator = p.flowchart.idGenerator
+	p.reserveID(id)
+
+	if len(p.subgraphs) == 0 {
+		p.flowchart.subgraphs = append(p.flowchart.subgraphs, subgraph)
+	} else {
+		parent := p.subgraphs[len(p.subgraphs)-1].subgraph
+		parent.subgraphs = append(parent.subgraphs, subgraph)
+	}
+
+	p.subgraphs = append(p.subgraphs, openSubgraph{
+		subgraph: subgraph,
+		line:     s.Line(),
+	})
+
+	return nil
+}
+
+func (p *flowchartParser) parseClassDef(s *parser.Scanner) error {
+	namesPos := s.Pos()
+	names := s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) })
+	if names == "" {
+		return s.Errorf("expected class name")
+	}
+	s.SkipSpaces()
+
+	style, err := parseNodeStyle(s)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range strings.Split(names, ",") {
+		if name == "" {
+			return s.ErrorAt(namesPos, "expected class name")
+		}
+		class := p.class(name)
+		styleCopy := *style
+		class.Style = &styleCopy
+	}
+
+	return nil
+}
+
+func (p *flowchartParser) parseClassAssignment(s *parser.Scanner) error {
+	ids := s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) })
+	s.SkipSpaces()
+	name := s.Rest()
+	if ids == "" || name == "" {
+		return s.Errorf("expected node identifiers and class name")
+	}
+
+	class := p.class(name)
+	for _, id := range strings.Split(ids, ",") {
+		p.node(id).Class = class
+	}
+
+	return nil
+}
+
+func (p *flowchartParser) parseStyle(s *parser.Scanner) error {
+	id := s.ReadWhile(parser.IsIdentifier)
+	if id == "" {
+		return s.Errorf("expected node identifier")
+	}
+	s.SkipSpaces()
+
+	style, err := parseNodeStyle(s)
+	if err != nil {
+		return err
+	}
+
+	p.node(id).Style = style
+
+	return nil
+}
+
+// parseNodeStyle reads a comma separated list of style properties.
+func parseNodeStyle(s *parser.Scanner) (*NodeStyle, error) {
+	style := &NodeStyle{}
+
+	if s.EOF() {
+		return nil, s.Errorf("expected style properties")
+	}
+
+	for !s.EOF() {
+		start := s.Pos()
+		property := s.ReadWhile(func(r rune) bool { return r != ',' })
+		s.Consume(",")
+
+		name, value, found := strings.Cut(property, ":")
+		name = strings.TrimSpace(name)
+		value = strings.TrimSpace(value)
+		if !found || name == "" {
+			return nil, s.ErrorAt(start, "expected style property, got %q", property)
+		}
+
+		switch name {
+		case "color":
+			style.Color = value
+		case "fill":
+			style.Fill = value
+		case "stroke":
+			style.Stroke = value
+		case "stroke-width":
+			width, err := strconv.Atoi(strings.TrimSuffix(value, "px"))
+			if err != nil {
+				return nil, s.ErrorAt(start, "invalid stroke-width %q", value)
+			}
+			style.StrokeWidth = width
+		case "stroke-dasharray":
+			style.StrokeDash = value
+		default:
+			return nil, s.ErrorAt(start, "unsupported style property %q", name)
+		}
+	}
+
+	return style, nil
+}
+
+// parseStatement reads a node declaration or a chain of links between node groups.
+func (p *flowchartParser) parseStatement(s *parser.Scanner) error {
+	from, err := p.parseNodeGroup(s)
+	if err != nil {
+		return err
+	}
+
+	for !s.EOF() {
+		template, err := parseLink(s)
+		if err != nil {
+			return err
+		}
+		s.SkipSpaces()
+
+		to, err := p.parseNodeGroup(s)
+		if err != nil {
+			return err
+		}
+
+		for _, fromNode := range from {
+			for _, toNode := range to {
+				link := *template
+				link.From = fromNode
+				link.To = toNode
+				p.addLink(&link)
+			}
+		}
+
+		from = to
+	}
+
+	return nil
+}
+
+func (p *flowchartParser) parseNodeGroup(s *parser.Scanner) ([]*Node, error) {
+	var nodes []*Node
+
+	for {
+		node, err := p.parseNode(s)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+
+		s.SkipSpaces()
+		if !s.Consume("&") {
+			return nodes, nil
+		}
+		s.SkipSpaces()
+	}
+}
+
+func (p *flowchartParser) parseNode(s *parser.Scanner) (*Node, error) {
+	id := s.ReadWhile(parser.IsIdentifier)
+	if id == "" {
+		return nil, s.Errorf("expected node identifier")
+	}
+
+	node := p.node(id)
+
+	if s.HasPrefix("@{") {
+		if err := parseNodeData(s, node); err != nil {
+			return nil, err
+		}
+	} else if err := parseNodeShape(s, node); err != nil {
+		return nil, err
+	}
+
+	if s.Consume(":::") {
+		name := s.ReadWhile(func(r rune) bool { return r == '-' || parser.IsIdentifier(r) })
+		if name == "" {
+			return nil, s.Errorf("expected class name")
+		}
+		node.Class = p.class(name)
+	}
+
+	return node, nil
+}
+
+// parseNodeData reads the `@{ shape: rect, label: "text" }` node syntax.
+func parseNodeData(s *parser.Scanner, node *Node) error {
+	start := s.Pos()
+	s.Consume("@{")
+
+	for {
+		s.SkipSpaces()
+		if s.EOF() {
+			return s.ErrorAt(start, "unterminated node data")
+		}
+		if s.Consume("}") {
+			return nil
+		}
+
+		keyPos := s.Pos()
+		key := s.ReadWhile(parser.IsIdentifier)
+		s.SkipSpaces()
+		if key == "" || !s.Consume(":") {
+			return s.ErrorAt(keyPos, "expected node property")
+		}
+		s.SkipSpaces()
+
+		var value string
+		if s.HasPrefix(`"`) {
+			quoted, err := s.ReadQuoted()
+			if err != nil {
+				return err
+			}
+			value = quoted
+		} else {
+			value = strings.TrimSpace(s.ReadWhile(func(r rune) bool { return r != ',' && r != '}' }))
+		}
+
+		switch key {
+		case "shape":
+			node.Shape = nodeShape(value)
+		case "label":
+			node.Text = basediagram.Unescape(value)
+		default:
+			return s.ErrorAt(keyPos, "unsupported node property %q", key)
+		}
+
+		s.SkipSpaces()
+		if !s.Consume(",") && !s.HasPrefix("}") {
+			return s.Errorf("expected ',' or '}'")
+		}
+	}
+}
+
+// parseNodeShape reads the classic bracket syntax such as `[text]` or `{text}`.
+// When several shapes share an opening delimiter, the earliest closing one wins.
+func parseNodeShape(s *parser.Scanner, node *Node) error {
+	rest := s.Rest()
+	best := -1
+	var bestText string
+	var bestLength int
+
+	for i, delimiter := range nodeShapeDelimiters {
+		if best >= 0 && delimiter.open != nodeShapeDelimiters[best].open {
+			break
+		}
+		if !strings.HasPrefix(rest, delimiter.open) {
+			continue
+		}
+
+		text, length, ok := delimitedText(rest[len(delimiter.open):], delimiter.close)
+		if ok && (best < 0 || length < bestLength) {
+			best = i
+			bestText = text
+			bestLength = length
+		}
+	}
+
+	if best < 0 {
+		if rest != "" && strings.ContainsAny(rest[:1], "[({>") {
+			return s.Errorf("unterminated node shape")
+		}
+		return nil
+	}
+
+	s.Advance(len(nodeShapeDelimiters[best].open) + bestLength)
+	node.Shape = nodeShapeDelimiters[best].shape
+	node.Text = basediagram.Unescape(bestText)
+
+	return nil
+}
+
+// delimitedText returns the possibly quoted text found before closing, and
+// the length of the text including the closing delimiter.
+func delimitedText(rest string, closing string) (text string, length int, ok bool) {
+	end := strings.Index(rest, closing)
+	if strings.HasPrefix(rest, `"`) {
+		end = strings.Index(rest[1:], `"`) + 2
+		if end < 2 || !strings.HasPrefix(rest[end:], closing) {
+			return "", 0, false
+		}
+		return rest[1 : end-1], end + len(closing), true
+	}
+
+	if end < 0 {
+		return "", 0, false
+	}
+
+	return rest[:end], end + len(closing), true
+}
+
+// parseLink reads a link such as `-->`, `-.->|text|` or `-- text -->`.
+// The returned link has no From and To nodes.
+func parseLink(s *parser.Scanner) (*Link, error) {
+	start := s.Pos()
+
+	if m := linkOpeningPattern.FindStringSubmatch(s.Rest()); m != nil {
+		rest := s.Rest()[len(m[0])-1:]
+		if loc := linkClosingPattern[m[2]].FindStringSubmatchIndex(rest); loc != nil {
+			body := rest[loc[2]:loc[3]]
+			if m[2] == "-." {
+				body = "-" + body
+			}
+			link := newParsedLink(m[1], body, rest[loc[4]:loc[5]])
+			link.Text = basediagram.Unescape(unquote(strings.TrimSpace(rest[:loc[0]])))
+			s.Advance(len(m[0]) - 1 + loc[5])
+			return link, nil
+		}
+		if m[2] == "-." {
+			return nil, s.ErrorAt(start, "unterminated link text")
+		}
+	}
+
+	m := linkPattern.FindStringSubmatch(s.Rest())
+	if m == nil {
+		return nil, s.Errorf("expected link")
+	}
+	s.Advance(len(m[0]))
+	link := newParsedLink(m[1], m[2], m[3])
+
+	if strings.HasPrefix(strings.TrimLeftFunc(s.Rest(), unicode.IsSpace), "|") {
+		s.SkipSpaces()
+		textPos := s.Pos()
+		s.Consume("|")
+		text, ok := s.ReadUntil("|")
+		if !ok {
+			return nil, s.ErrorAt(textPos, "unterminated link text")
+		}
+		link.Text = basediagram.Unescape(unquote(text))
+	}
+
+	return link, nil
+}
+
+// newParsedLink creates a link from its tail, body and head tokens.
+func newParsedLink(tail string, body string, head string) *Link {
+	link := NewLink(nil, nil)
+	link.Tail = linkArrowType(tail)
+	link.Head = linkArrowType(head)
+	link.Length = len(body) - 2
+
+	switch {
+	case strings.HasPrefix(body, "-."):
+		link.Shape = LinkShapeDotted
+		link.Length = len(body) - 3
+	case strings.HasPrefix(body, "=="):
+		link.Shape = LinkShapeThick
+	case strings.HasPrefix(body, "~~"):
+		link.Shape = LinkShapeInvisible
+	default:
+		link.Shape = LinkShapeOpen
+	}
+
+	return link
+}
+
+func (p *flowchartParser) addLink(link *Link) {
+	if len(p.subgraphs) == 0 {
+		p.flowchart.links = append(p.flowchart.links, link)
+		return
+	}
+
+	subgraph := p.subgraphs[len(p.subgraphs)-1].subgraph
+	subgraph.links = append(subgraph.links, link)
+}
+
+// node returns the node with the given ID, adding it to the flowchart on first use.
+func (p *flowchartParser) node(id string) *Node {
+	if node, ok := p.nodes[id]; ok {
+		return node
+	}
+
+	node := NewNode(id, id)
+	p.nodes[id] = node
+	p.flowchart.nodes = append(p.flowchart.nodes, node)
+	p.reserveID(id)
+
+	return node
+}
+
+// class returns the class with the given name, adding it to the flowchart on first use.
+// Classes referenced without a classDef have no style and are not rendered.
+func (p *flowchartParser) class(name string) *Class {
+	if class, ok := p.classes[name]; ok {
+		return class
+	}
+
+	class := &Class{Name: name}
+	p.classes[name] = class
+	p.flowchart.classes = append(p.flowchart.classes, class)
+
+	return class
+}
+
+func (p *flowchartParser) reserveID(id string) {
+	if n, err := strconv.Atoi(id); err == nil && n >= p.nextID {
+		p.nextID = n + 1
+	}
+}
+
+func unquote(text string) string {
+	if len(text) >= 2 && strings.HasPrefix(text, `"`) && strings.HasSuffix(text, `"`) {
+		return text[1 : len(text)-1]
+	}
+	return text
+}
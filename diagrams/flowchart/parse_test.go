@@ -0,0 +1,358 @@
+package flowchart
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Flowchart)
+	}{
+		{
+			name:  "Empty flowchart",
+			setup: func(f *Flowchart) {},
+		},
+		{
+			name: "Flowchart with title, direction and markdown fence",
+			setup: func(f *Flowchart) {
+				f.SetTitle("My Flowchart")
+				f.SetDirection(FlowchartDirectionLeftRight)
+				f.EnableMarkdownFence()
+				f.AddLink(f.AddNode("Start"), f.AddNode("End"))
+			},
+		},
+		{
+			name: "Flowchart with every link shape, head and length",
+			setup: func(f *Flowchart) {
+				a := f.AddNode("A")
+				b := f.AddNode("B")
+				for _, shape := range []linkShape{LinkShapeOpen, LinkShapeDotted, LinkShapeThick, LinkShapeInvisible} {
+					for _, head := range []linkArrowType{LinkArrowTypeNone, LinkArrowTypeArrow, LinkArrowTypeBullet, LinkArrowTypeCross} {
+						for length := 0; length < 3; length++ {
+							f.AddLink(a, b).SetShape(shape).SetHead(head).SetLength(length)
+						}
+					}
+				}
+				f.AddLink(a, b).SetTail(LinkArrowTypeLeftArrow).SetText("both ways")
+				f.AddLink(a, b).SetTail(LinkArrowTypeBullet).SetHead(LinkArrowTypeBullet)
+				f.AddLink(a, b).SetTail(LinkArrowTypeCross).SetHead(LinkArrowTypeCross).SetText("cross")
+			},
+		},
+		{
+			name: "Flowchart with text that needs escaping",
+			setup: func(f *Flowchart) {
+				f.SetTitle("Release #2: final")
+				a := f.AddNode(`Say "hi"`)
+				b := f.AddNode("Issue #1; done")
+				f.AddLink(a, b).SetText("a|b")
+				f.AddSubgraph(`The "group"`).AddLink(b, a).SetText("#quot;")
+			},
+		},
+		{
+			name: "Flowchart with node shapes, classes and styles",
+			setup: func(f *Flowchart) {
+				class := f.AddClass("important")
+				class.Style.Fill = "#f96"
+				class.Style.Stroke = "#333"
+
+				f.AddNode("Database").SetShape(NodeShapeDatabase).SetClass(class)
+				f.AddNode("Decision?").SetShape(NodeShapeDecision)
+				style := NewNodeStyle()
+				style.Color = "red"
+				style.StrokeWidth = 4
+				style.StrokeDash = "5 5"
+				f.AddNode("Styled").SetShape(NodeShapeCard).SetStyle(style)
+			},
+		},
+		{
+			name: "Flowchart with nested subgraphs",
+			setup: func(f *Flowchart) {
+				a := f.AddNode("A")
+				b := f.AddNode("B")
+				outer := f.AddSubgraph("Outer")
+				outer.Direction = SubgraphDirectionLeftRight
+				outer.AddLink(a, b).SetText("inside")
+				inner := outer.AddSubgraph("Inner")
+				inner.AddLink(b, a).SetShape(LinkShapeThick)
+				f.AddLink(a, b)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewFlowchart()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_ClassicSyntax(t *testing.T) {
+	input := `graph LR
+    %% a comment
+    A[Rectangle] --> B(Rounded)
+    B --> C([Stadium]) & D[[Subroutine]];
+    C -- some text --> E[(Database)]
+    D -. dotted text .-> F((Circle))
+    E == thick text ==> G>Odd]
+    F --- H{Rhombus} --o I{{Hexagon}}
+    I <--> J[/Lean right/] --x K[\Lean left\]
+    L[/Trapezoid\] ~~~ M[\Trapezoid alt/] -->|"quoted"| N(((Double)))
+    O["Quoted [text]"]
+    class A,B highlight
+    classDef highlight fill:#ff0,stroke-width:2px
+    style O fill:#0f0
+`
+
+	f, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if f.Direction != FlowchartDirectionLeftRight {
+		t.Errorf("Parse() direction = %v, want %v", f.Direction, FlowchartDirectionLeftRight)
+	}
+
+	wantNodes := []struct {
+		id    string
+		text  string
+		shape nodeShape
+	}{
+		{"A", "Rectangle", NodeShapeProcess},
+		{"B", "Rounded", NodeShapeEvent},
+		{"C", "Stadium", NodeShapeTerminal},
+		{"D", "Subroutine", NodeShapeSubprocess},
+		{"E", "Database", NodeShapeDatabase},
+		{"F", "Circle", NodeShapeStart},
+		{"G", "Odd", NodeShapeOdd},
+		{"H", "Rhombus", NodeShapeDecision},
+		{"I", "Hexagon", NodeShapePrepare},
+		{"J", "Lean right", NodeShapeInputOutput},
+		{"K", "Lean left", NodeShapeOutputInput},
+		{"L", "Trapezoid", NodeShapeManualOperation},
+		{"M", "Trapezoid alt", NodeShapeManual},
+		{"N", "Double", NodeShapeStopDouble},
+		{"O", "Quoted [text]", NodeShapeProcess},
+	}
+
+	if len(f.nodes) != len(wantNodes) {
+		t.Fatalf("Parse() got %d nodes, want %d", len(f.nodes), len(wantNodes))
+	}
+
+	for i, want := range wantNodes {
+		node := f.nodes[i]
+		if node.ID != want.id || node.Text != want.text || node.Shape != want.shape {
+			t.Errorf("Parse() node %d = {%s %q %s}, want {%s %q %s}", i, node.ID, node.Text, node.Shape, want.id, want.text, want.shape)
+		}
+	}
+
+	wantLinks := []string{
+		"A --> B",
+		"B --> C",
+		"B --> D",
+		"C -->|some text| E",
+		"D -.->|dotted text| F",
+		"E ==>|thick text| G",
+		"F --- H",
+		"H --o I",
+		"I <--> J",
+		"J --x K",
+		"L ~~~ M",
+		"M -->|quoted| N",
+	}
+
+	if len(f.links) != len(wantLinks) {
+		t.Fatalf("Parse() got %d links, want %d", len(f.links), len(wantLinks))
+	}
+
+	for i, want := range wantLinks {
+		if got := strings.TrimSpace(f.links[i].String()); got != want {
+			t.Errorf("Parse() link %d = %q, want %q", i, got, want)
+		}
+	}
+
+	if f.links[10].Length != 1 {
+		t.Errorf("Parse() invisible link length = %d, want 1", f.links[10].Length)
+	}
+
+	if f.nodes[0].Class == nil || f.nodes[0].Class.Name != "highlight" || f.nodes[0].Class.Style.Fill != "#ff0" || f.nodes[0].Class.Style.StrokeWidth != 2 {
+		t.Errorf("Parse() node A class = %+v, want highlight class", f.nodes[0].Class)
+	}
+
+	if f.nodes[14].Style == nil || f.nodes[14].Style.Fill != "#0f0" {
+		t.Errorf("Parse() node O style = %+v, want fill #0f0", f.nodes[14].Style)
+	}
+}
+
+func TestParse_Statements(t *testing.T) {
+	input := `flowchart LR
+    A-->B;B-->C
+    D["x;y"];E[a;b] -->|c;d| F ; ;
+    classDef warning fill:#f9f,stroke:#333;class A warning
+`
+
+	f, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	var nodes []string
+	for _, node := range f.nodes {
+		nodes = append(nodes, node.ID+"="+node.Text)
+	}
+	if want := []string{"A=A", "B=B", "C=C", "D=x;y", "E=a;b", "F=F"}; !reflect.DeepEqual(nodes, want) {
+		t.Errorf("Parse() nodes = %v, want %v", nodes, want)
+	}
+
+	var links []string
+	for _, link := range f.links {
+		links = append(links, strings.TrimSpace(link.String()))
+	}
+	if want := []string{"A --> B", "B --> C", "E -->|c#59;d| F"}; !reflect.DeepEqual(links, want) {
+		t.Errorf("Parse() links = %v, want %v", links, want)
+	}
+
+	if f.nodes[0].Class == nil || f.nodes[0].Class.Style.Stroke != "#333" {
+		t.Errorf("Parse() node A class = %+v, want warning class with stroke #333", f.nodes[0].Class)
+	}
+}
+
+func TestParse_IDGenerator(t *testing.T) {
+	f, err := Parse(strings.NewReader("flowchart TB\n    0 --> 1\n    subgraph 4 [Group]\n    end\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if node := f.AddNode("New"); node.ID != "5" {
+		t.Errorf("AddNode() after Parse() ID = %v, want %v", node.ID, "5")
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "A --> B\n",
+			line:    1,
+			column:  1,
+			message: "expected flowchart declaration",
+		},
+		{
+			name:    "Unknown direction",
+			input:   "flowchart XY\n",
+			line:    1,
+			column:  11,
+			message: `unknown direction "XY"`,
+		},
+		{
+			name:    "Invalid link",
+			input:   "flowchart TB\n    A -> B\n",
+			line:    2,
+			column:  7,
+			message: "expected link",
+		},
+		{
+			name:    "Invalid link after a semicolon",
+			input:   "flowchart TB\n    A --> B; C -> D\n",
+			line:    2,
+			column:  16,
+			message: "expected link",
+		},
+		{
+			name:    "Missing link target",
+			input:   "flowchart TB\n    A -->\n",
+			line:    2,
+			column:  10,
+			message: "expected node identifier",
+		},
+		{
+			name:    "Unterminated node shape",
+			input:   "flowchart TB\n    A[Start\n",
+			line:    2,
+			column:  6,
+			message: "unterminated node shape",
+		},
+		{
+			name:    "Unsupported node property",
+			input:   "flowchart TB\n    A@{ shape: rect, icon: \"fa:user\" }\n",
+			line:    2,
+			column:  22,
+			message: `unsupported node property "icon"`,
+		},
+		{
+			name:    "Unterminated link text",
+			input:   "flowchart TB\n    A -->|text B\n",
+			line:    2,
+			column:  10,
+			message: "unterminated link text",
+		},
+		{
+			name:    "Unsupported style property",
+			input:   "flowchart TB\n    style A fill:#fff,font-size:12px\n",
+			line:    2,
+			column:  23,
+			message: `unsupported style property "font-size"`,
+		},
+		{
+			name:    "End without subgraph",
+			input:   "flowchart TB\n    end\n",
+			line:    2,
+			column:  5,
+			message: "end without subgraph",
+		},
+		{
+			name:    "Unterminated subgraph",
+			input:   "flowchart TB\n  subgraph one\n  A --> B\n",
+			line:    2,
+			column:  3,
+			message: `subgraph "one" is missing its end`,
+		},
+		{
+			name:    "Unsupported statement",
+			input:   "flowchart TB\n    click A callback\n",
+			line:    2,
+			column:  5,
+			message: `unsupported statement "click"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
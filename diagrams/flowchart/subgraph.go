@@ -2,6 +2,7 @@ package flowchart
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/TyphonHill/go-mermaid/diagrams/utils"
@@ -76,26 +77,35 @@ func (s *Subgraph) AddLink(from *Node, to *Node) (newLink *Link) {
 // including its subgraphs, direction, and links with the specified indentation.
 func (s *Subgraph) String(curIndentation string) string {
 	var sb strings.Builder
+	s.writeTo(basediagram.NewWriter(&sb), curIndentation)
+	return sb.String()
+}
+
+// WriteTo writes the Mermaid representation of the Subgraph to w,
+// indented as a top-level subgraph of a flowchart.
+func (s *Subgraph) WriteTo(w io.Writer) (int64, error) {
+	cw := basediagram.NewWriter(w)
+	s.writeTo(cw, "%s")
+	return cw.Result()
+}
 
-	sb.WriteString(fmt.Sprintf(string(curIndentation), fmt.Sprintf(string(baseSubgraphString), s.ID, s.Title)))
+// writeTo writes the Subgraph to w with the specified indentation,
+// streaming nested subgraphs and links one by one.
+func (s *Subgraph) writeTo(w *basediagram.Writer, curIndentation string) {
+	w.Printf(string(curIndentation), fmt.Sprintf(string(baseSubgraphString), s.ID, basediagram.Quote(s.Title)))
 
-	direction := ""
 	if s.Direction != SubgraphDirectionNone {
-		direction = fmt.Sprintf(string(curIndentation), fmt.Sprintf(string(baseSubgraphDirectionString), string(s.Direction)))
+		w.Printf(string(curIndentation), fmt.Sprintf(string(baseSubgraphDirectionString), string(s.Direction)))
 	}
 
-	sb.WriteString(direction)
-
 	for _, subgraph := range s.subgraphs {
 		nextIndentation := fmt.Sprintf(string(baseSubgraphSubgraphString), string(curIndentation))
-		sb.WriteString(subgraph.String(nextIndentation))
+		subgraph.writeTo(w, nextIndentation)
 	}
 
 	for _, link := range s.links {
-		sb.WriteString(fmt.Sprintf(string(curIndentation), fmt.Sprintf(string(baseSubgraphLinkString), link.String())))
+		w.Printf(string(curIndentation), fmt.Sprintf(string(baseSubgraphLinkString), link.String()))
 	}
 
-	sb.WriteString(fmt.Sprintf(string(curIndentation), baseSubgraphEndString))
-
-	return sb.String()
+	w.Printf(string(curIndentation), baseSubgraphEndString)
 }
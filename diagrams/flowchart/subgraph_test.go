@@ -125,7 +125,7 @@ func TestSubgraph_String(t *testing.T) {
 			subgraph:    NewSubgraph("1", "Test"),
 			indentation: "%s",
 			contains: []string{
-				"subgraph 1 [Test]",
+				`subgraph 1 ["Test"]`,
 				"end",
 			},
 		},
@@ -137,7 +137,7 @@ func TestSubgraph_String(t *testing.T) {
 			},
 			indentation: "%s",
 			contains: []string{
-				"subgraph 1 [Test]",
+				`subgraph 1 ["Test"]`,
 				"direction LR",
 				"end",
 			},
@@ -154,8 +154,8 @@ func TestSubgraph_String(t *testing.T) {
 			},
 			indentation: "%s",
 			contains: []string{
-				"subgraph 1 [Parent]",
-				"subgraph 0 [Child]",
+				`subgraph 1 ["Parent"]`,
+				`subgraph 0 ["Child"]`,
 				"end",
 			},
 		},
@@ -169,7 +169,7 @@ func TestSubgraph_String(t *testing.T) {
 			},
 			indentation: "\t",
 			contains: []string{
-				"subgraph 1 [Test]",
+				`subgraph 1 ["Test"]`,
 				"1 --> 2",
 				"end",
 			},
@@ -193,3 +193,25 @@ func TestSubgraph_String(t *testing.T) {
 		})
 	}
 }
+
+func TestSubgraph_WriteTo(t *testing.T) {
+	subgraph := NewSubgraph("1", "Parent")
+	subgraph.Direction = SubgraphDirectionLeftRight
+	child := subgraph.AddSubgraph("Child")
+	child.AddLink(NewNode("1", "Start"), NewNode("2", "End"))
+	subgraph.AddLink(NewNode("3", "Other"), NewNode("4", "Node"))
+
+	var sb strings.Builder
+	n, err := subgraph.WriteTo(&sb)
+	if err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+
+	want := subgraph.String("%s")
+	if sb.String() != want {
+		t.Errorf("WriteTo() = %q, want %q", sb.String(), want)
+	}
+	if n != int64(len(want)) {
+		t.Errorf("WriteTo() wrote %d bytes, want %d", n, len(want))
+	}
+}
@@ -0,0 +1,431 @@
+package flowchart
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Colors of the Mermaid default theme.
+const (
+	defaultNodeFill        = "#ECECFF"
+	defaultNodeStroke      = "#9370DB"
+	defaultTextColor       = "#333333"
+	defaultLineColor       = "#333333"
+	defaultClusterFill     = "#ffffde"
+	defaultClusterStroke   = "#aaaa33"
+	defaultLabelBackground = "#e8e8e8"
+)
+
+// Stroke widths of the links.
+const (
+	linkStrokeWidth      = 2
+	thickLinkStrokeWidth = 3.5
+	dottedLinkDash       = "3"
+)
+
+// Base strings of the SVG elements.
+const (
+	baseSVGHeader     string = `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s" font-family="'trebuchet ms', verdana, arial, sans-serif" font-size="%s">` + "\n"
+	baseSVGFooter     string = "</svg>\n"
+	baseSVGTitle      string = "<title>%s</title>\n"
+	baseSVGGroupStart string = `<g class="%s">` + "\n"
+	baseSVGGroupEnd   string = "</g>\n"
+	baseSVGRect       string = `<rect x="%s" y="%s" width="%s" height="%s" fill="%s" stroke="%s"%s/>` + "\n"
+	baseSVGPath       string = `<path d="%s" fill="%s" stroke="%s"%s/>` + "\n"
+	baseSVGTextStart  string = `<text text-anchor="middle" dominant-baseline="central" fill="%s">`
+	baseSVGTextLine   string = `<tspan x="%s" y="%s">%s</tspan>`
+	baseSVGTextEnd    string = "</text>\n"
+)
+
+// svgMarkers defines the arrowheads of the links, one marker for each end.
+const svgMarkers = `<defs>
+<marker id="flowchart-arrow-end" viewBox="0 0 10 10" refX="9" refY="5" markerUnits="userSpaceOnUse" markerWidth="12" markerHeight="12" orient="auto"><path d="M 0 0 L 10 5 L 0 10 Z" fill="` + defaultLineColor + `"/></marker>
+<marker id="flowchart-arrow-start" viewBox="0 0 10 10" refX="1" refY="5" markerUnits="userSpaceOnUse" markerWidth="12" markerHeight="12" orient="auto"><path d="M 10 0 L 0 5 L 10 10 Z" fill="` + defaultLineColor + `"/></marker>
+<marker id="flowchart-circle-end" viewBox="0 0 10 10" refX="9" refY="5" markerUnits="userSpaceOnUse" markerWidth="11" markerHeight="11" orient="auto"><circle cx="5" cy="5" r="4" fill="` + defaultLineColor + `"/></marker>
+<marker id="flowchart-circle-start" viewBox="0 0 10 10" refX="1" refY="5" markerUnits="userSpaceOnUse" markerWidth="11" markerHeight="11" orient="auto"><circle cx="5" cy="5" r="4" fill="` + defaultLineColor + `"/></marker>
+<marker id="flowchart-cross-end" viewBox="0 0 10 10" refX="9" refY="5" markerUnits="userSpaceOnUse" markerWidth="11" markerHeight="11" orient="auto"><path d="M 1 1 L 9 9 M 1 9 L 9 1" stroke="` + defaultLineColor + `" stroke-width="2"/></marker>
+<marker id="flowchart-cross-start" viewBox="0 0 10 10" refX="1" refY="5" markerUnits="userSpaceOnUse" markerWidth="11" markerHeight="11" orient="auto"><path d="M 1 1 L 9 9 M 1 9 L 9 1" stroke="` + defaultLineColor + `" stroke-width="2"/></marker>
+</defs>
+`
+
+var xmlEscaper = strings.NewReplacer(`&`, "&amp;", `<`, "&lt;", `>`, "&gt;", `"`, "&quot;", `'`, "&apos;")
+
+// RenderSVG draws the flowchart as a standalone SVG image and writes it to w,
+// without going through Mermaid. The nodes are placed by a layered layout
+// following the flowchart Direction, and subgraphs are drawn as boxes around
+// their nodes. Common node shapes are drawn as such, the others as rectangles.
+// Links are drawn as smooth curves unless CurveStyle is CurveStyleLinear.
+// Text is measured with an estimated character width, as no font is available.
+// It returns the number of bytes written and the first write error.
+func (f *Flowchart) RenderSVG(w io.Writer) (int64, error) {
+	l := f.layout()
+	m := f.metrics()
+	cw := basediagram.NewWriter(w)
+
+	cw.Printf(baseSVGHeader, formatNumber(l.width), formatNumber(l.height), formatNumber(l.width), formatNumber(l.height), formatNumber(m.fontSize))
+	if f.Title != "" {
+		cw.Printf(baseSVGTitle, xmlEscaper.Replace(f.Title))
+	}
+	cw.WriteString(svgMarkers)
+
+	if l.title != nil {
+		writeSVGText(cw, l.titleAt, l.title, m.lineHeight, defaultTextColor)
+	}
+
+	cw.Printf(baseSVGGroupStart, "clusters")
+	for _, s := range l.subgraphs {
+		cw.Printf(baseSVGRect, formatNumber(s.x), formatNumber(s.y), formatNumber(s.width), formatNumber(s.height), defaultClusterFill, defaultClusterStroke, "")
+		titleAt := point{s.x + s.width/2, s.y + (m.padding+float64(len(s.lines))*m.lineHeight)/2}
+		writeSVGText(cw, titleAt, s.lines, m.lineHeight, defaultTextColor)
+	}
+	cw.WriteString(baseSVGGroupEnd)
+
+	cw.Printf(baseSVGGroupStart, "links")
+	for _, link := range l.links {
+		if link.link.Shape == LinkShapeInvisible {
+			continue
+		}
+		cw.Printf(baseSVGPath, linkPath(link.points, f.CurveStyle == CurveStyleLinear), "none", defaultLineColor, linkAttributes(link.link))
+	}
+	cw.WriteString(baseSVGGroupEnd)
+
+	cw.Printf(baseSVGGroupStart, "link-labels")
+	for _, link := range l.links {
+		if link.link.Shape == LinkShapeInvisible || link.lines == nil {
+			continue
+		}
+		x, y := link.labelAt.x-link.labelWidth/2, link.labelAt.y-link.labelHeight/2
+		cw.Printf(baseSVGRect, formatNumber(x), formatNumber(y), formatNumber(link.labelWidth), formatNumber(link.labelHeight), defaultLabelBackground, "none", "")
+		writeSVGText(cw, link.labelAt, link.lines, m.lineHeight, defaultTextColor)
+	}
+	cw.WriteString(baseSVGGroupEnd)
+
+	cw.Printf(baseSVGGroupStart, "nodes")
+	for _, n := range l.nodes {
+		style := resolveStyle(n.node)
+		cw.Printf(baseSVGPath, shapePath(n), xmlEscaper.Replace(style.Fill), xmlEscaper.Replace(style.Stroke), styleAttributes(style))
+		if !hidesText(n.node.Shape) {
+			writeSVGText(cw, point{n.x, n.y}, n.lines, m.lineHeight, style.Color)
+		}
+	}
+	cw.WriteString(baseSVGGroupEnd)
+
+	cw.WriteString(baseSVGFooter)
+
+	return cw.Result()
+}
+
+// writeSVGText writes the lines of text centered on p.
+func writeSVGText(w *basediagram.Writer, p point, lines []string, lineHeight float64, color string) {
+	if len(lines) == 0 {
+		return
+	}
+
+	w.Printf(baseSVGTextStart, xmlEscaper.Replace(color))
+	y := p.y - float64(len(lines)-1)*lineHeight/2
+	for i, line := range lines {
+		w.Printf(baseSVGTextLine, formatNumber(p.x), formatNumber(y+float64(i)*lineHeight), xmlEscaper.Replace(line))
+	}
+	w.WriteString(baseSVGTextEnd)
+}
+
+// resolveStyle returns the style a node is drawn with: the theme defaults,
+// overridden by the style of its class, overridden by its own style.
+func resolveStyle(node *Node) NodeStyle {
+	style := NodeStyle{
+		Color:       defaultTextColor,
+		Fill:        defaultNodeFill,
+		Stroke:      defaultNodeStroke,
+		StrokeWidth: 1,
+	}
+
+	switch node.Shape {
+	case NodeShapeText:
+		style.Fill, style.Stroke = "none", "none"
+	case NodeShapeJunction, NodeShapeForkJoin:
+		style.Fill, style.Stroke = defaultLineColor, defaultLineColor
+	}
+
+	var styles []*NodeStyle
+	if node.Class != nil {
+		styles = append(styles, node.Class.Style)
+	}
+	styles = append(styles, node.Style)
+
+	for _, s := range styles {
+		if s == nil {
+			continue
+		}
+		if s.Color != "" {
+			style.Color = s.Color
+		}
+		if s.Fill != "" {
+			style.Fill = s.Fill
+		}
+		if s.Stroke != "" {
+			style.Stroke = s.Stroke
+		}
+		if s.StrokeWidth > 0 {
+			style.StrokeWidth = s.StrokeWidth
+		}
+		if s.StrokeDash != "" && s.StrokeDash != "0" {
+			style.StrokeDash = s.StrokeDash
+		}
+	}
+
+	return style
+}
+
+// styleAttributes returns the stroke attributes of a node style.
+func styleAttributes(style NodeStyle) string {
+	attributes := fmt.Sprintf(` stroke-width="%d"`, style.StrokeWidth)
+	if style.StrokeDash != "" {
+		attributes += fmt.Sprintf(` stroke-dasharray="%s"`, xmlEscaper.Replace(style.StrokeDash))
+	}
+	return attributes
+}
+
+// linkAttributes returns the stroke and marker attributes of a link.
+func linkAttributes(link *Link) string {
+	var sb strings.Builder
+
+	switch link.Shape {
+	case LinkShapeThick:
+		fmt.Fprintf(&sb, ` stroke-width="%s"`, formatNumber(thickLinkStrokeWidth))
+	case LinkShapeDotted:
+		fmt.Fprintf(&sb, ` stroke-width="%s" stroke-dasharray="%s"`, formatNumber(linkStrokeWidth), dottedLinkDash)
+	default:
+		fmt.Fprintf(&sb, ` stroke-width="%s"`, formatNumber(linkStrokeWidth))
+	}
+
+	if marker := markerName(link.Tail); marker != "" {
+		fmt.Fprintf(&sb, ` marker-start="url(#flowchart-%s-start)"`, marker)
+	}
+	if marker := markerName(link.Head); marker != "" {
+		fmt.Fprintf(&sb, ` marker-end="url(#flowchart-%s-end)"`, marker)
+	}
+
+	return sb.String()
+}
+
+// markerName returns the name of the marker drawing an arrow type, or "" when
+// the link end has no arrowhead.
+func markerName(arrow linkArrowType) string {
+	switch arrow {
+	case LinkArrowTypeArrow, LinkArrowTypeLeftArrow:
+		return "arrow"
+	case LinkArrowTypeBullet:
+		return "circle"
+	case LinkArrowTypeCross:
+		return "cross"
+	}
+	return ""
+}
+
+// linkPath returns the path data of a link going through the points, either
+// as straight segments or rounded at the bends.
+func linkPath(points []point, linear bool) string {
+	var p pathData
+	p.moveTo(points[0].x, points[0].y)
+
+	if linear || len(points) < 3 {
+		for _, pt := range points[1:] {
+			p.lineTo(pt.x, pt.y)
+		}
+		return p.String()
+	}
+
+	for i := 1; i < len(points)-1; i++ {
+		next := points[i+1]
+		if i < len(points)-2 {
+			next = point{(points[i].x + points[i+1].x) / 2, (points[i].y + points[i+1].y) / 2}
+		}
+		p.quadTo(points[i].x, points[i].y, next.x, next.y)
+	}
+
+	return p.String()
+}
+
+// shapePath returns the path data drawing the outline of a node.
+func shapePath(n *nodeLayout) string {
+	var p pathData
+	x, y, w, h := n.x, n.y, n.width, n.height
+	left, right, top, bottom := x-w/2, x+w/2, y-h/2, y+h/2
+
+	switch n.node.Shape {
+	case NodeShapeText:
+		p.rect(left, top, right, bottom)
+	case NodeShapeEvent:
+		p.roundedRect(left, top, right, bottom, 5)
+	case NodeShapeTerminal:
+		p.roundedRect(left, top, right, bottom, h/2)
+	case NodeShapeSubprocess:
+		p.rect(left, top, right, bottom)
+		p.line(left+8, top, left+8, bottom)
+		p.line(right-8, top, right-8, bottom)
+	case NodeShapeLinedProcess:
+		p.rect(left, top, right, bottom)
+		p.line(left+8, top, left+8, bottom)
+	case NodeShapeDividedProcess:
+		p.rect(left, top, right, bottom)
+		p.line(left, top+h/4, right, top+h/4)
+	case NodeShapeInternalStorage:
+		p.rect(left, top, right, bottom)
+		p.line(left+10, top, left+10, bottom)
+		p.line(left, top+10, right, top+10)
+	case NodeShapeCard:
+		p.polygon(point{left + 12, top}, point{right, top}, point{right, bottom}, point{left, bottom}, point{left, top + 12})
+	case NodeShapeDatabase:
+		r := cylinderRadius(w)
+		p.moveTo(left, top+r)
+		p.arcTo(w/2, r, false, true, right, top+r)
+		p.lineTo(right, bottom-r)
+		p.arcTo(w/2, r, false, true, left, bottom-r)
+		p.close()
+		p.moveTo(left, top+r)
+		p.arcTo(w/2, r, false, false, right, top+r)
+	case NodeShapeStart, NodeShapeStartSmall, NodeShapeJunction:
+		p.circle(x, y, math.Min(w, h)/2)
+	case NodeShapeStopDouble, NodeShapeStopFramed:
+		p.circle(x, y, math.Min(w, h)/2)
+		p.circle(x, y, math.Min(w, h)/2-5)
+	case NodeShapeSummary:
+		r := math.Min(w, h) / 2
+		p.circle(x, y, r)
+		d := r * math.Sqrt2 / 2
+		p.line(x-d, y-d, x+d, y+d)
+		p.line(x-d, y+d, x+d, y-d)
+	case NodeShapeOdd:
+		p.polygon(point{left, top}, point{right, top}, point{right, bottom}, point{left, bottom}, point{left + h/4, y})
+	case NodeShapeDecision:
+		p.polygon(point{x, top}, point{right, y}, point{x, bottom}, point{left, y})
+	case NodeShapePrepare:
+		p.polygon(point{left + h/4, top}, point{right - h/4, top}, point{right, y}, point{right - h/4, bottom}, point{left + h/4, bottom}, point{left, y})
+	case NodeShapeInputOutput:
+		p.polygon(point{left + h/2, top}, point{right, top}, point{right - h/2, bottom}, point{left, bottom})
+	case NodeShapeOutputInput:
+		p.polygon(point{left, top}, point{right - h/2, top}, point{right, bottom}, point{left + h/2, bottom})
+	case NodeShapeManualOperation:
+		p.polygon(point{left + h/2, top}, point{right - h/2, top}, point{right, bottom}, point{left, bottom})
+	case NodeShapeManual:
+		p.polygon(point{left, top}, point{right, top}, point{right - h/2, bottom}, point{left + h/2, bottom})
+	case NodeShapeExtract:
+		p.polygon(point{x, top}, point{right, bottom}, point{left, bottom})
+	case NodeShapeManualFile:
+		p.polygon(point{left, top}, point{right, top}, point{x, bottom})
+	case NodeShapeDocument:
+		wave := h / 10
+		p.moveTo(left, top)
+		p.lineTo(right, top)
+		p.lineTo(right, bottom-wave)
+		p.cubicTo(x+w/4, bottom-3*wave, x-w/4, bottom+wave, left, bottom-wave)
+		p.close()
+	case NodeShapeDelay:
+		p.moveTo(left, top)
+		p.lineTo(right-h/2, top)
+		p.arcTo(h/2, h/2, false, true, right-h/2, bottom)
+		p.lineTo(left, bottom)
+		p.close()
+	default:
+		p.rect(left, top, right, bottom)
+	}
+
+	return p.String()
+}
+
+// pathData builds the d attribute of an SVG path.
+type pathData struct {
+	strings.Builder
+}
+
+func (p *pathData) command(name string, values ...float64) {
+	if p.Len() > 0 {
+		p.WriteString(" ")
+	}
+	p.WriteString(name)
+	for _, v := range values {
+		p.WriteString(" ")
+		p.WriteString(formatNumber(v))
+	}
+}
+
+func (p *pathData) moveTo(x, y float64) {
+	p.command("M", x, y)
+}
+
+func (p *pathData) lineTo(x, y float64) {
+	p.command("L", x, y)
+}
+
+func (p *pathData) quadTo(cx, cy, x, y float64) {
+	p.command("Q", cx, cy, x, y)
+}
+
+func (p *pathData) cubicTo(c1x, c1y, c2x, c2y, x, y float64) {
+	p.command("C", c1x, c1y, c2x, c2y, x, y)
+}
+
+func (p *pathData) arcTo(rx, ry float64, large, sweep bool, x, y float64) {
+	flag := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	p.command("A", rx, ry, 0, flag(large), flag(sweep), x, y)
+}
+
+func (p *pathData) close() {
+	p.command("Z")
+}
+
+func (p *pathData) line(x1, y1, x2, y2 float64) {
+	p.moveTo(x1, y1)
+	p.lineTo(x2, y2)
+}
+
+func (p *pathData) polygon(points ...point) {
+	p.moveTo(points[0].x, points[0].y)
+	for _, pt := range points[1:] {
+		p.lineTo(pt.x, pt.y)
+	}
+	p.close()
+}
+
+func (p *pathData) rect(left, top, right, bottom float64) {
+	p.polygon(point{left, top}, point{right, top}, point{right, bottom}, point{left, bottom})
+}
+
+func (p *pathData) roundedRect(left, top, right, bottom, r float64) {
+	p.moveTo(left+r, top)
+	p.lineTo(right-r, top)
+	p.arcTo(r, r, false, true, right, top+r)
+	p.lineTo(right, bottom-r)
+	p.arcTo(r, r, false, true, right-r, bottom)
+	p.lineTo(left+r, bottom)
+	p.arcTo(r, r, false, true, left, bottom-r)
+	p.lineTo(left, top+r)
+	p.arcTo(r, r, false, true, left+r, top)
+	p.close()
+}
+
+func (p *pathData) circle(x, y, r float64) {
+	p.moveTo(x-r, y)
+	p.arcTo(r, r, true, false, x+r, y)
+	p.arcTo(r, r, true, false, x-r, y)
+	p.close()
+}
+
+// formatNumber formats a coordinate with at most two decimals.
+func formatNumber(v float64) string {
+	v = math.Round(v*100) / 100
+	if v == 0 {
+		v = 0
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
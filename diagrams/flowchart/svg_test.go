@@ -0,0 +1,315 @@
+package flowchart
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// failingWriter fails every write.
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestFlowchart_RenderSVG(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(*Flowchart)
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:  "Empty flowchart",
+			setup: func(f *Flowchart) {},
+			contains: []string{
+				`<svg xmlns="http://www.w3.org/2000/svg" width="16" height="16" viewBox="0 0 16 16"`,
+				`<marker id="flowchart-arrow-end"`,
+				`<g class="nodes">`,
+				"</svg>\n",
+			},
+			notContains: []string{
+				"<title>",
+			},
+		},
+		{
+			name: "Title and escaped text",
+			setup: func(f *Flowchart) {
+				f.SetTitle("A & B")
+				f.AddNode(`x < "y"`)
+			},
+			contains: []string{
+				"<title>A &amp; B</title>",
+				`<tspan x="`,
+				">A &amp; B</tspan>",
+				">x &lt; &quot;y&quot;</tspan>",
+			},
+		},
+		{
+			name: "Node shapes and styles",
+			setup: func(f *Flowchart) {
+				class := f.AddClass("warning")
+				class.Style.Fill = "#fdd"
+				class.Style.Stroke = "#f00"
+				f.AddNode("Classed").SetClass(class)
+				style := NewNodeStyle()
+				style.Fill = "#dfd"
+				style.Color = "#060"
+				style.StrokeWidth = 3
+				style.StrokeDash = "5 5"
+				f.AddNode("Styled").SetClass(class).SetStyle(style)
+				f.AddNode("Junction").SetShape(NodeShapeJunction)
+			},
+			contains: []string{
+				`fill="#fdd" stroke="#f00" stroke-width="1"/>`,
+				`fill="#dfd" stroke="#f00" stroke-width="3" stroke-dasharray="5 5"/>`,
+				`<text text-anchor="middle" dominant-baseline="central" fill="#060">`,
+				`A 7 7 0 1 0`,
+				`fill="#333333" stroke="#333333" stroke-width="1"/>`,
+			},
+			notContains: []string{
+				">Junction</tspan>",
+			},
+		},
+		{
+			name: "Links",
+			setup: func(f *Flowchart) {
+				a := f.AddNode("A")
+				b := f.AddNode("B")
+				f.AddLink(a, b).SetShape(LinkShapeThick).SetText("thick")
+				f.AddLink(a, b).SetShape(LinkShapeDotted).SetHead(LinkArrowTypeCross).SetTail(LinkArrowTypeBullet)
+				f.AddLink(a, b).SetShape(LinkShapeInvisible).SetText("hidden")
+				f.AddLink(a, b).SetHead(LinkArrowTypeNone)
+			},
+			contains: []string{
+				`stroke-width="3.5" marker-end="url(#flowchart-arrow-end)"/>`,
+				`stroke-width="2" stroke-dasharray="3" marker-start="url(#flowchart-circle-start)" marker-end="url(#flowchart-cross-end)"/>`,
+				`fill="none" stroke="#333333" stroke-width="2"/>`,
+				">thick</tspan>",
+			},
+			notContains: []string{
+				">hidden</tspan>",
+			},
+		},
+		{
+			name: "Subgraphs",
+			setup: func(f *Flowchart) {
+				a := f.AddNode("A")
+				b := f.AddNode("B")
+				f.AddSubgraph("Group").AddLink(a, b)
+			},
+			contains: []string{
+				`fill="#ffffde" stroke="#aaaa33"/>`,
+				">Group</tspan>",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFlowchart()
+			tt.setup(f)
+
+			var sb strings.Builder
+			n, err := f.RenderSVG(&sb)
+			if err != nil {
+				t.Fatalf("RenderSVG() error = %v", err)
+			}
+
+			got := sb.String()
+			if n != int64(len(got)) {
+				t.Errorf("RenderSVG() = %d, want %d", n, len(got))
+			}
+			if !strings.HasPrefix(got, "<svg ") || !strings.HasSuffix(got, "</svg>\n") {
+				t.Errorf("RenderSVG() is not a single svg element:\n%s", got)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("RenderSVG() missing expected content %q in:\n%s", want, got)
+				}
+			}
+			for _, unwanted := range tt.notContains {
+				if strings.Contains(got, unwanted) {
+					t.Errorf("RenderSVG() has unexpected content %q in:\n%s", unwanted, got)
+				}
+			}
+		})
+	}
+}
+
+func TestFlowchart_RenderSVG_Deterministic(t *testing.T) {
+	build := func() string {
+		f := NewFlowchart()
+		nodes := make([]*Node, 0, 8)
+		for _, text := range []string{"A", "B", "C", "D", "E", "F", "G", "H"} {
+			nodes = append(nodes, f.AddNode(text))
+		}
+		for i := range nodes {
+			f.AddLink(nodes[i], nodes[(i*3+1)%len(nodes)])
+			f.AddLink(nodes[i], nodes[(i*5+2)%len(nodes)])
+		}
+
+		var sb strings.Builder
+		f.RenderSVG(&sb)
+		return sb.String()
+	}
+
+	want := build()
+	for i := 0; i < 5; i++ {
+		if got := build(); got != want {
+			t.Fatalf("RenderSVG() output differs between runs")
+		}
+	}
+}
+
+func TestFlowchart_RenderSVG_WriteError(t *testing.T) {
+	f := NewFlowchart()
+	f.AddNode("A")
+
+	if _, err := f.RenderSVG(failingWriter{}); err == nil {
+		t.Error("RenderSVG() error = nil, want the write error")
+	}
+}
+
+func TestResolveStyle(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func() *Node
+		want  NodeStyle
+	}{
+		{
+			name:  "Theme defaults",
+			setup: func() *Node { return NewNode("id", "") },
+			want:  NodeStyle{Color: defaultTextColor, Fill: defaultNodeFill, Stroke: defaultNodeStroke, StrokeWidth: 1},
+		},
+		{
+			name:  "Text node",
+			setup: func() *Node { return NewNode("id", "").SetShape(NodeShapeText) },
+			want:  NodeStyle{Color: defaultTextColor, Fill: "none", Stroke: "none", StrokeWidth: 1},
+		},
+		{
+			name: "Node style overrides class style",
+			setup: func() *Node {
+				class := NewClass("c")
+				class.Style.Fill = "#111"
+				class.Style.Stroke = "#222"
+				class.Style.StrokeDash = "4"
+				style := NewNodeStyle()
+				style.Fill = "#333"
+				return NewNode("id", "").SetClass(class).SetStyle(style)
+			},
+			want: NodeStyle{Color: defaultTextColor, Fill: "#333", Stroke: "#222", StrokeWidth: 1, StrokeDash: "4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveStyle(tt.setup()); got != tt.want {
+				t.Errorf("resolveStyle() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinkPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []point
+		linear bool
+		want   string
+	}{
+		{
+			name:   "Straight link",
+			points: []point{{0, 0}, {0, 10}},
+			want:   "M 0 0 L 0 10",
+		},
+		{
+			name:   "Linear bends",
+			points: []point{{0, 0}, {10, 10}, {0, 20}},
+			linear: true,
+			want:   "M 0 0 L 10 10 L 0 20",
+		},
+		{
+			name:   "Rounded bends",
+			points: []point{{0, 0}, {10, 10}, {10, 20}, {0, 30}},
+			want:   "M 0 0 Q 10 10 10 15 Q 10 20 0 30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := linkPath(tt.points, tt.linear); got != tt.want {
+				t.Errorf("linkPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShapePath(t *testing.T) {
+	tests := []struct {
+		name  string
+		shape nodeShape
+		want  string
+	}{
+		{
+			name:  "Process",
+			shape: NodeShapeProcess,
+			want:  "M -20 -10 L 20 -10 L 20 10 L -20 10 Z",
+		},
+		{
+			name:  "Decision",
+			shape: NodeShapeDecision,
+			want:  "M 0 -10 L 20 0 L 0 10 L -20 0 Z",
+		},
+		{
+			name:  "Subprocess",
+			shape: NodeShapeSubprocess,
+			want:  "M -20 -10 L 20 -10 L 20 10 L -20 10 Z M -12 -10 L -12 10 M 12 -10 L 12 10",
+		},
+		{
+			name:  "Circle",
+			shape: NodeShapeStart,
+			want:  "M -10 0 A 10 10 0 1 0 10 0 A 10 10 0 1 0 -10 0 Z",
+		},
+		{
+			name:  "Input output",
+			shape: NodeShapeInputOutput,
+			want:  "M -10 -10 L 20 -10 L 10 10 L -20 10 Z",
+		},
+		{
+			name:  "Unsupported shape falls back to a rectangle",
+			shape: NodeShapeTaggedDocument,
+			want:  "M -20 -10 L 20 -10 L 20 10 L -20 10 Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := &nodeLayout{node: NewNode("id", "").SetShape(tt.shape), width: 40, height: 20}
+			if got := shapePath(n); got != tt.want {
+				t.Errorf("shapePath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		value float64
+		want  string
+	}{
+		{0, "0"},
+		{-0.001, "0"},
+		{12, "12"},
+		{1.5, "1.5"},
+		{2.345678, "2.35"},
+		{-7.25, "-7.25"},
+	}
+
+	for _, tt := range tests {
+		if got := formatNumber(tt.value); got != tt.want {
+			t.Errorf("formatNumber(%v) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
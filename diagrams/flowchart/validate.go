@@ -0,0 +1,71 @@
+package flowchart
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the flowchart for problems that String would render silently:
+// links to nodes that were never added to the flowchart, duplicate node and
+// subgraph IDs, and nodes using a class that was never added.
+// Paths name the unexported fields of the flowchart, e.g. "links[2].From".
+func (f *Flowchart) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	nodes := make(map[*Node]bool, len(f.nodes))
+	for i, node := range f.nodes {
+		path := fmt.Sprintf("nodes[%d]", i)
+		v.UniqueID(path, node.ID)
+		nodes[node] = true
+
+		if node.Class != nil && !f.hasClass(node.Class) {
+			v.Warning(basediagram.CodeUnknownReference, path+".Class", "class %q is not part of the flowchart", node.Class.Name)
+		}
+	}
+
+	for i, subgraph := range f.subgraphs {
+		validateSubgraph(&v, fmt.Sprintf("subgraphs[%d]", i), subgraph, nodes)
+	}
+
+	for i, link := range f.links {
+		validateLink(&v, fmt.Sprintf("links[%d]", i), link, nodes)
+	}
+
+	return v.Errors()
+}
+
+func (f *Flowchart) hasClass(class *Class) bool {
+	for _, c := range f.classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func validateSubgraph(v *basediagram.Validator, path string, subgraph *Subgraph, nodes map[*Node]bool) {
+	v.UniqueID(path, subgraph.ID)
+
+	for i, child := range subgraph.subgraphs {
+		validateSubgraph(v, fmt.Sprintf("%s.subgraphs[%d]", path, i), child, nodes)
+	}
+
+	for i, link := range subgraph.links {
+		validateLink(v, fmt.Sprintf("%s.links[%d]", path, i), link, nodes)
+	}
+}
+
+func validateLink(v *basediagram.Validator, path string, link *Link, nodes map[*Node]bool) {
+	for _, end := range []struct {
+		field string
+		node  *Node
+	}{{"From", link.From}, {"To", link.To}} {
+		switch {
+		case end.node == nil:
+			v.Error(basediagram.CodeMissingReference, path+"."+end.field, "link has no node")
+		case !nodes[end.node]:
+			v.Error(basediagram.CodeUnknownReference, path+"."+end.field, "node %q is not part of the flowchart", end.node.ID)
+		}
+	}
+}
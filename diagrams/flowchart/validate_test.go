@@ -0,0 +1,81 @@
+package flowchart
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestFlowchart_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Flowchart)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty flowchart",
+			setup: func(f *Flowchart) {},
+		},
+		{
+			name: "Valid flowchart",
+			setup: func(f *Flowchart) {
+				class := f.AddClass("important")
+				start := f.AddNode("Start").SetClass(class)
+				end := f.AddNode("End")
+				f.AddLink(start, end)
+				f.AddSubgraph("Group").AddLink(end, start)
+			},
+		},
+		{
+			name: "Link to a node that was never added",
+			setup: func(f *Flowchart) {
+				f.AddLink(f.AddNode("Start"), NewNode("orphan", "Orphan"))
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "links[0].To", Message: `node "orphan" is not part of the flowchart`},
+			},
+		},
+		{
+			name: "Link without nodes inside a subgraph",
+			setup: func(f *Flowchart) {
+				f.AddSubgraph("Group").AddLink(nil, f.AddNode("End"))
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "subgraphs[0].links[0].From", Message: "link has no node"},
+			},
+		},
+		{
+			name: "Duplicate IDs",
+			setup: func(f *Flowchart) {
+				f.AddNode("Start")
+				f.AddNode("Again").ID = "0"
+				f.AddSubgraph("Group").ID = "0"
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "nodes[1]", Message: `ID "0" is already used by nodes[0]`},
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "subgraphs[0]", Message: `ID "0" is already used by nodes[0]`},
+			},
+		},
+		{
+			name: "Node using a class that was never added",
+			setup: func(f *Flowchart) {
+				f.AddNode("Start").SetClass(NewClass("important"))
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityWarning, Path: "nodes[0].Class", Message: `class "important" is not part of the flowchart`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFlowchart()
+			tt.setup(f)
+
+			if got := f.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package gantt
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	ganttConfigurationSection        string = "gantt"
+	baseGanttConfigurationProperties string = basediagram.Indentation + ganttConfigurationSection + ":\n"
+
+	ganttPropertyTitleTopMargin       string = "titleTopMargin"
+	ganttPropertyBarHeight            string = "barHeight"
+	ganttPropertyBarGap               string = "barGap"
+	ganttPropertyTopPadding           string = "topPadding"
+	ganttPropertyRightPadding         string = "rightPadding"
+	ganttPropertyLeftPadding          string = "leftPadding"
+	ganttPropertyGridLineStartPadding string = "gridLineStartPadding"
+	ganttPropertySectionFontSize      string = "sectionFontSize"
+	ganttPropertyNumberSectionStyles  string = "numberSectionStyles"
+	ganttPropertyAxisFormat           string = "axisFormat"
+	ganttPropertyTickInterval         string = "tickInterval"
+	ganttPropertyTopAxis              string = "topAxis"
+	ganttPropertyDisplayMode          string = "displayMode"
+	ganttPropertyWeekday              string = "weekday"
+	ganttPropertyUseMaxWidth          string = "useMaxWidth"
+)
+
+// GanttConfigurationProperties holds gantt-specific configuration
+type GanttConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewGanttConfigurationProperties() GanttConfigurationProperties {
+	return GanttConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *GanttConfigurationProperties) SetTitleTopMargin(v int) *GanttConfigurationProperties {
+	c.properties[ganttPropertyTitleTopMargin] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyTitleTopMargin,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetBarHeight(v int) *GanttConfigurationProperties {
+	c.properties[ganttPropertyBarHeight] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyBarHeight,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetBarGap(v int) *GanttConfigurationProperties {
+	c.properties[ganttPropertyBarGap] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyBarGap,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetTopPadding(v int) *GanttConfigurationProperties {
+	c.properties[ganttPropertyTopPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyTopPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetRightPadding(v int) *GanttConfigurationProperties {
+	c.properties[ganttPropertyRightPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyRightPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetLeftPadding(v int) *GanttConfigurationProperties {
+	c.properties[ganttPropertyLeftPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyLeftPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetGridLineStartPadding(v int) *GanttConfigurationProperties {
+	c.properties[ganttPropertyGridLineStartPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyGridLineStartPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetSectionFontSize(v int) *GanttConfigurationProperties {
+	c.properties[ganttPropertySectionFontSize] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertySectionFontSize,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetNumberSectionStyles(v int) *GanttConfigurationProperties {
+	c.properties[ganttPropertyNumberSectionStyles] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyNumberSectionStyles,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetAxisFormat(v string) *GanttConfigurationProperties {
+	c.properties[ganttPropertyAxisFormat] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyAxisFormat,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetTickInterval(v string) *GanttConfigurationProperties {
+	c.properties[ganttPropertyTickInterval] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyTickInterval,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetTopAxis(v bool) *GanttConfigurationProperties {
+	c.properties[ganttPropertyTopAxis] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyTopAxis,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetDisplayMode(v string) *GanttConfigurationProperties {
+	c.properties[ganttPropertyDisplayMode] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyDisplayMode,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetWeekday(v string) *GanttConfigurationProperties {
+	c.properties[ganttPropertyWeekday] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyWeekday,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GanttConfigurationProperties) SetUseMaxWidth(v bool) *GanttConfigurationProperties {
+	c.properties[ganttPropertyUseMaxWidth] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: ganttPropertyUseMaxWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c GanttConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(baseGanttConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,258 @@
+package gantt
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewGanttConfigurationProperties(t *testing.T) {
+	got := NewGanttConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewGanttConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewGanttConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestGanttConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   GanttConfigurationProperties
+		setup    func(*GanttConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewGanttConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewGanttConfigurationProperties(),
+			setup: func(c *GanttConfigurationProperties) {
+				c.SetTitleTopMargin(25)
+			},
+			contains: []string{
+				"gantt:",
+				"titleTopMargin: 25",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewGanttConfigurationProperties(),
+			setup: func(c *GanttConfigurationProperties) {
+				c.SetBarHeight(20)
+				c.SetUseMaxWidth(false)
+			},
+			contains: []string{
+				"gantt:",
+				"barHeight: 20",
+				"useMaxWidth: false",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewGanttConfigurationProperties(),
+			setup: func(c *GanttConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetTitleTopMargin(25)
+			},
+			contains: []string{
+				"fontSize: 12",
+				"gantt:",
+				"titleTopMargin: 25",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestGanttConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*GanttConfigurationProperties) *GanttConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set title top margin",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetTitleTopMargin(25)
+			},
+			property: ganttPropertyTitleTopMargin,
+			value:    25,
+		},
+		{
+			name: "Set bar height",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetBarHeight(20)
+			},
+			property: ganttPropertyBarHeight,
+			value:    20,
+		},
+		{
+			name: "Set bar gap",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetBarGap(4)
+			},
+			property: ganttPropertyBarGap,
+			value:    4,
+		},
+		{
+			name: "Set top padding",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetTopPadding(50)
+			},
+			property: ganttPropertyTopPadding,
+			value:    50,
+		},
+		{
+			name: "Set right padding",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetRightPadding(75)
+			},
+			property: ganttPropertyRightPadding,
+			value:    75,
+		},
+		{
+			name: "Set left padding",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetLeftPadding(75)
+			},
+			property: ganttPropertyLeftPadding,
+			value:    75,
+		},
+		{
+			name: "Set grid line start padding",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetGridLineStartPadding(35)
+			},
+			property: ganttPropertyGridLineStartPadding,
+			value:    35,
+		},
+		{
+			name: "Set section font size",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetSectionFontSize(11)
+			},
+			property: ganttPropertySectionFontSize,
+			value:    11,
+		},
+		{
+			name: "Set number section styles",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetNumberSectionStyles(4)
+			},
+			property: ganttPropertyNumberSectionStyles,
+			value:    4,
+		},
+		{
+			name: "Set axis format",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetAxisFormat("%Y-%m-%d")
+			},
+			property: ganttPropertyAxisFormat,
+			value:    "%Y-%m-%d",
+		},
+		{
+			name: "Set tick interval",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetTickInterval("1week")
+			},
+			property: ganttPropertyTickInterval,
+			value:    "1week",
+		},
+		{
+			name: "Set top axis",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetTopAxis(true)
+			},
+			property: ganttPropertyTopAxis,
+			value:    true,
+		},
+		{
+			name: "Set display mode",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetDisplayMode("compact")
+			},
+			property: ganttPropertyDisplayMode,
+			value:    "compact",
+		},
+		{
+			name: "Set weekday",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetWeekday("monday")
+			},
+			property: ganttPropertyWeekday,
+			value:    "monday",
+		},
+		{
+			name: "Set use max width",
+			setup: func(c *GanttConfigurationProperties) *GanttConfigurationProperties {
+				return c.SetUseMaxWidth(false)
+			},
+			property: ganttPropertyUseMaxWidth,
+			value:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewGanttConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
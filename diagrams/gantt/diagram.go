@@ -0,0 +1,139 @@
+// Package gantt provides functionality for creating Mermaid gantt charts
+package gantt
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for gantt charts
+const (
+	diagramType      string = "gantt"
+	baseDiagramType  string = diagramType + "\n"
+	baseDateFormat   string = basediagram.Indentation + "dateFormat %s\n"
+	baseAxisFormat   string = basediagram.Indentation + "axisFormat %s\n"
+	baseTickInterval string = basediagram.Indentation + "tickInterval %s\n"
+	baseExcludes     string = basediagram.Indentation + "excludes %s\n"
+	baseTodayMarker  string = basediagram.Indentation + "todayMarker %s\n"
+)
+
+// List of common values for the gantt chart settings.
+// Reference: https://mermaid.js.org/syntax/gantt.html#syntax
+const (
+	DateFormatISO   string = "YYYY-MM-DD" // Default input date format of Mermaid
+	ExcludeWeekends string = "weekends"   // Excludes saturdays and sundays
+	TodayMarkerOff  string = "off"        // Hides the marker of the current date
+)
+
+// Diagram represents a Mermaid gantt chart. Dates are written in DateFormat,
+// AxisFormat and TickInterval control the labels of the time axis, and the
+// dates and days listed in Excludes are skipped when durations are computed.
+// TodayMarker is either TodayMarkerOff or the CSS style of the marker.
+// Reference: https://mermaid.js.org/syntax/gantt.html
+type Diagram struct {
+	basediagram.BaseDiagram[GanttConfigurationProperties]
+	DateFormat   string
+	AxisFormat   string
+	TickInterval string
+	Excludes     []string
+	TodayMarker  string
+	Sections     []*Section
+}
+
+// NewDiagram creates a new gantt chart using ISO dates
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram: basediagram.NewBaseDiagram(NewGanttConfigurationProperties()),
+		DateFormat:  DateFormatISO,
+		Excludes:    make([]string, 0),
+		Sections:    make([]*Section, 0),
+	}
+}
+
+// SetDateFormat sets the format of the task dates and returns the diagram for chaining
+func (d *Diagram) SetDateFormat(format string) *Diagram {
+	d.DateFormat = format
+	return d
+}
+
+// SetAxisFormat sets the format of the axis labels and returns the diagram for chaining
+func (d *Diagram) SetAxisFormat(format string) *Diagram {
+	d.AxisFormat = format
+	return d
+}
+
+// SetTickInterval sets the interval between axis ticks, e.g. "1week", and returns the diagram for chaining
+func (d *Diagram) SetTickInterval(interval string) *Diagram {
+	d.TickInterval = interval
+	return d
+}
+
+// AddExcludes adds dates, weekdays or ExcludeWeekends to the excluded days and returns the diagram for chaining
+func (d *Diagram) AddExcludes(excludes ...string) *Diagram {
+	d.Excludes = append(d.Excludes, excludes...)
+	return d
+}
+
+// SetTodayMarker sets the style of the today marker, or TodayMarkerOff, and returns the diagram for chaining
+func (d *Diagram) SetTodayMarker(marker string) *Diagram {
+	d.TodayMarker = marker
+	return d
+}
+
+// AddSection creates and adds a new section to the gantt chart
+func (d *Diagram) AddSection(title string) *Section {
+	section := NewSection(title)
+	d.Sections = append(d.Sections, section)
+	return section
+}
+
+// String generates the Mermaid syntax for the gantt chart
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces a gantt chart.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one element at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		if d.DateFormat != "" {
+			w.Printf(baseDateFormat, d.DateFormat)
+		}
+
+		if d.AxisFormat != "" {
+			w.Printf(baseAxisFormat, d.AxisFormat)
+		}
+
+		if d.TickInterval != "" {
+			w.Printf(baseTickInterval, d.TickInterval)
+		}
+
+		if len(d.Excludes) > 0 {
+			w.Printf(baseExcludes, strings.Join(d.Excludes, ", "))
+		}
+
+		if d.TodayMarker != "" {
+			w.Printf(baseTodayMarker, d.TodayMarker)
+		}
+
+		for _, section := range d.Sections {
+			w.WriteString(section.String())
+		}
+	})
+}
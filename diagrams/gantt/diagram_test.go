@@ -0,0 +1,107 @@
+package gantt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if len(diagram.Sections) != 0 {
+		t.Error("NewDiagram() should create empty sections slice")
+	}
+
+	if diagram.DateFormat != DateFormatISO {
+		t.Errorf("NewDiagram() date format = %v, want %v", diagram.DateFormat, DateFormatISO)
+	}
+}
+
+func TestDiagram_Setters(t *testing.T) {
+	diagram := NewDiagram()
+
+	result := diagram.SetDateFormat("DD-MM-YYYY").
+		SetAxisFormat("%d/%m").
+		SetTickInterval("1week").
+		AddExcludes(ExcludeWeekends, "2024-01-01").
+		SetTodayMarker(TodayMarkerOff)
+
+	if result != diagram {
+		t.Error("Setters should return diagram for chaining")
+	}
+
+	if diagram.DateFormat != "DD-MM-YYYY" || diagram.AxisFormat != "%d/%m" || diagram.TickInterval != "1week" || diagram.TodayMarker != TodayMarkerOff {
+		t.Errorf("Setters did not set the diagram settings: %+v", diagram)
+	}
+
+	if len(diagram.Excludes) != 2 || diagram.Excludes[0] != ExcludeWeekends {
+		t.Errorf("AddExcludes() = %v, want [%v 2024-01-01]", diagram.Excludes, ExcludeWeekends)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func() *Diagram
+		contains []string
+	}{
+		{
+			name: "Empty diagram",
+			setup: func() *Diagram {
+				return NewDiagram()
+			},
+			contains: []string{
+				"gantt\n",
+				"dateFormat YYYY-MM-DD\n",
+			},
+		},
+		{
+			name: "Diagram with settings",
+			setup: func() *Diagram {
+				d := NewDiagram()
+				d.SetAxisFormat("%m/%d").SetTickInterval("1day").SetTodayMarker("stroke-width:5px")
+				d.AddExcludes(ExcludeWeekends, "2024-01-01")
+				return d
+			},
+			contains: []string{
+				"axisFormat %m/%d\n",
+				"tickInterval 1day\n",
+				"excludes weekends, 2024-01-01\n",
+				"todayMarker stroke-width:5px\n",
+			},
+		},
+		{
+			name: "Complete diagram",
+			setup: func() *Diagram {
+				d := NewDiagram()
+				d.SetTitle("Release plan")
+
+				planning := d.AddSection("Planning")
+				design := planning.AddTask("Design").SetID("des").SetStart("2024-01-01").SetDuration("5d")
+				planning.AddTask("Build").SetStatuses(TaskStatusCritical).SetID("build").SetAfter(design).SetDuration("10d")
+
+				return d
+			},
+			contains: []string{
+				"title: Release plan",
+				"gantt",
+				"section Planning",
+				"Design :des, 2024-01-01, 5d",
+				"Build :crit, build, after des, 10d",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagram := tt.setup()
+			result := diagram.String()
+
+			for _, want := range tt.contains {
+				if !strings.Contains(result, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, result)
+				}
+			}
+		})
+	}
+}
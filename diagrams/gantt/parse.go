@@ -0,0 +1,217 @@
+package gantt
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// durationPattern matches the task durations understood by Mermaid, e.g. "3d" or "1.5h".
+var durationPattern = regexp.MustCompile(`^\d+(?:\.\d+)?(?:ms|s|m|h|d|w)$`)
+
+// excludesSeparatorPattern matches the commas and spaces separating excluded days.
+var excludesSeparatorPattern = regexp.MustCompile(`[\s,]+`)
+
+// dependency is an `after` reference waiting for all the task IDs to be known.
+type dependency struct {
+	task *Task
+	id   string
+	line parser.Line
+	pos  int
+}
+
+type ganttParser struct {
+	diagram      *Diagram
+	section      *Section
+	dependencies []dependency
+}
+
+// Parse reads Mermaid gantt syntax and returns the corresponding Diagram.
+// It understands the syntax generated by Diagram.String as well as the `title`
+// statement. The `after` dependencies may refer to tasks declared later in
+// the chart, and tasks declared before any section are kept in a section
+// without title. Only the date format written in the chart is kept, so a
+// chart without dateFormat parses with an empty DateFormat.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &ganttParser{diagram: NewDiagram()}
+	p.diagram.DateFormat = ""
+	p.diagram.Title = doc.Title
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, ganttConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.resolveDependencies(); err != nil {
+		return nil, err
+	}
+
+	return p.diagram, nil
+}
+
+func (p *ganttParser) parseLine(line parser.Line) error {
+	keyword, rest, _ := strings.Cut(line.Text, " ")
+	rest = strings.TrimSpace(rest)
+
+	switch keyword {
+	case "title":
+		p.diagram.Title = rest
+		return nil
+	case "dateFormat":
+		p.diagram.DateFormat = rest
+		return nil
+	case "axisFormat":
+		p.diagram.AxisFormat = rest
+		return nil
+	case "tickInterval":
+		p.diagram.TickInterval = rest
+		return nil
+	case "todayMarker":
+		p.diagram.TodayMarker = rest
+		return nil
+	case "excludes":
+		for _, exclude := range excludesSeparatorPattern.Split(rest, -1) {
+			if exclude != "" {
+				p.diagram.AddExcludes(exclude)
+			}
+		}
+		return nil
+	case "section":
+		p.section = p.diagram.AddSection(basediagram.Unescape(rest))
+		return nil
+	case "accTitle", "accDescr", "includes", "inclusiveEndDates", "topAxis", "weekday", "click":
+		return line.Errorf(0, "unsupported statement %q", keyword)
+	}
+
+	return p.parseTask(parser.NewScanner(line))
+}
+
+// parseTask reads `Name :statuses, id, start, end`, where every metadata
+// item but the end is optional.
+func (p *ganttParser) parseTask(s *parser.Scanner) error {
+	name, ok := s.ReadUntil(":")
+	if !ok {
+		return s.Errorf("expected ':'")
+	}
+
+	task := NewTask(basediagram.Unescape(strings.TrimSpace(name)))
+
+	type item struct {
+		text string
+		pos  int
+	}
+	var items []item
+	for !s.EOF() {
+		s.SkipSpaces()
+		pos := s.Pos()
+		text, found := s.ReadUntil(",")
+		if !found {
+			text = s.Rest()
+			s.Advance(len(text))
+		}
+		items = append(items, item{text: strings.TrimSpace(text), pos: pos})
+	}
+
+	for len(items) > 0 && isStatus(items[0].text) {
+		task.Statuses = append(task.Statuses, taskStatus(items[0].text))
+		items = items[1:]
+	}
+
+	switch len(items) {
+	case 0:
+		return s.Errorf("expected task duration or end date")
+	case 1, 2, 3:
+	default:
+		return s.ErrorAt(items[3].pos, "unexpected %q", items[3].text)
+	}
+
+	for _, it := range items {
+		if it.text == "" {
+			return s.ErrorAt(it.pos, "expected task metadata")
+		}
+	}
+
+	if len(items) == 3 {
+		task.ID = items[0].text
+		items = items[1:]
+	}
+
+	if len(items) == 2 {
+		start := items[0]
+		if after, ok := strings.CutPrefix(start.text, "after "); ok {
+			for _, id := range strings.Fields(after) {
+				p.dependencies = append(p.dependencies, dependency{task: task, id: id, line: s.Line(), pos: start.pos})
+				task.After = append(task.After, nil)
+			}
+		} else {
+			task.Start = start.text
+		}
+		items = items[1:]
+	}
+
+	if durationPattern.MatchString(items[0].text) {
+		task.Duration = items[0].text
+	} else {
+		task.End = items[0].text
+	}
+
+	if p.section == nil {
+		p.section = p.diagram.AddSection("")
+	}
+	p.section.Tasks = append(p.section.Tasks, task)
+
+	return nil
+}
+
+// resolveDependencies replaces the `after` IDs with the tasks declaring them.
+func (p *ganttParser) resolveDependencies() error {
+	tasks := make(map[string]*Task)
+	for _, section := range p.diagram.Sections {
+		for _, task := range section.Tasks {
+			if task.ID != "" {
+				tasks[task.ID] = task
+			}
+		}
+	}
+
+	next := make(map[*Task]int)
+	for _, dep := range p.dependencies {
+		after, ok := tasks[dep.id]
+		if !ok {
+			return dep.line.Errorf(dep.pos, "unknown task %q", dep.id)
+		}
+		dep.task.After[next[dep.task]] = after
+		next[dep.task]++
+	}
+
+	return nil
+}
+
+func isStatus(text string) bool {
+	switch taskStatus(text) {
+	case TaskStatusDone, TaskStatusActive, TaskStatusCritical, TaskStatusMilestone:
+		return true
+	}
+	return false
+}
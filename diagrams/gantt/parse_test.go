@@ -0,0 +1,188 @@
+package gantt
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty gantt chart",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Gantt chart with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Release plan"
+				d.Config.SetAxisFormat("%Y-%m").SetTopAxis(true)
+				d.EnableMarkdownFence()
+				d.AddSection("").AddTask("Design").SetStart("2024-01-01").SetDuration("5d")
+			},
+		},
+		{
+			name: "Gantt chart with settings",
+			setup: func(d *Diagram) {
+				d.SetDateFormat("DD-MM-YYYY").SetAxisFormat("%d/%m").SetTickInterval("1week")
+				d.AddExcludes(ExcludeWeekends, "25-12-2024").SetTodayMarker("stroke-width:5px,stroke:#0f0")
+			},
+		},
+		{
+			name: "Gantt chart with text that needs escaping",
+			setup: func(d *Diagram) {
+				d.AddSection("Phase #1; alpha").AddTask(`Fix "bug"`).SetStart("2024-01-01").SetDuration("1d")
+				d.AddSection("Release").AddTask("Step: ship").SetDuration("1d")
+			},
+		},
+		{
+			name: "Gantt chart with statuses and dependencies",
+			setup: func(d *Diagram) {
+				planning := d.AddSection("Planning")
+				design := planning.AddTask("Design").SetStatuses(TaskStatusDone).SetID("des").SetStart("2024-01-01").SetEnd("2024-01-05")
+				review := planning.AddTask("Review").SetStatuses(TaskStatusActive, TaskStatusCritical).SetID("rev").SetAfter(design).SetDuration("2d")
+				release := d.AddSection("Release")
+				release.AddTask("Build").SetDuration("12h")
+				release.AddTask("Ship").SetStatuses(TaskStatusMilestone).SetID("ship").SetAfter(design, review).SetDuration("0d")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `gantt
+    title A Gantt Diagram
+    dateFormat YYYY-MM-DD
+    excludes weekends 2024-01-10
+    Kickoff :milestone, 2024-01-01, 0d
+    section Section
+    A task          :a1, after a2, 30d
+    Another task    :a2, 2024-01-02, 2024-01-04
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if d.Title != "A Gantt Diagram" {
+		t.Errorf("Parse() title = %v, want %v", d.Title, "A Gantt Diagram")
+	}
+
+	if len(d.Excludes) != 2 || d.Excludes[1] != "2024-01-10" {
+		t.Errorf("Parse() excludes = %v, want [weekends 2024-01-10]", d.Excludes)
+	}
+
+	if len(d.Sections) != 2 || d.Sections[0].Title != "" || d.Sections[1].Title != "Section" {
+		t.Fatalf("Parse() sections = %+v, want untitled and Section", d.Sections)
+	}
+
+	kickoff := d.Sections[0].Tasks[0]
+	if len(kickoff.Statuses) != 1 || kickoff.Statuses[0] != TaskStatusMilestone || kickoff.Start != "2024-01-01" || kickoff.Duration != "0d" {
+		t.Errorf("Parse() kickoff = %+v, want milestone on 2024-01-01", kickoff)
+	}
+
+	tasks := d.Sections[1].Tasks
+	if len(tasks) != 2 {
+		t.Fatalf("Parse() got %d tasks, want 2", len(tasks))
+	}
+	if tasks[0].ID != "a1" || len(tasks[0].After) != 1 || tasks[0].After[0] != tasks[1] || tasks[0].Duration != "30d" {
+		t.Errorf("Parse() task 0 = %+v, want a1 after a2 for 30d", tasks[0])
+	}
+	if tasks[1].Start != "2024-01-02" || tasks[1].End != "2024-01-04" {
+		t.Errorf("Parse() task 1 = %+v, want 2024-01-02 to 2024-01-04", tasks[1])
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "Design :5d\n",
+			line:    1,
+			column:  1,
+			message: "expected gantt declaration",
+		},
+		{
+			name:    "Task without colon",
+			input:   "gantt\n    Design 5d\n",
+			line:    2,
+			column:  5,
+			message: "expected ':'",
+		},
+		{
+			name:    "Task without duration",
+			input:   "gantt\n    Design :done\n",
+			line:    2,
+			column:  17,
+			message: "expected task duration or end date",
+		},
+		{
+			name:    "Too much metadata",
+			input:   "gantt\n    Design :a, 2024-01-01, 3d, 4d\n",
+			line:    2,
+			column:  32,
+			message: `unexpected "4d"`,
+		},
+		{
+			name:    "Unknown dependency",
+			input:   "gantt\n    Design :a, after b, 3d\n",
+			line:    2,
+			column:  16,
+			message: `unknown task "b"`,
+		},
+		{
+			name:    "Unsupported statement",
+			input:   "gantt\n    weekday monday\n",
+			line:    2,
+			column:  5,
+			message: `unsupported statement "weekday"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package gantt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for gantt sections
+const (
+	baseSectionTitle string = basediagram.Indentation + "section %s\n"
+)
+
+// Section groups the tasks of a gantt chart under a title.
+// Tasks declared before any section are kept in a section without title,
+// which is rendered without a section statement.
+type Section struct {
+	Title string
+	Tasks []*Task
+}
+
+// NewSection creates a new gantt section
+func NewSection(title string) *Section {
+	return &Section{
+		Title: title,
+		Tasks: make([]*Task, 0),
+	}
+}
+
+// AddTask creates and adds a new task to the section
+func (s *Section) AddTask(name string) *Task {
+	task := NewTask(name)
+	s.Tasks = append(s.Tasks, task)
+	return task
+}
+
+// String generates the Mermaid syntax for the section
+func (s *Section) String() string {
+	var sb strings.Builder
+
+	if s.Title != "" {
+		sb.WriteString(fmt.Sprintf(baseSectionTitle, basediagram.Escape(s.Title)))
+	}
+
+	for _, task := range s.Tasks {
+		sb.WriteString(task.String())
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,102 @@
+package gantt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewSection(t *testing.T) {
+	got := NewSection("Test Section")
+
+	if got.Title != "Test Section" {
+		t.Errorf("NewSection().Title = %v, want %v", got.Title, "Test Section")
+	}
+
+	if len(got.Tasks) != 0 {
+		t.Errorf("NewSection().Tasks length = %v, want 0", len(got.Tasks))
+	}
+}
+
+func TestSection_AddTask(t *testing.T) {
+	section := NewSection("Planning")
+	task := section.AddTask("Design")
+
+	if len(section.Tasks) != 1 || section.Tasks[0] != task {
+		t.Fatalf("AddTask() tasks = %v, want [%v]", section.Tasks, task)
+	}
+
+	if task.Name != "Design" {
+		t.Errorf("AddTask() name = %v, want %v", task.Name, "Design")
+	}
+}
+
+func TestSection_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		section  *Section
+		setup    func(*Section)
+		contains []string
+		excludes []string
+	}{
+		{
+			name:    "Empty section",
+			section: NewSection("Empty Section"),
+			contains: []string{
+				"section Empty Section\n",
+			},
+		},
+		{
+			name:    "Section with tasks",
+			section: NewSection("Planning"),
+			setup: func(s *Section) {
+				s.AddTask("Design").SetStart("2024-01-01").SetDuration("5d")
+				s.AddTask("Review").SetDuration("1d")
+			},
+			contains: []string{
+				"section Planning\n",
+				"Design :2024-01-01, 5d\n",
+				"Review :1d\n",
+			},
+		},
+		{
+			name:    "Section without title",
+			section: NewSection(""),
+			setup: func(s *Section) {
+				s.AddTask("Design").SetDuration("5d")
+			},
+			contains: []string{
+				"Design :5d\n",
+			},
+			excludes: []string{
+				"section",
+			},
+		},
+		{
+			name:    "Section with title that needs escaping",
+			section: NewSection("Phase #1"),
+			contains: []string{
+				"section Phase #35;1\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(tt.section)
+			}
+
+			got := tt.section.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(got, unwanted) {
+					t.Errorf("String() unexpected content %q in:\n%s", unwanted, got)
+				}
+			}
+		})
+	}
+}
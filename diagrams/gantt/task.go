@@ -0,0 +1,124 @@
+package gantt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+type taskStatus string
+
+// List of possible Task statuses. Critical can be combined with the others.
+// Reference: https://mermaid.js.org/syntax/gantt.html#syntax
+const (
+	TaskStatusDone      taskStatus = "done"
+	TaskStatusActive    taskStatus = "active"
+	TaskStatusCritical  taskStatus = "crit"
+	TaskStatusMilestone taskStatus = "milestone"
+)
+
+// Base string formats for gantt tasks
+const (
+	baseTask      string = basediagram.Indentation + basediagram.Indentation + "%s :%s\n"
+	baseTaskAfter string = "after %s"
+)
+
+// Task represents a bar of a gantt chart.
+// A task starts on the Start date, or after all the tasks listed in After,
+// or after the previous task when neither is set. It lasts for Duration,
+// e.g. "3d" or "24h", unless an End date is set.
+type Task struct {
+	ID       string
+	Name     string
+	Statuses []taskStatus
+	Start    string
+	After    []*Task
+	Duration string
+	End      string
+}
+
+// NewTask creates a new Task with the given name
+func NewTask(name string) *Task {
+	return &Task{
+		Name: name,
+	}
+}
+
+// SetID sets the task ID used by the dependencies of other tasks and returns the task for chaining
+func (t *Task) SetID(id string) *Task {
+	t.ID = id
+	return t
+}
+
+// SetStatuses sets the task statuses and returns the task for chaining
+func (t *Task) SetStatuses(statuses ...taskStatus) *Task {
+	t.Statuses = statuses
+	return t
+}
+
+// SetStart sets the start date of the task and returns the task for chaining
+func (t *Task) SetStart(date string) *Task {
+	t.Start = date
+	return t
+}
+
+// SetAfter makes the task start after the given tasks and returns the task for chaining
+func (t *Task) SetAfter(tasks ...*Task) *Task {
+	t.After = tasks
+	return t
+}
+
+// SetDuration sets the duration of the task, e.g. "3d", and returns the task for chaining
+func (t *Task) SetDuration(duration string) *Task {
+	t.Duration = duration
+	return t
+}
+
+// SetEnd sets the end date of the task and returns the task for chaining
+func (t *Task) SetEnd(date string) *Task {
+	t.End = date
+	return t
+}
+
+// String generates the Mermaid syntax for the task, listing its statuses,
+// ID, start and end. After takes precedence over Start, and End over Duration.
+// The ID is left out of a task without start, where Mermaid would read it
+// as the start date.
+func (t *Task) String() string {
+	metadata := make([]string, 0, len(t.Statuses)+3)
+
+	for _, status := range t.Statuses {
+		metadata = append(metadata, string(status))
+	}
+
+	if t.ID != "" && hasStart(t) {
+		metadata = append(metadata, t.ID)
+	}
+
+	if len(t.After) > 0 {
+		ids := make([]string, 0, len(t.After))
+		for _, task := range t.After {
+			if task != nil {
+				ids = append(ids, task.ID)
+			}
+		}
+		metadata = append(metadata, fmt.Sprintf(baseTaskAfter, strings.Join(ids, " ")))
+	} else if t.Start != "" {
+		metadata = append(metadata, t.Start)
+	}
+
+	if t.End != "" {
+		metadata = append(metadata, t.End)
+	} else if t.Duration != "" {
+		metadata = append(metadata, t.Duration)
+	}
+
+	return fmt.Sprintf(baseTask, basediagram.EscapeColons(t.Name), strings.Join(metadata, ", "))
+}
+
+// hasStart reports whether the task starts on a date or after other tasks,
+// which is when String renders its ID.
+func hasStart(task *Task) bool {
+	return task.Start != "" || len(task.After) > 0
+}
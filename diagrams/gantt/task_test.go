@@ -0,0 +1,104 @@
+package gantt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewTask(t *testing.T) {
+	got := NewTask("Design")
+	want := &Task{Name: "Design"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewTask() = %+v, want %+v", got, want)
+	}
+}
+
+func TestTask_Setters(t *testing.T) {
+	design := NewTask("Design")
+	task := NewTask("Build")
+
+	result := task.SetID("build").
+		SetStatuses(TaskStatusActive, TaskStatusCritical).
+		SetStart("2024-01-01").
+		SetAfter(design).
+		SetDuration("3d").
+		SetEnd("2024-01-10")
+
+	if result != task {
+		t.Error("Setters should return task for chaining")
+	}
+
+	want := &Task{
+		ID:       "build",
+		Name:     "Build",
+		Statuses: []taskStatus{TaskStatusActive, TaskStatusCritical},
+		Start:    "2024-01-01",
+		After:    []*Task{design},
+		Duration: "3d",
+		End:      "2024-01-10",
+	}
+	if !reflect.DeepEqual(task, want) {
+		t.Errorf("Setters = %+v, want %+v", task, want)
+	}
+}
+
+func TestTask_String(t *testing.T) {
+	design := NewTask("Design").SetID("des")
+	review := NewTask("Review").SetID("rev")
+
+	tests := []struct {
+		name string
+		task *Task
+		want string
+	}{
+		{
+			name: "Task with duration only",
+			task: NewTask("Design").SetDuration("3d"),
+			want: "        Design :3d\n",
+		},
+		{
+			name: "Task with start and end dates",
+			task: NewTask("Design").SetStart("2024-01-01").SetEnd("2024-01-05"),
+			want: "        Design :2024-01-01, 2024-01-05\n",
+		},
+		{
+			name: "Task with statuses and ID",
+			task: NewTask("Release").SetStatuses(TaskStatusCritical, TaskStatusMilestone).SetID("rel").SetStart("2024-02-01").SetDuration("0d"),
+			want: "        Release :crit, milestone, rel, 2024-02-01, 0d\n",
+		},
+		{
+			name: "Task after other tasks",
+			task: NewTask("Ship").SetAfter(design, review).SetDuration("1w"),
+			want: "        Ship :after des rev, 1w\n",
+		},
+		{
+			name: "Dependencies and end date take precedence",
+			task: NewTask("Ship").SetStart("2024-01-01").SetAfter(design).SetDuration("1w").SetEnd("2024-03-01"),
+			want: "        Ship :after des, 2024-03-01\n",
+		},
+		{
+			name: "Task name that needs escaping",
+			task: NewTask(`Fix "bug" #1`).SetDuration("1d"),
+			want: "        Fix #quot;bug#quot; #35;1 :1d\n",
+		},
+		{
+			name: "Task name with a colon",
+			task: NewTask("Step: one").SetDuration("1d"),
+			want: "        Step#58; one :1d\n",
+		},
+		{
+			name: "ID of a task without start is left out",
+			task: NewTask("Design").SetID("des").SetDuration("3d"),
+			want: "        Design :3d\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.task.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
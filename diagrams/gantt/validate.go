@@ -0,0 +1,81 @@
+package gantt
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// missing sections and tasks, empty task names, duplicate task IDs, tasks
+// without duration or end date, and dependencies on tasks that were never
+// added or whose ID is not rendered. Values hidden by the precedence of After and End, and IDs left
+// out of tasks without start, are reported as warnings.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	tasks := make(map[*Task]bool)
+	for _, section := range d.Sections {
+		if section == nil {
+			continue
+		}
+		for _, task := range section.Tasks {
+			tasks[task] = true
+		}
+	}
+
+	for i, section := range d.Sections {
+		if section == nil {
+			v.Error(basediagram.CodeMissingReference, fmt.Sprintf("Sections[%d]", i), "missing section")
+			continue
+		}
+		for j, task := range section.Tasks {
+			validateTask(&v, fmt.Sprintf("Sections[%d].Tasks[%d]", i, j), task, tasks)
+		}
+	}
+
+	return v.Errors()
+}
+
+func validateTask(v *basediagram.Validator, path string, task *Task, tasks map[*Task]bool) {
+	if task == nil {
+		v.Error(basediagram.CodeMissingReference, path, "missing task")
+		return
+	}
+
+	if task.Name == "" {
+		v.Error(basediagram.CodeInvalidValue, path+".Name", "task has no name")
+	}
+
+	if task.ID != "" {
+		v.UniqueID(path+".ID", task.ID)
+		if !hasStart(task) {
+			v.Warning(basediagram.CodeIgnoredValue, path+".ID", "ID %q is ignored for a task without start", task.ID)
+		}
+	}
+
+	for i, after := range task.After {
+		afterPath := fmt.Sprintf("%s.After[%d]", path, i)
+		switch {
+		case after == nil:
+			v.Error(basediagram.CodeMissingReference, afterPath, "missing task")
+		case !tasks[after]:
+			v.Error(basediagram.CodeUnknownReference, afterPath, "task %q is not part of the diagram", after.Name)
+		case after.ID == "":
+			v.Error(basediagram.CodeEmptyID, afterPath, "task %q has no ID to depend on", after.Name)
+		case !hasStart(after):
+			v.Error(basediagram.CodeEmptyID, afterPath, "task %q has no start, so its ID is not rendered", after.Name)
+		}
+	}
+
+	if len(task.After) > 0 && task.Start != "" {
+		v.Warning(basediagram.CodeIgnoredValue, path+".Start", "start %q is ignored for a task with dependencies", task.Start)
+	}
+
+	switch {
+	case task.End == "" && task.Duration == "":
+		v.Error(basediagram.CodeInvalidValue, path, "task has no duration or end date")
+	case task.End != "" && task.Duration != "":
+		v.Warning(basediagram.CodeIgnoredValue, path+".Duration", "duration %q is ignored for a task with an end date", task.Duration)
+	}
+}
@@ -0,0 +1,105 @@
+package gantt
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				section := d.AddSection("Planning")
+				design := section.AddTask("Design").SetID("des").SetStart("2024-01-01").SetDuration("5d")
+				section.AddTask("Build").SetAfter(design).SetEnd("2024-02-01")
+				section.AddTask("Test").SetDuration("3d")
+			},
+		},
+		{
+			name: "Empty name and missing duration",
+			setup: func(d *Diagram) {
+				section := d.AddSection("Planning")
+				section.AddTask("").SetDuration("1d")
+				section.AddTask("Step: one")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Sections[0].Tasks[0].Name", Message: "task has no name"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Sections[0].Tasks[1]", Message: "task has no duration or end date"},
+			},
+		},
+		{
+			name: "Duplicate ID and ID without start",
+			setup: func(d *Diagram) {
+				section := d.AddSection("Planning")
+				section.AddTask("Design").SetID("a").SetStart("2024-01-01").SetDuration("1d")
+				d.AddSection("Release").AddTask("Ship").SetID("a").SetDuration("1d")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Sections[1].Tasks[0].ID", Message: `ID "a" is already used by Sections[0].Tasks[0].ID`},
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Sections[1].Tasks[0].ID", Message: `ID "a" is ignored for a task without start`},
+			},
+		},
+		{
+			name: "Invalid dependencies",
+			setup: func(d *Diagram) {
+				section := d.AddSection("Planning")
+				design := section.AddTask("Design").SetDuration("1d")
+				build := section.AddTask("Build").SetID("build").SetDuration("1d")
+				section.AddTask("Ship").SetAfter(nil, NewTask("Ghost").SetID("ghost"), design, build).SetDuration("1d")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Sections[0].Tasks[1].ID", Message: `ID "build" is ignored for a task without start`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Sections[0].Tasks[2].After[0]", Message: "missing task"},
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "Sections[0].Tasks[2].After[1]", Message: `task "Ghost" is not part of the diagram`},
+				{Code: basediagram.CodeEmptyID, Severity: basediagram.SeverityError, Path: "Sections[0].Tasks[2].After[2]", Message: `task "Design" has no ID to depend on`},
+				{Code: basediagram.CodeEmptyID, Severity: basediagram.SeverityError, Path: "Sections[0].Tasks[2].After[3]", Message: `task "Build" has no start, so its ID is not rendered`},
+			},
+		},
+		{
+			name: "Ignored start and duration",
+			setup: func(d *Diagram) {
+				section := d.AddSection("Planning")
+				design := section.AddTask("Design").SetID("des").SetStart("2024-01-01").SetDuration("1d")
+				section.AddTask("Ship").SetStart("2024-01-05").SetAfter(design).SetDuration("1d").SetEnd("2024-01-09")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Sections[0].Tasks[1].Start", Message: `start "2024-01-05" is ignored for a task with dependencies`},
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Sections[0].Tasks[1].Duration", Message: `duration "1d" is ignored for a task with an end date`},
+			},
+		},
+		{
+			name: "Missing section and task",
+			setup: func(d *Diagram) {
+				section := d.AddSection("Planning")
+				section.Tasks = append(section.Tasks, nil)
+				d.Sections = append(d.Sections, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Sections[0].Tasks[0]", Message: "missing task"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Sections[1]", Message: "missing section"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
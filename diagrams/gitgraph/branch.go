@@ -0,0 +1,86 @@
+package gitgraph
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for branches
+const (
+	baseBranch      string = basediagram.Indentation + "branch %s"
+	baseBranchOrder string = " order: %d"
+)
+
+// plainBranchName matches the branch names Mermaid reads without quotes.
+var plainBranchName = regexp.MustCompile(`^\w([-./\w]*[-\w])?$`)
+
+// keywords are the git graph statements, read as such instead of branch names.
+var keywords = map[string]bool{
+	"commit":      true,
+	"branch":      true,
+	"checkout":    true,
+	"switch":      true,
+	"merge":       true,
+	"cherry-pick": true,
+}
+
+// Branch represents the creation of a branch from the current branch.
+// The new branch becomes the current branch.
+type Branch struct {
+	Name string
+	// Order sets the position of the branch in the graph, the main branch
+	// being at 0. Branches without order are drawn in creation order.
+	Order int
+}
+
+// NewBranch creates a new branch without order
+func NewBranch(name string) *Branch {
+	return &Branch{
+		Name: name,
+	}
+}
+
+// SetOrder sets the branch order and returns the branch for chaining
+func (b *Branch) SetOrder(order int) *Branch {
+	b.Order = order
+	return b
+}
+
+// String generates the Mermaid syntax for the branch
+func (b *Branch) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf(baseBranch, formatBranchName(b.Name)))
+	if b.Order > 0 {
+		sb.WriteString(fmt.Sprintf(baseBranchOrder, b.Order))
+	}
+	sb.WriteString("\n")
+
+	return sb.String()
+}
+
+func (b *Branch) apply(h *history) *historyError {
+	if b.Name == "" {
+		return newHistoryError(basediagram.CodeEmptyID, "Name", "branch has no name")
+	}
+	if _, ok := h.branches[b.Name]; ok {
+		return newHistoryError(basediagram.CodeDuplicateID, "Name", "branch %q already exists", b.Name)
+	}
+
+	h.branches[b.Name] = h.branches[h.current]
+	h.current = b.Name
+
+	return nil
+}
+
+// formatBranchName quotes the branch names that are not a single word for
+// Mermaid, such as names with spaces or keywords.
+func formatBranchName(name string) string {
+	if plainBranchName.MatchString(name) && !keywords[name] {
+		return name
+	}
+	return `"` + name + `"`
+}
@@ -0,0 +1,62 @@
+package gitgraph
+
+import "testing"
+
+func TestBranch_SetOrder(t *testing.T) {
+	branch := NewBranch("develop")
+
+	if result := branch.SetOrder(3); result != branch {
+		t.Error("SetOrder() should return branch for chaining")
+	}
+
+	if branch.Order != 3 {
+		t.Errorf("SetOrder() = %d, want 3", branch.Order)
+	}
+}
+
+func TestBranch_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		branch *Branch
+		want   string
+	}{
+		{
+			name:   "Branch without order",
+			branch: NewBranch("develop"),
+			want:   "    branch develop\n",
+		},
+		{
+			name:   "Branch with order",
+			branch: NewBranch("feature/login").SetOrder(2),
+			want:   "    branch feature/login order: 2\n",
+		},
+		{
+			name:   "Branch name with spaces",
+			branch: NewBranch("my feature"),
+			want:   "    branch \"my feature\"\n",
+		},
+		{
+			name:   "Branch name that is a keyword",
+			branch: NewBranch("commit"),
+			want:   "    branch \"commit\"\n",
+		},
+		{
+			name:   "Branch name ending with a dot",
+			branch: NewBranch("v1.").SetOrder(1),
+			want:   "    branch \"v1.\" order: 1\n",
+		},
+		{
+			name:   "Branch with negative order",
+			branch: NewBranch("develop").SetOrder(-1),
+			want:   "    branch develop\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.branch.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package gitgraph
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for checkouts
+const (
+	baseCheckout string = basediagram.Indentation + "checkout %s\n"
+	baseSwitch   string = basediagram.Indentation + "switch %s\n"
+)
+
+// Checkout represents a change of the current branch. Switch selects the
+// switch keyword, which Mermaid treats as an alias of checkout.
+type Checkout struct {
+	Branch string
+	Switch bool
+}
+
+// NewCheckout creates a new checkout of branch
+func NewCheckout(branch string) *Checkout {
+	return &Checkout{
+		Branch: branch,
+	}
+}
+
+// SetSwitch sets whether the switch keyword is used and returns the checkout for chaining
+func (c *Checkout) SetSwitch(useSwitch bool) *Checkout {
+	c.Switch = useSwitch
+	return c
+}
+
+// String generates the Mermaid syntax for the checkout
+func (c *Checkout) String() string {
+	if c.Switch {
+		return fmt.Sprintf(baseSwitch, formatBranchName(c.Branch))
+	}
+	return fmt.Sprintf(baseCheckout, formatBranchName(c.Branch))
+}
+
+func (c *Checkout) apply(h *history) *historyError {
+	if _, ok := h.branches[c.Branch]; !ok {
+		return newHistoryError(basediagram.CodeUnknownReference, "Branch", "branch %q does not exist", c.Branch)
+	}
+
+	h.current = c.Branch
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package gitgraph
+
+import "testing"
+
+func TestCheckout_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		checkout *Checkout
+		want     string
+	}{
+		{
+			name:     "Checkout",
+			checkout: NewCheckout("develop"),
+			want:     "    checkout develop\n",
+		},
+		{
+			name:     "Switch",
+			checkout: NewCheckout("develop").SetSwitch(true),
+			want:     "    switch develop\n",
+		},
+		{
+			name:     "Checkout of a branch name with spaces",
+			checkout: NewCheckout("my feature"),
+			want:     "    checkout \"my feature\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.checkout.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package gitgraph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for cherry-picks
+const (
+	baseCherryPick       string = basediagram.Indentation + "cherry-pick id: %s"
+	baseCherryPickParent string = " parent: %s"
+)
+
+// CherryPick represents a copy of an existing commit onto the current branch.
+// Parent is only set when cherry-picking a merge commit, and chooses which of
+// its parents the copied changes are relative to.
+type CherryPick struct {
+	ID     string
+	Parent string
+	Tag    string
+}
+
+// NewCherryPick creates a new cherry-pick of the commit with the given ID
+func NewCherryPick(id string) *CherryPick {
+	return &CherryPick{
+		ID: id,
+	}
+}
+
+// SetParent sets the parent of the cherry-picked merge commit and returns the cherry-pick for chaining
+func (c *CherryPick) SetParent(parent string) *CherryPick {
+	c.Parent = parent
+	return c
+}
+
+// SetTag sets the tag of the copied commit and returns the cherry-pick for chaining
+func (c *CherryPick) SetTag(tag string) *CherryPick {
+	c.Tag = tag
+	return c
+}
+
+// String generates the Mermaid syntax for the cherry-pick
+func (c *CherryPick) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf(baseCherryPick, basediagram.Quote(c.ID)))
+	if c.Parent != "" {
+		sb.WriteString(fmt.Sprintf(baseCherryPickParent, basediagram.Quote(c.Parent)))
+	}
+	if c.Tag != "" {
+		sb.WriteString(fmt.Sprintf(baseCommitTag, basediagram.Quote(c.Tag)))
+	}
+	sb.WriteString("\n")
+
+	return sb.String()
+}
+
+func (c *CherryPick) apply(h *history) *historyError {
+	if c.ID == "" {
+		return newHistoryError(basediagram.CodeEmptyID, "ID", "missing commit ID")
+	}
+
+	source, ok := h.commits[c.ID]
+	if !ok {
+		return newHistoryError(basediagram.CodeUnknownReference, "ID", "commit %q does not exist", c.ID)
+	}
+	if source.branch == h.current {
+		return newHistoryError(basediagram.CodeInvalidValue, "ID", "commit %q is already on branch %q", c.ID, h.current)
+	}
+
+	switch {
+	case len(source.parents) > 1 && c.Parent == "":
+		return newHistoryError(basediagram.CodeMissingReference, "Parent", "merge commit %q needs a parent to be cherry-picked", c.ID)
+	case len(source.parents) <= 1 && c.Parent != "":
+		return newHistoryError(basediagram.CodeInvalidValue, "Parent", "commit %q is not a merge commit", c.ID)
+	case c.Parent != "" && !hasParent(source, c.Parent):
+		return newHistoryError(basediagram.CodeUnknownReference, "Parent", "commit %q is not a parent of %q", c.Parent, c.ID)
+	}
+
+	current, err := h.branchHead("ID", h.current)
+	if err != nil {
+		return err
+	}
+
+	return h.addCommit("", current)
+}
+
+func hasParent(commit *commitNode, id string) bool {
+	for _, parent := range commit.parents {
+		if parent.id == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package gitgraph
+
+import "testing"
+
+func TestCherryPick_String(t *testing.T) {
+	tests := []struct {
+		name       string
+		cherryPick *CherryPick
+		want       string
+	}{
+		{
+			name:       "Cherry-pick",
+			cherryPick: NewCherryPick("fix"),
+			want:       "    cherry-pick id: \"fix\"\n",
+		},
+		{
+			name:       "Cherry-pick with tag",
+			cherryPick: NewCherryPick("fix").SetTag("v1.0.1"),
+			want:       "    cherry-pick id: \"fix\" tag: \"v1.0.1\"\n",
+		},
+		{
+			name:       "Cherry-pick of a merge commit",
+			cherryPick: NewCherryPick("merge").SetParent("fix"),
+			want:       "    cherry-pick id: \"merge\" parent: \"fix\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cherryPick.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package gitgraph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+type commitType string
+
+// List of possible commit types, which change how the commit is drawn.
+// Reference: https://mermaid.js.org/syntax/gitgraph.html#modifying-commit-type
+const (
+	CommitTypeDefault   commitType = ""
+	CommitTypeNormal    commitType = "NORMAL"
+	CommitTypeReverse   commitType = "REVERSE"
+	CommitTypeHighlight commitType = "HIGHLIGHT"
+)
+
+// Base string formats for commits
+const (
+	baseCommit     string = basediagram.Indentation + "commit"
+	baseCommitID   string = " id: %s"
+	baseCommitType string = " type: %s"
+	baseCommitTag  string = " tag: %s"
+)
+
+// Commit represents a commit added to the current branch. Mermaid generates
+// an ID for commits without one, but only commits with an ID can be
+// cherry-picked.
+type Commit struct {
+	ID   string
+	Type commitType
+	Tag  string
+}
+
+// NewCommit creates a new commit without ID, type or tag
+func NewCommit() *Commit {
+	return &Commit{}
+}
+
+// SetID sets the commit ID and returns the commit for chaining
+func (c *Commit) SetID(id string) *Commit {
+	c.ID = id
+	return c
+}
+
+// SetType sets the commit type and returns the commit for chaining
+func (c *Commit) SetType(commitType commitType) *Commit {
+	c.Type = commitType
+	return c
+}
+
+// SetTag sets the commit tag and returns the commit for chaining
+func (c *Commit) SetTag(tag string) *Commit {
+	c.Tag = tag
+	return c
+}
+
+// String generates the Mermaid syntax for the commit
+func (c *Commit) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(baseCommit)
+	writeAttributes(&sb, c.ID, c.Type, c.Tag)
+	sb.WriteString("\n")
+
+	return sb.String()
+}
+
+func (c *Commit) apply(h *history) *historyError {
+	return h.addCommit(c.ID, h.branches[h.current])
+}
+
+// writeAttributes writes the id, type and tag attributes shared by
+// commits and merges, skipping the ones that are not set.
+func writeAttributes(sb *strings.Builder, id string, t commitType, tag string) {
+	if id != "" {
+		sb.WriteString(fmt.Sprintf(baseCommitID, basediagram.Quote(id)))
+	}
+	if t != CommitTypeDefault {
+		sb.WriteString(fmt.Sprintf(baseCommitType, string(t)))
+	}
+	if tag != "" {
+		sb.WriteString(fmt.Sprintf(baseCommitTag, basediagram.Quote(tag)))
+	}
+}
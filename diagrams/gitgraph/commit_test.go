@@ -0,0 +1,52 @@
+package gitgraph
+
+import "testing"
+
+func TestCommit_Setters(t *testing.T) {
+	commit := NewCommit()
+
+	if result := commit.SetID("a").SetType(CommitTypeReverse).SetTag("v1"); result != commit {
+		t.Error("Setters should return commit for chaining")
+	}
+
+	if commit.ID != "a" || commit.Type != CommitTypeReverse || commit.Tag != "v1" {
+		t.Errorf("Setters = %+v, want a, REVERSE and v1", commit)
+	}
+}
+
+func TestCommit_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		commit *Commit
+		want   string
+	}{
+		{
+			name:   "Plain commit",
+			commit: NewCommit(),
+			want:   "    commit\n",
+		},
+		{
+			name:   "Commit with ID",
+			commit: NewCommit().SetID("init"),
+			want:   "    commit id: \"init\"\n",
+		},
+		{
+			name:   "Commit with every attribute",
+			commit: NewCommit().SetID("fix").SetType(CommitTypeHighlight).SetTag("v1.0.1"),
+			want:   "    commit id: \"fix\" type: HIGHLIGHT tag: \"v1.0.1\"\n",
+		},
+		{
+			name:   "Commit with text that needs escaping",
+			commit: NewCommit().SetTag(`"stable" #1`),
+			want:   "    commit tag: \"#quot;stable#quot; #35;1\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.commit.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package gitgraph
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	gitGraphConfigurationSection        string = "gitGraph"
+	baseGitGraphConfigurationProperties string = basediagram.Indentation + gitGraphConfigurationSection + ":\n"
+
+	gitGraphPropertyShowBranches      string = "showBranches"
+	gitGraphPropertyShowCommitLabel   string = "showCommitLabel"
+	gitGraphPropertyMainBranchName    string = "mainBranchName"
+	gitGraphPropertyMainBranchOrder   string = "mainBranchOrder"
+	gitGraphPropertyParallelCommits   string = "parallelCommits"
+	gitGraphPropertyRotateCommitLabel string = "rotateCommitLabel"
+)
+
+// GitGraphConfigurationProperties holds gitGraph-specific configuration
+type GitGraphConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewGitGraphConfigurationProperties() GitGraphConfigurationProperties {
+	return GitGraphConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *GitGraphConfigurationProperties) SetShowBranches(v bool) *GitGraphConfigurationProperties {
+	c.properties[gitGraphPropertyShowBranches] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: gitGraphPropertyShowBranches,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GitGraphConfigurationProperties) SetShowCommitLabel(v bool) *GitGraphConfigurationProperties {
+	c.properties[gitGraphPropertyShowCommitLabel] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: gitGraphPropertyShowCommitLabel,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GitGraphConfigurationProperties) SetMainBranchName(v string) *GitGraphConfigurationProperties {
+	c.properties[gitGraphPropertyMainBranchName] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: gitGraphPropertyMainBranchName,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GitGraphConfigurationProperties) SetMainBranchOrder(v int) *GitGraphConfigurationProperties {
+	c.properties[gitGraphPropertyMainBranchOrder] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: gitGraphPropertyMainBranchOrder,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GitGraphConfigurationProperties) SetParallelCommits(v bool) *GitGraphConfigurationProperties {
+	c.properties[gitGraphPropertyParallelCommits] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: gitGraphPropertyParallelCommits,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *GitGraphConfigurationProperties) SetRotateCommitLabel(v bool) *GitGraphConfigurationProperties {
+	c.properties[gitGraphPropertyRotateCommitLabel] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: gitGraphPropertyRotateCommitLabel,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c GitGraphConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(baseGitGraphConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
+
+// mainBranch returns the name of the branch the history starts on,
+// "main" unless another name was set with SetMainBranchName.
+func (c GitGraphConfigurationProperties) mainBranch() string {
+	if property, ok := c.properties[gitGraphPropertyMainBranchName]; ok {
+		if name, ok := property.Value().(string); ok && name != "" {
+			return name
+		}
+	}
+	return defaultMainBranchName
+}
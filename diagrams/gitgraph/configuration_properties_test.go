@@ -0,0 +1,186 @@
+package gitgraph
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewGitGraphConfigurationProperties(t *testing.T) {
+	got := NewGitGraphConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewGitGraphConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewGitGraphConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestGitGraphConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   GitGraphConfigurationProperties
+		setup    func(*GitGraphConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewGitGraphConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewGitGraphConfigurationProperties(),
+			setup: func(c *GitGraphConfigurationProperties) {
+				c.SetShowBranches(false)
+			},
+			contains: []string{
+				"gitGraph:",
+				"showBranches: false",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewGitGraphConfigurationProperties(),
+			setup: func(c *GitGraphConfigurationProperties) {
+				c.SetShowCommitLabel(false)
+				c.SetRotateCommitLabel(false)
+			},
+			contains: []string{
+				"gitGraph:",
+				"showCommitLabel: false",
+				"rotateCommitLabel: false",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewGitGraphConfigurationProperties(),
+			setup: func(c *GitGraphConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetShowBranches(false)
+			},
+			contains: []string{
+				"fontSize: 12",
+				"gitGraph:",
+				"showBranches: false",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestGitGraphConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*GitGraphConfigurationProperties) *GitGraphConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set show branches",
+			setup: func(c *GitGraphConfigurationProperties) *GitGraphConfigurationProperties {
+				return c.SetShowBranches(false)
+			},
+			property: gitGraphPropertyShowBranches,
+			value:    false,
+		},
+		{
+			name: "Set show commit label",
+			setup: func(c *GitGraphConfigurationProperties) *GitGraphConfigurationProperties {
+				return c.SetShowCommitLabel(false)
+			},
+			property: gitGraphPropertyShowCommitLabel,
+			value:    false,
+		},
+		{
+			name: "Set main branch name",
+			setup: func(c *GitGraphConfigurationProperties) *GitGraphConfigurationProperties {
+				return c.SetMainBranchName("trunk")
+			},
+			property: gitGraphPropertyMainBranchName,
+			value:    "trunk",
+		},
+		{
+			name: "Set main branch order",
+			setup: func(c *GitGraphConfigurationProperties) *GitGraphConfigurationProperties {
+				return c.SetMainBranchOrder(2)
+			},
+			property: gitGraphPropertyMainBranchOrder,
+			value:    2,
+		},
+		{
+			name: "Set parallel commits",
+			setup: func(c *GitGraphConfigurationProperties) *GitGraphConfigurationProperties {
+				return c.SetParallelCommits(true)
+			},
+			property: gitGraphPropertyParallelCommits,
+			value:    true,
+		},
+		{
+			name: "Set rotate commit label",
+			setup: func(c *GitGraphConfigurationProperties) *GitGraphConfigurationProperties {
+				return c.SetRotateCommitLabel(false)
+			},
+			property: gitGraphPropertyRotateCommitLabel,
+			value:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewGitGraphConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
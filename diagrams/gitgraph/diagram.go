@@ -0,0 +1,172 @@
+// Package gitgraph provides functionality for creating Mermaid git graphs
+package gitgraph
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+type gitGraphDirection string
+
+// List of possible git graph directions.
+// Reference: https://mermaid.js.org/syntax/gitgraph.html#orientation-v10-3-0
+const (
+	DirectionDefault     gitGraphDirection = ""
+	DirectionLeftRight   gitGraphDirection = "LR"
+	DirectionTopToBottom gitGraphDirection = "TB"
+	DirectionBottomUp    gitGraphDirection = "BT"
+)
+
+// Base string formats for git graphs
+const (
+	diagramType              string = "gitGraph"
+	baseDiagramType          string = diagramType + "\n"
+	baseDiagramTypeDirection string = diagramType + " %s:\n"
+	defaultMainBranchName    string = "main"
+)
+
+// Diagram represents a Mermaid git graph: the history of a repository drawn
+// as a list of commands, each applied to the branch checked out at that point.
+// The builder methods refuse the commands Mermaid cannot draw, such as merging
+// a branch into itself or checking out a branch that does not exist.
+// Reference: https://mermaid.js.org/syntax/gitgraph.html
+type Diagram struct {
+	basediagram.BaseDiagram[GitGraphConfigurationProperties]
+	Direction gitGraphDirection
+	Commands  []Command
+
+	// history is the history built by the first replayed commands, the last
+	// of them being lastReplayed, see state.
+	history      *history
+	replayed     int
+	lastReplayed Command
+}
+
+// NewDiagram creates a new git graph whose history starts on the main branch
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram: basediagram.NewBaseDiagram(NewGitGraphConfigurationProperties()),
+		Commands:    make([]Command, 0),
+	}
+}
+
+// SetDirection sets the git graph direction and returns the diagram for chaining
+func (d *Diagram) SetDirection(direction gitGraphDirection) *Diagram {
+	d.Direction = direction
+	return d
+}
+
+// CurrentBranch returns the name of the branch the next commit is added to.
+func (d *Diagram) CurrentBranch() string {
+	return d.state().current
+}
+
+// Commit adds a new commit to the current branch
+func (d *Diagram) Commit() *Commit {
+	commit := NewCommit()
+	d.Commands = append(d.Commands, commit)
+	return commit
+}
+
+// Branch creates a new branch from the current branch and checks it out.
+// It fails when a branch with the same name already exists.
+func (d *Diagram) Branch(name string) (*Branch, error) {
+	branch := NewBranch(name)
+	if err := d.add(branch); err != nil {
+		return nil, err
+	}
+	return branch, nil
+}
+
+// Checkout makes branch the current branch with the checkout command.
+// It fails when the branch does not exist.
+func (d *Diagram) Checkout(branch string) error {
+	return d.add(NewCheckout(branch))
+}
+
+// Switch makes branch the current branch with the switch command,
+// an alias of checkout. It fails when the branch does not exist.
+func (d *Diagram) Switch(branch string) error {
+	return d.add(NewCheckout(branch).SetSwitch(true))
+}
+
+// Merge merges branch into the current branch. It fails when the branch does
+// not exist, is the current branch, or has no commits to merge.
+func (d *Diagram) Merge(branch string) (*Merge, error) {
+	merge := NewMerge(branch)
+	if err := d.add(merge); err != nil {
+		return nil, err
+	}
+	return merge, nil
+}
+
+// CherryPick copies the commit with the given ID onto the current branch.
+// It fails when no commit has this ID, when the commit is already on the
+// current branch, or when it is a merge commit, see CherryPickMerge.
+func (d *Diagram) CherryPick(id string) (*CherryPick, error) {
+	return d.CherryPickMerge(id, "")
+}
+
+// CherryPickMerge copies the merge commit with the given ID onto the current
+// branch, keeping the changes it brought relative to its parent commit.
+func (d *Diagram) CherryPickMerge(id string, parent string) (*CherryPick, error) {
+	cherryPick := NewCherryPick(id).SetParent(parent)
+	if err := d.add(cherryPick); err != nil {
+		return nil, err
+	}
+	return cherryPick, nil
+}
+
+// add appends command to the diagram, unless it cannot be applied to the
+// history built by the commands before it.
+func (d *Diagram) add(command Command) error {
+	if err := command.apply(d.state()); err != nil {
+		// The kept history does not see the commit IDs changed after their
+		// command was applied, so a refusal is checked against a full replay.
+		d.history = nil
+		if err := command.apply(d.state()); err != nil {
+			return err
+		}
+	}
+
+	d.Commands = append(d.Commands, command)
+	d.markReplayed()
+	return nil
+}
+
+// String generates the Mermaid syntax for the git graph
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces a git graph.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one command at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		if d.Direction != DirectionDefault {
+			w.Printf(baseDiagramTypeDirection, string(d.Direction))
+		} else {
+			w.WriteString(baseDiagramType)
+		}
+
+		for _, command := range d.Commands {
+			if command != nil {
+				w.WriteString(command.String())
+			}
+		}
+	})
+}
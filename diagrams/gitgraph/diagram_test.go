@@ -0,0 +1,298 @@
+package gitgraph
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if diagram.Direction != DirectionDefault {
+		t.Errorf("NewDiagram() direction = %q, want default", diagram.Direction)
+	}
+
+	if len(diagram.Commands) != 0 {
+		t.Errorf("NewDiagram() commands = %v, want none", diagram.Commands)
+	}
+
+	if got := diagram.CurrentBranch(); got != "main" {
+		t.Errorf("NewDiagram() current branch = %q, want main", got)
+	}
+}
+
+func TestDiagram_SetDirection(t *testing.T) {
+	diagram := NewDiagram()
+
+	if result := diagram.SetDirection(DirectionTopToBottom); result != diagram {
+		t.Error("SetDirection() should return diagram for chaining")
+	}
+
+	if diagram.Direction != DirectionTopToBottom {
+		t.Errorf("SetDirection() = %q, want %q", diagram.Direction, DirectionTopToBottom)
+	}
+}
+
+func TestDiagram_Builder(t *testing.T) {
+	d := NewDiagram()
+
+	d.Commit().SetID("init")
+	if _, err := d.Branch("develop"); err != nil {
+		t.Fatalf("Branch() error = %v", err)
+	}
+	if got := d.CurrentBranch(); got != "develop" {
+		t.Errorf("Branch() current branch = %q, want develop", got)
+	}
+
+	d.Commit().SetID("feature")
+	if err := d.Checkout("main"); err != nil {
+		t.Fatalf("Checkout() error = %v", err)
+	}
+	if _, err := d.Merge("develop"); err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	if err := d.Switch("develop"); err != nil {
+		t.Fatalf("Switch() error = %v", err)
+	}
+	d.Commit().SetID("fix")
+	if err := d.Checkout("main"); err != nil {
+		t.Fatalf("Checkout() error = %v", err)
+	}
+	if _, err := d.CherryPick("fix"); err != nil {
+		t.Fatalf("CherryPick() error = %v", err)
+	}
+
+	if len(d.Commands) != 9 {
+		t.Errorf("Builder added %d commands, want 9", len(d.Commands))
+	}
+}
+
+func TestDiagram_Builder_Refused(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(*Diagram)
+		command func(*Diagram) error
+		wantErr string
+	}{
+		{
+			name:  "Existing branch",
+			setup: func(d *Diagram) {},
+			command: func(d *Diagram) error {
+				_, err := d.Branch("main")
+				return err
+			},
+			wantErr: `branch "main" already exists`,
+		},
+		{
+			name:  "Branch without name",
+			setup: func(d *Diagram) {},
+			command: func(d *Diagram) error {
+				_, err := d.Branch("")
+				return err
+			},
+			wantErr: "branch has no name",
+		},
+		{
+			name:  "Checkout of an unknown branch",
+			setup: func(d *Diagram) {},
+			command: func(d *Diagram) error {
+				return d.Checkout("develop")
+			},
+			wantErr: `branch "develop" does not exist`,
+		},
+		{
+			name:  "Switch to an unknown branch",
+			setup: func(d *Diagram) {},
+			command: func(d *Diagram) error {
+				return d.Switch("develop")
+			},
+			wantErr: `branch "develop" does not exist`,
+		},
+		{
+			name: "Merge into itself",
+			setup: func(d *Diagram) {
+				d.Commit()
+			},
+			command: func(d *Diagram) error {
+				_, err := d.Merge("main")
+				return err
+			},
+			wantErr: `cannot merge branch "main" into itself`,
+		},
+		{
+			name: "Merge of an unknown branch",
+			setup: func(d *Diagram) {
+				d.Commit()
+			},
+			command: func(d *Diagram) error {
+				_, err := d.Merge("develop")
+				return err
+			},
+			wantErr: `branch "develop" does not exist`,
+		},
+		{
+			name: "Merge of a branch without new commits",
+			setup: func(d *Diagram) {
+				d.Commit()
+				d.Branch("develop")
+				d.Checkout("main")
+			},
+			command: func(d *Diagram) error {
+				_, err := d.Merge("develop")
+				return err
+			},
+			wantErr: `branch "develop" has no commits to merge into "main"`,
+		},
+		{
+			name: "Merge into a branch without commits",
+			setup: func(d *Diagram) {
+				d.Branch("develop")
+				d.Commit()
+				d.Checkout("main")
+			},
+			command: func(d *Diagram) error {
+				_, err := d.Merge("develop")
+				return err
+			},
+			wantErr: `branch "main" has no commits`,
+		},
+		{
+			name: "Cherry-pick of an unknown commit",
+			setup: func(d *Diagram) {
+				d.Commit()
+			},
+			command: func(d *Diagram) error {
+				_, err := d.CherryPick("a")
+				return err
+			},
+			wantErr: `commit "a" does not exist`,
+		},
+		{
+			name: "Cherry-pick onto the same branch",
+			setup: func(d *Diagram) {
+				d.Commit().SetID("a")
+			},
+			command: func(d *Diagram) error {
+				_, err := d.CherryPick("a")
+				return err
+			},
+			wantErr: `commit "a" is already on branch "main"`,
+		},
+		{
+			name: "Cherry-pick of a merge commit without parent",
+			setup: func(d *Diagram) {
+				d.Commit().SetID("a")
+				d.Branch("develop")
+				d.Commit().SetID("b")
+				d.Checkout("main")
+				merge, _ := d.Merge("develop")
+				merge.SetID("m")
+				d.Branch("release")
+				d.Commit()
+			},
+			command: func(d *Diagram) error {
+				_, err := d.CherryPick("m")
+				return err
+			},
+			wantErr: `merge commit "m" needs a parent to be cherry-picked`,
+		},
+		{
+			name: "Cherry-pick of a merge commit with another parent",
+			setup: func(d *Diagram) {
+				d.Commit().SetID("a")
+				d.Branch("develop")
+				d.Commit().SetID("b")
+				d.Checkout("main")
+				merge, _ := d.Merge("develop")
+				merge.SetID("m")
+				d.Branch("release")
+				d.Commit()
+			},
+			command: func(d *Diagram) error {
+				_, err := d.CherryPickMerge("m", "c")
+				return err
+			},
+			wantErr: `commit "c" is not a parent of "m"`,
+		},
+		{
+			name: "Cherry-pick of a regular commit with parent",
+			setup: func(d *Diagram) {
+				d.Commit().SetID("a")
+				d.Branch("develop")
+				d.Commit()
+			},
+			command: func(d *Diagram) error {
+				_, err := d.CherryPickMerge("a", "b")
+				return err
+			},
+			wantErr: `commit "a" is not a merge commit`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+			count := len(d.Commands)
+
+			err := tt.command(d)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("builder error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(d.Commands) != count {
+				t.Errorf("builder added a refused command: %v", d.Commands[count:])
+			}
+		})
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  string
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+			want:  "gitGraph\n",
+		},
+		{
+			name: "Diagram with direction",
+			setup: func(d *Diagram) {
+				d.SetDirection(DirectionBottomUp)
+			},
+			want: "gitGraph BT:\n",
+		},
+		{
+			name: "Complete diagram",
+			setup: func(d *Diagram) {
+				d.Commit().SetID("init")
+				develop, _ := d.Branch("develop")
+				develop.SetOrder(2)
+				d.Commit().SetType(CommitTypeHighlight)
+				d.Checkout("main")
+				merge, _ := d.Merge("develop")
+				merge.SetTag("v1.0")
+			},
+			want: "gitGraph\n" +
+				"    commit id: \"init\"\n" +
+				"    branch develop order: 2\n" +
+				"    commit type: HIGHLIGHT\n" +
+				"    checkout main\n" +
+				"    merge develop tag: \"v1.0\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.String(); !strings.HasSuffix(got, "---\n"+tt.want) {
+				t.Errorf("String() = %q, want body %q", got, tt.want)
+			}
+		})
+	}
+}
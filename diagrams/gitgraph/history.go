@@ -0,0 +1,164 @@
+package gitgraph
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Command is a single statement of a git graph, such as a commit or a merge.
+// Commands can only be defined in this package, as each one knows how it
+// changes the history built by the commands before it.
+type Command interface {
+	String() string
+	apply(h *history) *historyError
+}
+
+// historyError describes why a command cannot be applied to a history.
+// The field of the command at fault and the validation code are kept for
+// Diagram.Validate.
+type historyError struct {
+	code    string
+	field   string
+	message string
+}
+
+// Error implements the error interface.
+func (e *historyError) Error() string {
+	return e.message
+}
+
+func newHistoryError(code string, field string, format string, args ...interface{}) *historyError {
+	return &historyError{
+		code:    code,
+		field:   field,
+		message: fmt.Sprintf(format, args...),
+	}
+}
+
+// commitNode is a commit of the history, including the commits created by
+// merges and cherry-picks.
+type commitNode struct {
+	id      string
+	branch  string
+	parents []*commitNode
+}
+
+// history is the state of the repository after a list of commands:
+// the head of every branch, the commits that can be referenced by ID
+// and the branch currently checked out.
+type history struct {
+	mainBranch string
+	current    string
+	branches   map[string]*commitNode
+	commits    map[string]*commitNode
+}
+
+func newHistory(mainBranch string) *history {
+	return &history{
+		mainBranch: mainBranch,
+		current:    mainBranch,
+		branches:   map[string]*commitNode{mainBranch: nil},
+		commits:    make(map[string]*commitNode),
+	}
+}
+
+// state returns the history built by the commands of the diagram. The history
+// is kept on the diagram and only the commands appended since the last call
+// are applied to it, unless Commands or the main branch were changed directly,
+// in which case it is rebuilt from the first command.
+func (d *Diagram) state() *history {
+	mainBranch := d.Config.mainBranch()
+	if d.history == nil || d.history.mainBranch != mainBranch || !d.isReplayed() {
+		d.history = newHistory(mainBranch)
+		d.replayed = 0
+	}
+
+	for _, command := range d.Commands[d.replayed:] {
+		if command != nil {
+			command.apply(d.history)
+		}
+	}
+	d.markReplayed()
+
+	return d.history
+}
+
+// isReplayed reports whether the commands applied to the kept history are
+// still the first commands of the diagram, judging by their count and by the
+// last of them.
+func (d *Diagram) isReplayed() bool {
+	if d.replayed > len(d.Commands) {
+		return false
+	}
+	return d.replayed == 0 || d.Commands[d.replayed-1] == d.lastReplayed
+}
+
+// markReplayed records that every command of the diagram was applied to the
+// kept history.
+func (d *Diagram) markReplayed() {
+	d.replayed = len(d.Commands)
+	d.lastReplayed = nil
+	if d.replayed > 0 {
+		d.lastReplayed = d.Commands[d.replayed-1]
+	}
+}
+
+// replayErrors applies the commands of the diagram in order, skipping the ones
+// that cannot be applied, and returns the resulting history along with the
+// reason each skipped command was refused, keyed by its index.
+func (d *Diagram) replayErrors() (*history, map[int]*historyError) {
+	h := newHistory(d.Config.mainBranch())
+	errs := make(map[int]*historyError)
+
+	for i, command := range d.Commands {
+		if command == nil {
+			continue
+		}
+		if err := command.apply(h); err != nil {
+			errs[i] = err
+		}
+	}
+
+	return h, errs
+}
+
+// addCommit adds a commit with the given parents to the current branch.
+// The commit is only recorded by ID when it has one.
+func (h *history) addCommit(id string, parents ...*commitNode) *historyError {
+	if id != "" {
+		if _, ok := h.commits[id]; ok {
+			return newHistoryError(basediagram.CodeDuplicateID, "ID", "commit ID %q is already used", id)
+		}
+	}
+
+	commit := &commitNode{
+		id:     id,
+		branch: h.current,
+	}
+	for _, parent := range parents {
+		if parent != nil {
+			commit.parents = append(commit.parents, parent)
+		}
+	}
+
+	h.branches[h.current] = commit
+	if id != "" {
+		h.commits[id] = commit
+	}
+
+	return nil
+}
+
+// branchHead returns the last commit of an existing branch, or an error when
+// the branch was never created or has no commits yet.
+func (h *history) branchHead(field string, name string) (*commitNode, *historyError) {
+	head, ok := h.branches[name]
+	if !ok {
+		return nil, newHistoryError(basediagram.CodeUnknownReference, field, "branch %q does not exist", name)
+	}
+	if head == nil {
+		return nil, newHistoryError(basediagram.CodeInvalidValue, field, "branch %q has no commits", name)
+	}
+	return head, nil
+}
@@ -0,0 +1,126 @@
+package gitgraph
+
+import "testing"
+
+func TestDiagram_replay(t *testing.T) {
+	d := NewDiagram()
+	d.Config.SetMainBranchName("trunk")
+
+	d.Commit().SetID("a")
+	d.Branch("develop")
+	d.Commit().SetID("b")
+	d.Checkout("trunk")
+	d.Commit().SetID("c")
+	d.Commands = append(d.Commands, NewCheckout("release"), NewCommit().SetID("a"))
+
+	h, errs := d.replayErrors()
+
+	if h.current != "trunk" {
+		t.Errorf("replay() current branch = %q, want trunk", h.current)
+	}
+
+	if head := h.branches["develop"]; head == nil || head.id != "b" || head.branch != "develop" {
+		t.Errorf("replay() develop head = %+v, want commit b", head)
+	}
+
+	if head := h.branches["trunk"]; head == nil || head.id != "c" || len(head.parents) != 1 || head.parents[0].id != "a" {
+		t.Errorf("replay() trunk head = %+v, want commit c after a", head)
+	}
+
+	if len(errs) != 2 || errs[5] == nil || errs[6] == nil {
+		t.Fatalf("replay() errors = %v, want commands 5 and 6 refused", errs)
+	}
+
+	if errs[6].code != "duplicate-id" || errs[6].field != "ID" {
+		t.Errorf("replay() error = %+v, want duplicate ID", errs[6])
+	}
+}
+
+func TestDiagram_state(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(d *Diagram)
+		wantRebuilt bool
+		wantCurrent string
+	}{
+		{
+			name: "Builder calls update the kept history",
+			setup: func(d *Diagram) {
+				d.Commit()
+				d.Checkout("develop")
+			},
+			wantRebuilt: false,
+			wantCurrent: "develop",
+		},
+		{
+			name: "Commands appended directly are applied",
+			setup: func(d *Diagram) {
+				d.Commands = append(d.Commands, NewCheckout("main"))
+			},
+			wantRebuilt: false,
+			wantCurrent: "main",
+		},
+		{
+			name: "Commands changed directly",
+			setup: func(d *Diagram) {
+				d.Commands = d.Commands[:1]
+			},
+			wantRebuilt: true,
+			wantCurrent: "main",
+		},
+		{
+			name: "Last command replaced directly",
+			setup: func(d *Diagram) {
+				d.Commands[len(d.Commands)-1] = NewCheckout("main")
+			},
+			wantRebuilt: true,
+			wantCurrent: "main",
+		},
+		{
+			name: "Main branch renamed",
+			setup: func(d *Diagram) {
+				d.Config.SetMainBranchName("trunk")
+			},
+			wantRebuilt: true,
+			wantCurrent: "develop",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			d.Commit()
+			d.Branch("develop")
+			d.Commit()
+			h := d.state()
+
+			tt.setup(d)
+
+			got := d.state()
+			if rebuilt := got != h; rebuilt != tt.wantRebuilt {
+				t.Errorf("state() rebuilt = %v, want %v", rebuilt, tt.wantRebuilt)
+			}
+			if got.current != tt.wantCurrent {
+				t.Errorf("state() current branch = %q, want %q", got.current, tt.wantCurrent)
+			}
+		})
+	}
+}
+
+func TestDiagram_state_ChangedID(t *testing.T) {
+	d := NewDiagram()
+	d.Commit().SetID("a")
+	d.Branch("develop")
+	d.Commit().SetID("b")
+	d.Checkout("main")
+	merge, err := d.Merge("develop")
+	if err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	merge.SetID("m")
+	d.Branch("release")
+
+	if _, err := d.CherryPickMerge("m", "b"); err != nil {
+		t.Errorf("CherryPickMerge() error = %v, want the ID set after the merge to be found", err)
+	}
+}
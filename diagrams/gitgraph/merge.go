@@ -0,0 +1,80 @@
+package gitgraph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for merges
+const (
+	baseMerge string = basediagram.Indentation + "merge %s"
+)
+
+// Merge represents the merge of a branch into the current branch. The merge
+// commit accepts the same ID, type and tag as a regular commit.
+type Merge struct {
+	Branch string
+	ID     string
+	Type   commitType
+	Tag    string
+}
+
+// NewMerge creates a new merge of branch
+func NewMerge(branch string) *Merge {
+	return &Merge{
+		Branch: branch,
+	}
+}
+
+// SetID sets the ID of the merge commit and returns the merge for chaining
+func (m *Merge) SetID(id string) *Merge {
+	m.ID = id
+	return m
+}
+
+// SetType sets the type of the merge commit and returns the merge for chaining
+func (m *Merge) SetType(commitType commitType) *Merge {
+	m.Type = commitType
+	return m
+}
+
+// SetTag sets the tag of the merge commit and returns the merge for chaining
+func (m *Merge) SetTag(tag string) *Merge {
+	m.Tag = tag
+	return m
+}
+
+// String generates the Mermaid syntax for the merge
+func (m *Merge) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf(baseMerge, formatBranchName(m.Branch)))
+	writeAttributes(&sb, m.ID, m.Type, m.Tag)
+	sb.WriteString("\n")
+
+	return sb.String()
+}
+
+func (m *Merge) apply(h *history) *historyError {
+	if m.Branch == h.current {
+		return newHistoryError(basediagram.CodeInvalidValue, "Branch", "cannot merge branch %q into itself", m.Branch)
+	}
+
+	head, err := h.branchHead("Branch", m.Branch)
+	if err != nil {
+		return err
+	}
+
+	current, err := h.branchHead("Branch", h.current)
+	if err != nil {
+		return err
+	}
+
+	if head == current {
+		return newHistoryError(basediagram.CodeInvalidValue, "Branch", "branch %q has no commits to merge into %q", m.Branch, h.current)
+	}
+
+	return h.addCommit(m.ID, current, head)
+}
@@ -0,0 +1,47 @@
+package gitgraph
+
+import "testing"
+
+func TestMerge_Setters(t *testing.T) {
+	merge := NewMerge("develop")
+
+	if result := merge.SetID("m").SetType(CommitTypeNormal).SetTag("v2"); result != merge {
+		t.Error("Setters should return merge for chaining")
+	}
+
+	if merge.Branch != "develop" || merge.ID != "m" || merge.Type != CommitTypeNormal || merge.Tag != "v2" {
+		t.Errorf("Setters = %+v, want develop, m, NORMAL and v2", merge)
+	}
+}
+
+func TestMerge_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		merge *Merge
+		want  string
+	}{
+		{
+			name:  "Plain merge",
+			merge: NewMerge("develop"),
+			want:  "    merge develop\n",
+		},
+		{
+			name:  "Merge with every attribute",
+			merge: NewMerge("develop").SetID("release").SetType(CommitTypeReverse).SetTag("v2.0"),
+			want:  "    merge develop id: \"release\" type: REVERSE tag: \"v2.0\"\n",
+		},
+		{
+			name:  "Merge of a branch name with spaces",
+			merge: NewMerge("my feature"),
+			want:  "    merge \"my feature\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.merge.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
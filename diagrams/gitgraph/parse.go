@@ -0,0 +1,236 @@
+package gitgraph
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// attribute is a `key: value` pair following a command, with the position of
+// its value for error reporting.
+type attribute struct {
+	value string
+	pos   int
+}
+
+// Parse reads Mermaid git graph syntax and returns the corresponding Diagram.
+// It understands the syntax generated by Diagram.String, including quoted
+// branch names. Commands are checked against the history like the builder
+// methods do, so a merge of an unknown branch is reported as a syntax error.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+
+	d := NewDiagram()
+	d.Title = doc.Title
+	if err := doc.Config.Apply(&d.Config.ConfigurationProperties, gitGraphConfigurationSection, d.Config.properties); err != nil {
+		return nil, err
+	}
+
+	if rest != "" {
+		direction := strings.TrimSpace(strings.TrimSuffix(rest, ":"))
+		switch gitGraphDirection(direction) {
+		case DirectionDefault, DirectionLeftRight, DirectionTopToBottom, DirectionBottomUp:
+			d.Direction = gitGraphDirection(direction)
+		default:
+			return nil, header.Errorf(len(header.Text)-len(rest), "unknown direction %q", direction)
+		}
+	}
+
+	for _, line := range doc.Body() {
+		command, err := parseCommand(line)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := d.add(command); err != nil {
+			return nil, line.Errorf(0, "%s", err.Error())
+		}
+	}
+
+	return d, nil
+}
+
+// parseCommand reads a single git graph command.
+func parseCommand(line parser.Line) (Command, error) {
+	s := parser.NewScanner(line)
+	keyword := s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) })
+
+	switch keyword {
+	case "commit":
+		attributes, err := readAttributes(s, "id", "type", "tag")
+		if err != nil {
+			return nil, err
+		}
+
+		commitType, err := readType(s, attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewCommit().
+			SetID(attributes["id"].value).
+			SetType(commitType).
+			SetTag(attributes["tag"].value), nil
+
+	case "branch":
+		name, err := readBranchName(s)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes, err := readAttributes(s, "order")
+		if err != nil {
+			return nil, err
+		}
+
+		branch := NewBranch(name)
+		if order, ok := attributes["order"]; ok {
+			if branch.Order, err = strconv.Atoi(order.value); err != nil {
+				return nil, s.ErrorAt(order.pos, "invalid order %q", order.value)
+			}
+		}
+
+		return branch, nil
+
+	case "checkout", "switch":
+		name, err := readBranchName(s)
+		if err != nil {
+			return nil, err
+		}
+
+		s.SkipSpaces()
+		if !s.EOF() {
+			return nil, s.Errorf("unexpected %q", s.Rest())
+		}
+
+		return NewCheckout(name).SetSwitch(keyword == "switch"), nil
+
+	case "merge":
+		name, err := readBranchName(s)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes, err := readAttributes(s, "id", "type", "tag")
+		if err != nil {
+			return nil, err
+		}
+
+		commitType, err := readType(s, attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewMerge(name).
+			SetID(attributes["id"].value).
+			SetType(commitType).
+			SetTag(attributes["tag"].value), nil
+
+	case "cherry-pick":
+		attributes, err := readAttributes(s, "id", "parent", "tag")
+		if err != nil {
+			return nil, err
+		}
+
+		return NewCherryPick(attributes["id"].value).
+			SetParent(attributes["parent"].value).
+			SetTag(attributes["tag"].value), nil
+	}
+
+	return nil, line.Errorf(0, "unknown command %q", keyword)
+}
+
+// readBranchName reads a branch name, either as a single word or quoted.
+func readBranchName(s *parser.Scanner) (string, error) {
+	s.SkipSpaces()
+
+	if s.HasPrefix(`"`) {
+		return s.ReadQuoted()
+	}
+
+	name := s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) })
+	if name == "" {
+		return "", s.Errorf("expected branch name")
+	}
+
+	return name, nil
+}
+
+// readAttributes reads the `key: value` pairs up to the end of the line,
+// refusing the keys that are not listed. Quoted values are unescaped.
+func readAttributes(s *parser.Scanner, keys ...string) (map[string]attribute, error) {
+	attributes := make(map[string]attribute)
+
+	for {
+		s.SkipSpaces()
+		if s.EOF() {
+			return attributes, nil
+		}
+
+		keyPos := s.Pos()
+		key := s.ReadWhile(unicode.IsLetter)
+		if !isKey(key, keys) {
+			return nil, s.ErrorAt(keyPos, "unexpected %q", s.Line().Text[keyPos:])
+		}
+		if _, ok := attributes[key]; ok {
+			return nil, s.ErrorAt(keyPos, "duplicate %q attribute", key)
+		}
+
+		s.SkipSpaces()
+		if !s.Consume(":") {
+			return nil, s.Errorf("expected ':' after %q", key)
+		}
+		s.SkipSpaces()
+
+		valuePos := s.Pos()
+		var value string
+		if s.HasPrefix(`"`) {
+			quoted, err := s.ReadQuoted()
+			if err != nil {
+				return nil, err
+			}
+			value = basediagram.Unescape(quoted)
+		} else {
+			value = s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) })
+		}
+
+		attributes[key] = attribute{value: value, pos: valuePos}
+	}
+}
+
+// readType converts the type attribute to a commit type.
+func readType(s *parser.Scanner, attributes map[string]attribute) (commitType, error) {
+	typeAttribute, ok := attributes["type"]
+	if !ok {
+		return CommitTypeDefault, nil
+	}
+
+	switch t := commitType(typeAttribute.value); t {
+	case CommitTypeNormal, CommitTypeReverse, CommitTypeHighlight:
+		return t, nil
+	}
+
+	return "", s.ErrorAt(typeAttribute.pos, "unknown commit type %q", typeAttribute.value)
+}
+
+func isKey(key string, keys []string) bool {
+	for _, k := range keys {
+		if key == k {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,217 @@
+package gitgraph
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty git graph",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Git graph with title, config, direction and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Release flow"
+				d.Config.SetShowBranches(false).SetMainBranchName("trunk")
+				d.SetDirection(DirectionTopToBottom)
+				d.EnableMarkdownFence()
+				d.Commit()
+				d.Branch("develop")
+				d.Commit()
+				d.Checkout("trunk")
+				d.Merge("develop")
+			},
+		},
+		{
+			name: "Git graph with every command",
+			setup: func(d *Diagram) {
+				d.Commit().SetID("init").SetTag("v0.1")
+				develop, _ := d.Branch("develop")
+				develop.SetOrder(1)
+				d.Commit().SetID("feature").SetType(CommitTypeHighlight)
+				d.Commit().SetID("fix").SetType(CommitTypeReverse)
+				d.Switch("main")
+				merge, _ := d.Merge("develop")
+				merge.SetID("release").SetType(CommitTypeNormal).SetTag("v1.0")
+				d.Branch("hotfix")
+				d.Commit()
+				d.CherryPickMerge("release", "fix")
+				d.Checkout("develop")
+				d.CherryPick("init")
+			},
+		},
+		{
+			name: "Git graph with branch names that need quoting",
+			setup: func(d *Diagram) {
+				d.Commit()
+				branch, _ := d.Branch("my feature")
+				branch.SetOrder(2)
+				d.Commit()
+				d.Switch("main")
+				d.Merge("my feature")
+				d.Branch("merge")
+				d.Commit()
+				d.Checkout("my feature")
+				d.Merge("merge")
+			},
+		},
+		{
+			name: "Git graph with text that needs escaping",
+			setup: func(d *Diagram) {
+				d.Commit().SetID(`say "hi"`).SetTag("#1; final")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `gitGraph LR:
+  commit
+  commit id:"Alpha" tag:"v1"
+  branch "feature" order:3
+  checkout feature
+  commit type:HIGHLIGHT
+  checkout main
+  merge feature
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if d.Direction != DirectionLeftRight {
+		t.Errorf("Parse() direction = %q, want LR", d.Direction)
+	}
+
+	if len(d.Commands) != 7 {
+		t.Fatalf("Parse() got %d commands, want 7", len(d.Commands))
+	}
+
+	if commit, ok := d.Commands[1].(*Commit); !ok || commit.ID != "Alpha" || commit.Tag != "v1" {
+		t.Errorf("Parse() second command = %+v, want commit Alpha tagged v1", d.Commands[1])
+	}
+
+	if branch, ok := d.Commands[2].(*Branch); !ok || branch.Name != "feature" || branch.Order != 3 {
+		t.Errorf("Parse() third command = %+v, want branch feature with order 3", d.Commands[2])
+	}
+
+	if commit, ok := d.Commands[4].(*Commit); !ok || commit.Type != CommitTypeHighlight {
+		t.Errorf("Parse() fifth command = %+v, want highlighted commit", d.Commands[4])
+	}
+
+	if merge, ok := d.Commands[6].(*Merge); !ok || merge.Branch != "feature" {
+		t.Errorf("Parse() last command = %+v, want merge of feature", d.Commands[6])
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "commit\n",
+			line:    1,
+			column:  1,
+			message: "expected gitGraph declaration",
+		},
+		{
+			name:    "Unknown direction",
+			input:   "gitGraph RL:\n",
+			line:    1,
+			column:  10,
+			message: `unknown direction "RL"`,
+		},
+		{
+			name:    "Unknown command",
+			input:   "gitGraph\n    rebase develop\n",
+			line:    2,
+			column:  5,
+			message: `unknown command "rebase"`,
+		},
+		{
+			name:    "Unknown attribute",
+			input:   "gitGraph\n    commit msg: \"hello\"\n",
+			line:    2,
+			column:  12,
+			message: `unexpected "msg: \"hello\""`,
+		},
+		{
+			name:    "Unknown commit type",
+			input:   "gitGraph\n    commit type: BOLD\n",
+			line:    2,
+			column:  18,
+			message: `unknown commit type "BOLD"`,
+		},
+		{
+			name:    "Invalid order",
+			input:   "gitGraph\n    branch develop order: first\n",
+			line:    2,
+			column:  27,
+			message: `invalid order "first"`,
+		},
+		{
+			name:    "Checkout of an unknown branch",
+			input:   "gitGraph\n    commit\n    checkout develop\n",
+			line:    3,
+			column:  5,
+			message: `branch "develop" does not exist`,
+		},
+		{
+			name:    "Merge into itself",
+			input:   "gitGraph\n    commit\n    merge main\n",
+			line:    3,
+			column:  5,
+			message: `cannot merge branch "main" into itself`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
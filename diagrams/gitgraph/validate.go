@@ -0,0 +1,59 @@
+package gitgraph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// commands that cannot be applied to the history built by the commands before
+// them, e.g. because they were appended to Commands directly or a commit ID
+// was changed afterwards, branch names that cannot be quoted and unknown
+// commit types.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	_, errs := d.replayErrors()
+
+	for i, command := range d.Commands {
+		path := fmt.Sprintf("Commands[%d]", i)
+
+		switch command := command.(type) {
+		case nil:
+			v.Error(basediagram.CodeMissingReference, path, "missing command")
+			continue
+		case *Commit:
+			validateType(&v, path, command.Type)
+		case *Merge:
+			validateType(&v, path, command.Type)
+		case *Branch:
+			validateBranch(&v, path, command)
+		}
+
+		if err, ok := errs[i]; ok {
+			v.Error(err.code, path+"."+err.field, "%s", err.message)
+		}
+	}
+
+	return v.Errors()
+}
+
+func validateType(v *basediagram.Validator, path string, t commitType) {
+	switch t {
+	case CommitTypeDefault, CommitTypeNormal, CommitTypeReverse, CommitTypeHighlight:
+	default:
+		v.Error(basediagram.CodeInvalidValue, path+".Type", "unknown commit type %q", t)
+	}
+}
+
+func validateBranch(v *basediagram.Validator, path string, branch *Branch) {
+	if strings.ContainsAny(branch.Name, "\n\"") {
+		v.Error(basediagram.CodeInvalidValue, path+".Name", "branch name %q contains a quote or a line break", branch.Name)
+	}
+
+	if branch.Order < 0 {
+		v.Warning(basediagram.CodeIgnoredValue, path+".Order", "negative order %d is ignored", branch.Order)
+	}
+}
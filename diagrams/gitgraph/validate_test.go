@@ -0,0 +1,84 @@
+package gitgraph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				d.Commit().SetID("a")
+				d.Branch("develop")
+				d.Commit().SetID("b").SetType(CommitTypeReverse)
+				d.Checkout("main")
+				d.Merge("develop")
+			},
+		},
+		{
+			name: "Commands appended directly",
+			setup: func(d *Diagram) {
+				d.Commands = append(d.Commands,
+					NewCommit(),
+					NewMerge("main"),
+					NewCheckout("develop"),
+					nil,
+					NewCherryPick(""),
+				)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Commands[1].Branch", Message: `cannot merge branch "main" into itself`},
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "Commands[2].Branch", Message: `branch "develop" does not exist`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Commands[3]", Message: "missing command"},
+				{Code: basediagram.CodeEmptyID, Severity: basediagram.SeverityError, Path: "Commands[4].ID", Message: "missing commit ID"},
+			},
+		},
+		{
+			name: "Commit ID changed after the fact",
+			setup: func(d *Diagram) {
+				d.Commit().SetID("a")
+				d.Commit().SetID("b")
+				d.Commands[1].(*Commit).SetID("a")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Commands[1].ID", Message: `commit ID "a" is already used`},
+			},
+		},
+		{
+			name: "Invalid branch and commit type",
+			setup: func(d *Diagram) {
+				d.Commit().SetType("BOLD")
+				branch, _ := d.Branch(`my "feature"`)
+				branch.SetOrder(-2)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Commands[0].Type", Message: `unknown commit type "BOLD"`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Commands[1].Name", Message: `branch name "my \"feature\"" contains a quote or a line break`},
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Commands[1].Order", Message: "negative order -2 is ignored"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package kanban
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+type cardPriority string
+
+// List of possible card priorities.
+// Reference: https://mermaid.js.org/syntax/kanban.html#supported-metadata-keys
+const (
+	PriorityNone     cardPriority = ""
+	PriorityVeryHigh cardPriority = "Very High"
+	PriorityHigh     cardPriority = "High"
+	PriorityLow      cardPriority = "Low"
+	PriorityVeryLow  cardPriority = "Very Low"
+)
+
+// Base string formats for cards
+const (
+	baseCard         string = basediagram.Indentation + basediagram.Indentation + "%s[%s]"
+	baseCardMetadata string = "@{ %s }"
+	baseCardProperty string = "%s: %s"
+
+	cardPropertyAssigned string = "assigned"
+	cardPropertyTicket   string = "ticket"
+	cardPropertyPriority string = "priority"
+)
+
+// Card represents a task on the board. The metadata fields are optional:
+// Ticket is turned into a link by the ticketBaseUrl configuration option.
+type Card struct {
+	ID       string
+	Text     string
+	Assigned string
+	Ticket   string
+	Priority cardPriority
+}
+
+// NewCard creates a new card without metadata
+func NewCard(id string, text string) *Card {
+	return &Card{
+		ID:   id,
+		Text: text,
+	}
+}
+
+// SetAssigned sets the person assigned to the card and returns the card for chaining
+func (c *Card) SetAssigned(assigned string) *Card {
+	c.Assigned = assigned
+	return c
+}
+
+// SetTicket sets the ticket number of the card and returns the card for chaining
+func (c *Card) SetTicket(ticket string) *Card {
+	c.Ticket = ticket
+	return c
+}
+
+// SetPriority sets the card priority and returns the card for chaining
+func (c *Card) SetPriority(priority cardPriority) *Card {
+	c.Priority = priority
+	return c
+}
+
+// String generates the Mermaid syntax for the card. The metadata values are
+// written as double quoted YAML strings, so that commas and braces are kept.
+func (c *Card) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf(baseCard, c.ID, basediagram.Quote(c.Text)))
+
+	var metadata []string
+	if c.Assigned != "" {
+		metadata = append(metadata, fmt.Sprintf(baseCardProperty, cardPropertyAssigned, strconv.Quote(c.Assigned)))
+	}
+	if c.Ticket != "" {
+		metadata = append(metadata, fmt.Sprintf(baseCardProperty, cardPropertyTicket, strconv.Quote(c.Ticket)))
+	}
+	if c.Priority != PriorityNone {
+		metadata = append(metadata, fmt.Sprintf(baseCardProperty, cardPropertyPriority, strconv.Quote(string(c.Priority))))
+	}
+	if len(metadata) > 0 {
+		sb.WriteString(fmt.Sprintf(baseCardMetadata, strings.Join(metadata, ", ")))
+	}
+
+	sb.WriteString("\n")
+
+	return sb.String()
+}
@@ -0,0 +1,47 @@
+package kanban
+
+import "testing"
+
+func TestCard_Setters(t *testing.T) {
+	card := NewCard("a", "Task")
+
+	if result := card.SetAssigned("knsv").SetTicket("MC-1").SetPriority(PriorityVeryLow); result != card {
+		t.Error("Setters should return card for chaining")
+	}
+
+	if card.Assigned != "knsv" || card.Ticket != "MC-1" || card.Priority != PriorityVeryLow {
+		t.Errorf("Setters = %+v, want knsv, MC-1 and Very Low", card)
+	}
+}
+
+func TestCard_String(t *testing.T) {
+	tests := []struct {
+		name string
+		card *Card
+		want string
+	}{
+		{
+			name: "Card without metadata",
+			card: NewCard("a", "Task"),
+			want: "        a[\"Task\"]\n",
+		},
+		{
+			name: "Card with priority",
+			card: NewCard("a", "Task").SetPriority(PriorityVeryHigh),
+			want: "        a[\"Task\"]@{ priority: \"Very High\" }\n",
+		},
+		{
+			name: "Card with metadata that needs quoting",
+			card: NewCard("a", "Task").SetAssigned(`Doe, "JD"`).SetTicket("#42"),
+			want: "        a[\"Task\"]@{ assigned: \"Doe, \\\"JD\\\"\", ticket: \"#42\" }\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.card.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
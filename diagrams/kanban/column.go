@@ -0,0 +1,50 @@
+package kanban
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for columns
+const (
+	baseColumn string = basediagram.Indentation + "%s[%s]\n"
+)
+
+// Column represents a column of the board, such as "Todo" or "Done".
+type Column struct {
+	ID    string
+	Title string
+	Cards []*Card
+}
+
+// NewColumn creates a new column without cards
+func NewColumn(id string, title string) *Column {
+	return &Column{
+		ID:    id,
+		Title: title,
+		Cards: make([]*Card, 0),
+	}
+}
+
+// AddCard creates and adds a new card to the column
+func (c *Column) AddCard(id string, text string) *Card {
+	card := NewCard(id, text)
+	c.Cards = append(c.Cards, card)
+	return card
+}
+
+// String generates the Mermaid syntax for the column and its cards
+func (c *Column) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf(baseColumn, c.ID, basediagram.Quote(c.Title)))
+	for _, card := range c.Cards {
+		if card != nil {
+			sb.WriteString(card.String())
+		}
+	}
+
+	return sb.String()
+}
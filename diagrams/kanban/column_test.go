@@ -0,0 +1,53 @@
+package kanban
+
+import "testing"
+
+func TestColumn_AddCard(t *testing.T) {
+	column := NewColumn("todo", "Todo")
+	card := column.AddCard("docs", "Write docs")
+
+	if len(column.Cards) != 1 || column.Cards[0] != card {
+		t.Fatalf("AddCard() cards = %v, want [%v]", column.Cards, card)
+	}
+
+	if card.ID != "docs" || card.Text != "Write docs" {
+		t.Errorf("AddCard() = %+v, want docs card", card)
+	}
+}
+
+func TestColumn_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		column *Column
+		want   string
+	}{
+		{
+			name:   "Empty column",
+			column: NewColumn("todo", "Todo"),
+			want:   "    todo[\"Todo\"]\n",
+		},
+		{
+			name: "Column with cards",
+			column: func() *Column {
+				c := NewColumn("todo", "Todo")
+				c.AddCard("a", "First")
+				c.AddCard("b", "Second")
+				return c
+			}(),
+			want: "    todo[\"Todo\"]\n        a[\"First\"]\n        b[\"Second\"]\n",
+		},
+		{
+			name:   "Column title that needs escaping",
+			column: NewColumn("todo", `Todo "now"`),
+			want:   "    todo[\"Todo #quot;now#quot;\"]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.column.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package kanban
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	kanbanConfigurationSection        string = "kanban"
+	baseKanbanConfigurationProperties string = basediagram.Indentation + kanbanConfigurationSection + ":\n"
+
+	kanbanPropertyTicketBaseURL string = "ticketBaseUrl"
+	kanbanPropertyPadding       string = "padding"
+	kanbanPropertySectionWidth  string = "sectionWidth"
+)
+
+// KanbanConfigurationProperties holds kanban-specific configuration
+type KanbanConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewKanbanConfigurationProperties() KanbanConfigurationProperties {
+	return KanbanConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *KanbanConfigurationProperties) SetTicketBaseURL(v string) *KanbanConfigurationProperties {
+	c.properties[kanbanPropertyTicketBaseURL] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: kanbanPropertyTicketBaseURL,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *KanbanConfigurationProperties) SetPadding(v int) *KanbanConfigurationProperties {
+	c.properties[kanbanPropertyPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: kanbanPropertyPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *KanbanConfigurationProperties) SetSectionWidth(v int) *KanbanConfigurationProperties {
+	c.properties[kanbanPropertySectionWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: kanbanPropertySectionWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c KanbanConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(baseKanbanConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
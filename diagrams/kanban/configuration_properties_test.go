@@ -0,0 +1,162 @@
+package kanban
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewKanbanConfigurationProperties(t *testing.T) {
+	got := NewKanbanConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewKanbanConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewKanbanConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestKanbanConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   KanbanConfigurationProperties
+		setup    func(*KanbanConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewKanbanConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewKanbanConfigurationProperties(),
+			setup: func(c *KanbanConfigurationProperties) {
+				c.SetTicketBaseURL("https://example.com/browse/#TICKET#")
+			},
+			contains: []string{
+				"kanban:",
+				"ticketBaseUrl: https://example.com/browse/#TICKET#",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewKanbanConfigurationProperties(),
+			setup: func(c *KanbanConfigurationProperties) {
+				c.SetPadding(8)
+				c.SetSectionWidth(200)
+			},
+			contains: []string{
+				"kanban:",
+				"padding: 8",
+				"sectionWidth: 200",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewKanbanConfigurationProperties(),
+			setup: func(c *KanbanConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetTicketBaseURL("https://example.com/browse/#TICKET#")
+			},
+			contains: []string{
+				"fontSize: 12",
+				"kanban:",
+				"ticketBaseUrl: https://example.com/browse/#TICKET#",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestKanbanConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*KanbanConfigurationProperties) *KanbanConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set ticket base url",
+			setup: func(c *KanbanConfigurationProperties) *KanbanConfigurationProperties {
+				return c.SetTicketBaseURL("https://example.com/browse/#TICKET#")
+			},
+			property: kanbanPropertyTicketBaseURL,
+			value:    "https://example.com/browse/#TICKET#",
+		},
+		{
+			name: "Set padding",
+			setup: func(c *KanbanConfigurationProperties) *KanbanConfigurationProperties {
+				return c.SetPadding(8)
+			},
+			property: kanbanPropertyPadding,
+			value:    8,
+		},
+		{
+			name: "Set section width",
+			setup: func(c *KanbanConfigurationProperties) *KanbanConfigurationProperties {
+				return c.SetSectionWidth(200)
+			},
+			property: kanbanPropertySectionWidth,
+			value:    200,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewKanbanConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
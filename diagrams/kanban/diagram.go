@@ -0,0 +1,70 @@
+// Package kanban provides functionality for creating Mermaid kanban boards
+package kanban
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for kanban boards
+const (
+	diagramType     string = "kanban"
+	baseDiagramType string = diagramType + "\n"
+)
+
+// Diagram represents a Mermaid kanban board: columns holding cards, each
+// card optionally carrying an assignee, a ticket and a priority. Tickets are
+// linked through the ticketBaseUrl configuration option.
+// Reference: https://mermaid.js.org/syntax/kanban.html
+type Diagram struct {
+	basediagram.BaseDiagram[KanbanConfigurationProperties]
+	Columns []*Column
+}
+
+// NewDiagram creates a new kanban board without columns
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram: basediagram.NewBaseDiagram(NewKanbanConfigurationProperties()),
+		Columns:     make([]*Column, 0),
+	}
+}
+
+// AddColumn creates and adds a new column to the board
+func (d *Diagram) AddColumn(id string, title string) *Column {
+	column := NewColumn(id, title)
+	d.Columns = append(d.Columns, column)
+	return column
+}
+
+// String generates the Mermaid syntax for the kanban board
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces a kanban board.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one column at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		for _, column := range d.Columns {
+			if column != nil {
+				w.WriteString(column.String())
+			}
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package kanban
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if diagram.Columns == nil || len(diagram.Columns) != 0 {
+		t.Errorf("NewDiagram() columns = %v, want empty slice", diagram.Columns)
+	}
+}
+
+func TestDiagram_AddColumn(t *testing.T) {
+	diagram := NewDiagram()
+	column := diagram.AddColumn("todo", "Todo")
+
+	if len(diagram.Columns) != 1 || diagram.Columns[0] != column {
+		t.Fatalf("AddColumn() columns = %v, want [%v]", diagram.Columns, column)
+	}
+
+	if column.ID != "todo" || column.Title != "Todo" {
+		t.Errorf("AddColumn() = %+v, want todo column", column)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  string
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+			want:  "kanban\n",
+		},
+		{
+			name: "Complete diagram",
+			setup: func(d *Diagram) {
+				todo := d.AddColumn("todo", "Todo")
+				todo.AddCard("docs", "Create documentation")
+				d.AddColumn("doing", "In progress").
+					AddCard("parser", "Write parser").
+					SetAssigned("knsv").
+					SetTicket("MC-2038").
+					SetPriority(PriorityHigh)
+				d.AddColumn("done", "Done")
+			},
+			want: "kanban\n" +
+				"    todo[\"Todo\"]\n" +
+				"        docs[\"Create documentation\"]\n" +
+				"    doing[\"In progress\"]\n" +
+				"        parser[\"Write parser\"]@{ assigned: \"knsv\", ticket: \"MC-2038\", priority: \"High\" }\n" +
+				"    done[\"Done\"]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.String(); !strings.HasSuffix(got, "---\n"+tt.want) {
+				t.Errorf("String() = %q, want body %q", got, tt.want)
+			}
+		})
+	}
+}
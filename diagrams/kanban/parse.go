@@ -0,0 +1,204 @@
+package kanban
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// node is a column or card as written on a line, before its indentation
+// tells which one it is.
+type node struct {
+	id       string
+	text     string
+	metadata map[string]string
+}
+
+// Parse reads Mermaid kanban syntax and returns the corresponding Diagram.
+// Columns are the least indented lines and cards the lines indented below
+// them. Both can be written as `id[Text]`, `id["Text"]` or as a single word
+// used as ID and text. Card metadata may use double, single or no quotes.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	d := NewDiagram()
+	d.Title = doc.Title
+	if err := doc.Config.Apply(&d.Config.ConfigurationProperties, kanbanConfigurationSection, d.Config.properties); err != nil {
+		return nil, err
+	}
+
+	columnOffset := -1
+	var column *Column
+	for _, line := range doc.Body() {
+		n, err := parseNode(line)
+		if err != nil {
+			return nil, err
+		}
+
+		if columnOffset < 0 {
+			columnOffset = line.Offset
+		}
+
+		switch {
+		case line.Offset < columnOffset:
+			return nil, line.Errorf(0, "unexpected indentation")
+		case line.Offset == columnOffset:
+			if n.metadata != nil {
+				return nil, line.Errorf(0, "column %q cannot have metadata", n.id)
+			}
+			column = d.AddColumn(n.id, n.text)
+		default:
+			column.AddCard(n.id, n.text).
+				SetAssigned(n.metadata[cardPropertyAssigned]).
+				SetTicket(n.metadata[cardPropertyTicket]).
+				SetPriority(cardPriority(n.metadata[cardPropertyPriority]))
+		}
+	}
+
+	return d, nil
+}
+
+// parseNode reads `id[Text]`, optionally followed by `@{ key: value, ... }`.
+func parseNode(line parser.Line) (node, error) {
+	s := parser.NewScanner(line)
+
+	n := node{
+		id: s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) && r != '[' && r != '@' }),
+	}
+	if n.id == "" {
+		return n, s.Errorf("expected ID")
+	}
+
+	n.text = n.id
+	if s.Consume("[") {
+		if s.HasPrefix(`"`) {
+			text, err := s.ReadQuoted()
+			if err != nil {
+				return n, err
+			}
+			if !s.Consume("]") {
+				return n, s.Errorf("expected ']'")
+			}
+			n.text = basediagram.Unescape(text)
+		} else {
+			text, ok := s.ReadUntil("]")
+			if !ok {
+				return n, s.Errorf("expected ']'")
+			}
+			n.text = basediagram.Unescape(text)
+		}
+	}
+
+	s.SkipSpaces()
+	if s.Consume("@{") {
+		metadata, err := parseMetadata(s)
+		if err != nil {
+			return n, err
+		}
+		n.metadata = metadata
+	}
+
+	s.SkipSpaces()
+	if !s.EOF() {
+		return n, s.Errorf("unexpected %q", s.Rest())
+	}
+
+	return n, nil
+}
+
+// parseMetadata reads the `key: value` pairs of a card up to the closing brace.
+func parseMetadata(s *parser.Scanner) (map[string]string, error) {
+	metadata := make(map[string]string)
+
+	for {
+		s.SkipSpaces()
+		if s.Consume("}") {
+			return metadata, nil
+		}
+
+		keyPos := s.Pos()
+		key := s.ReadWhile(unicode.IsLetter)
+		switch key {
+		case cardPropertyAssigned, cardPropertyTicket, cardPropertyPriority:
+		default:
+			return nil, s.ErrorAt(keyPos, "unknown metadata %q", key)
+		}
+
+		s.SkipSpaces()
+		if !s.Consume(":") {
+			return nil, s.Errorf("expected ':' after %q", key)
+		}
+		s.SkipSpaces()
+
+		value, err := readValue(s)
+		if err != nil {
+			return nil, err
+		}
+		metadata[key] = value
+
+		s.SkipSpaces()
+		if !s.Consume(",") && !s.HasPrefix("}") {
+			return nil, s.Errorf("expected ',' or '}'")
+		}
+	}
+}
+
+// readValue reads a double quoted, single quoted or plain YAML scalar.
+func readValue(s *parser.Scanner) (string, error) {
+	start := s.Pos()
+	rest := s.Rest()
+
+	switch {
+	case strings.HasPrefix(rest, `"`):
+		for i := 1; i < len(rest); i++ {
+			switch rest[i] {
+			case '\\':
+				i++
+			case '"':
+				value, err := strconv.Unquote(rest[:i+1])
+				if err != nil {
+					return "", s.ErrorAt(start, "invalid string %s", rest[:i+1])
+				}
+				s.Advance(i + 1)
+				return value, nil
+			}
+		}
+		return "", s.ErrorAt(start, "unterminated string")
+
+	case strings.HasPrefix(rest, "'"):
+		var sb strings.Builder
+		for i := 1; i < len(rest); i++ {
+			if rest[i] != '\'' {
+				sb.WriteByte(rest[i])
+				continue
+			}
+			if i+1 < len(rest) && rest[i+1] == '\'' {
+				sb.WriteByte('\'')
+				i++
+				continue
+			}
+			s.Advance(i + 1)
+			return sb.String(), nil
+		}
+		return "", s.ErrorAt(start, "unterminated string")
+	}
+
+	value := s.ReadWhile(func(r rune) bool { return r != ',' && r != '}' })
+	return strings.TrimSpace(value), nil
+}
@@ -0,0 +1,176 @@
+package kanban
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty board",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Board with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Sprint 12"
+				d.Config.SetTicketBaseURL("https://example.com/browse/#TICKET#")
+				d.EnableMarkdownFence()
+				d.AddColumn("todo", "Todo")
+			},
+		},
+		{
+			name: "Board with cards and metadata",
+			setup: func(d *Diagram) {
+				todo := d.AddColumn("todo", "Todo")
+				todo.AddCard("docs", "Write docs").SetAssigned("knsv")
+				todo.AddCard("tests", "Write tests").SetPriority(PriorityVeryHigh)
+				d.AddColumn("doing", "In progress").
+					AddCard("parser", "Write parser").
+					SetAssigned("K.Sveidqvist").
+					SetTicket("MC-2038").
+					SetPriority(PriorityLow)
+				d.AddColumn("done", "Done")
+			},
+		},
+		{
+			name: "Board with text that needs escaping",
+			setup: func(d *Diagram) {
+				d.AddColumn("todo", `Todo [now]; #1`).
+					AddCard("a", `Fix "quotes"`).
+					SetAssigned(`Doe, "JD" {lead}`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `kanban
+  Todo
+    id1[Create Documentation]
+  id7[In progress]
+    id4[Create parsing tests]@{ ticket: MC-2038, assigned: 'K.Sveidqvist', priority: 'High' }
+    id5["Quoted"]@{ assigned: "O'Brien" }
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if len(d.Columns) != 2 {
+		t.Fatalf("Parse() got %d columns, want 2", len(d.Columns))
+	}
+
+	if todo := d.Columns[0]; todo.ID != "Todo" || todo.Title != "Todo" || len(todo.Cards) != 1 || todo.Cards[0].Text != "Create Documentation" {
+		t.Errorf("Parse() first column = %+v, want Todo with one card", todo)
+	}
+
+	doing := d.Columns[1]
+	if doing.ID != "id7" || doing.Title != "In progress" || len(doing.Cards) != 2 {
+		t.Fatalf("Parse() second column = %+v, want In progress with two cards", doing)
+	}
+
+	if card := doing.Cards[0]; card.Ticket != "MC-2038" || card.Assigned != "K.Sveidqvist" || card.Priority != PriorityHigh {
+		t.Errorf("Parse() card = %+v, want MC-2038 assigned to K.Sveidqvist with high priority", card)
+	}
+
+	if card := doing.Cards[1]; card.Text != "Quoted" || card.Assigned != "O'Brien" {
+		t.Errorf("Parse() card = %+v, want Quoted assigned to O'Brien", card)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "todo[Todo]\n",
+			line:    1,
+			column:  1,
+			message: "expected kanban declaration",
+		},
+		{
+			name:    "Unterminated text",
+			input:   "kanban\n    todo[Todo\n",
+			line:    2,
+			column:  10,
+			message: "expected ']'",
+		},
+		{
+			name:    "Column less indented than the first one",
+			input:   "kanban\n    todo[Todo]\n  done[Done]\n",
+			line:    3,
+			column:  3,
+			message: "unexpected indentation",
+		},
+		{
+			name:    "Column with metadata",
+			input:   "kanban\n    todo[Todo]@{ priority: 'High' }\n",
+			line:    2,
+			column:  5,
+			message: `column "todo" cannot have metadata`,
+		},
+		{
+			name:    "Unknown metadata",
+			input:   "kanban\n    todo[Todo]\n        a[Task]@{ label: 'x' }\n",
+			line:    3,
+			column:  19,
+			message: `unknown metadata "label"`,
+		},
+		{
+			name:    "Unterminated metadata",
+			input:   "kanban\n    todo[Todo]\n        a[Task]@{ assigned: 'x'\n",
+			line:    3,
+			column:  32,
+			message: "expected ',' or '}'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package kanban
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// missing or duplicate IDs, which are shared by columns and cards, IDs that
+// are not a single word and unknown priorities.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	for i, column := range d.Columns {
+		path := fmt.Sprintf("Columns[%d]", i)
+		if column == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing column")
+			continue
+		}
+
+		validateID(&v, path+".ID", column.ID)
+
+		for j, card := range column.Cards {
+			cardPath := fmt.Sprintf("%s.Cards[%d]", path, j)
+			if card == nil {
+				v.Error(basediagram.CodeMissingReference, cardPath, "missing card")
+				continue
+			}
+
+			validateID(&v, cardPath+".ID", card.ID)
+
+			switch card.Priority {
+			case PriorityNone, PriorityVeryHigh, PriorityHigh, PriorityLow, PriorityVeryLow:
+			default:
+				v.Error(basediagram.CodeInvalidValue, cardPath+".Priority", "unknown priority %q", card.Priority)
+			}
+		}
+	}
+
+	return v.Errors()
+}
+
+func validateID(v *basediagram.Validator, path string, id string) {
+	if strings.IndexFunc(id, func(r rune) bool { return unicode.IsSpace(r) || strings.ContainsRune("[]@\"", r) }) >= 0 {
+		v.Error(basediagram.CodeInvalidValue, path, "ID %q is not a single word", id)
+	}
+
+	v.UniqueID(path, id)
+}
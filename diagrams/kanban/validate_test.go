@@ -0,0 +1,67 @@
+package kanban
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				d.AddColumn("todo", "Todo").AddCard("a", "Task").SetPriority(PriorityLow).SetTicket("MC-1")
+				d.AddColumn("done", "Done")
+			},
+		},
+		{
+			name: "Invalid IDs",
+			setup: func(d *Diagram) {
+				todo := d.AddColumn("todo", "Todo")
+				todo.AddCard("todo", "Same ID as the column")
+				todo.AddCard("", "No ID")
+				d.AddColumn("in progress", "In progress")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Columns[0].Cards[0].ID", Message: `ID "todo" is already used by Columns[0].ID`},
+				{Code: basediagram.CodeEmptyID, Severity: basediagram.SeverityError, Path: "Columns[0].Cards[1].ID", Message: "missing ID"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Columns[1].ID", Message: `ID "in progress" is not a single word`},
+			},
+		},
+		{
+			name: "Missing elements and unknown priority",
+			setup: func(d *Diagram) {
+				todo := d.AddColumn("todo", "Todo")
+				todo.AddCard("a", "Task").SetPriority("Urgent")
+				todo.Cards = append(todo.Cards, nil)
+				d.Columns = append(d.Columns, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Columns[0].Cards[0].Priority", Message: `unknown priority "Urgent"`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Columns[0].Cards[1]", Message: "missing card"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Columns[1]", Message: "missing column"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package mindmap
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	mindmapConfigurationSection        string = "mindmap"
+	baseMindmapConfigurationProperties string = basediagram.Indentation + mindmapConfigurationSection + ":\n"
+
+	mindmapPropertyPadding      string = "padding"
+	mindmapPropertyMaxNodeWidth string = "maxNodeWidth"
+	mindmapPropertyUseMaxWidth  string = "useMaxWidth"
+)
+
+// MindmapConfigurationProperties holds mindmap-specific configuration
+type MindmapConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewMindmapConfigurationProperties() MindmapConfigurationProperties {
+	return MindmapConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *MindmapConfigurationProperties) SetPadding(v int) *MindmapConfigurationProperties {
+	c.properties[mindmapPropertyPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: mindmapPropertyPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *MindmapConfigurationProperties) SetMaxNodeWidth(v int) *MindmapConfigurationProperties {
+	c.properties[mindmapPropertyMaxNodeWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: mindmapPropertyMaxNodeWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *MindmapConfigurationProperties) SetUseMaxWidth(v bool) *MindmapConfigurationProperties {
+	c.properties[mindmapPropertyUseMaxWidth] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: mindmapPropertyUseMaxWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c MindmapConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(baseMindmapConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,162 @@
+package mindmap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewMindmapConfigurationProperties(t *testing.T) {
+	got := NewMindmapConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewMindmapConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewMindmapConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestMindmapConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   MindmapConfigurationProperties
+		setup    func(*MindmapConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewMindmapConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewMindmapConfigurationProperties(),
+			setup: func(c *MindmapConfigurationProperties) {
+				c.SetPadding(10)
+			},
+			contains: []string{
+				"mindmap:",
+				"padding: 10",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewMindmapConfigurationProperties(),
+			setup: func(c *MindmapConfigurationProperties) {
+				c.SetMaxNodeWidth(200)
+				c.SetUseMaxWidth(false)
+			},
+			contains: []string{
+				"mindmap:",
+				"maxNodeWidth: 200",
+				"useMaxWidth: false",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewMindmapConfigurationProperties(),
+			setup: func(c *MindmapConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetPadding(10)
+			},
+			contains: []string{
+				"fontSize: 12",
+				"mindmap:",
+				"padding: 10",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestMindmapConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*MindmapConfigurationProperties) *MindmapConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set padding",
+			setup: func(c *MindmapConfigurationProperties) *MindmapConfigurationProperties {
+				return c.SetPadding(10)
+			},
+			property: mindmapPropertyPadding,
+			value:    10,
+		},
+		{
+			name: "Set max node width",
+			setup: func(c *MindmapConfigurationProperties) *MindmapConfigurationProperties {
+				return c.SetMaxNodeWidth(200)
+			},
+			property: mindmapPropertyMaxNodeWidth,
+			value:    200,
+		},
+		{
+			name: "Set use max width",
+			setup: func(c *MindmapConfigurationProperties) *MindmapConfigurationProperties {
+				return c.SetUseMaxWidth(false)
+			},
+			property: mindmapPropertyUseMaxWidth,
+			value:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewMindmapConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Package mindmap provides functionality for creating Mermaid mindmaps
+package mindmap
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for mindmaps
+const (
+	diagramType     string = "mindmap"
+	baseDiagramType string = diagramType + "\n"
+)
+
+// Diagram represents a Mermaid mindmap, a tree of nodes growing from a single root.
+// The hierarchy is rendered through the indentation of the nodes.
+// Reference: https://mermaid.js.org/syntax/mindmap.html
+type Diagram struct {
+	basediagram.BaseDiagram[MindmapConfigurationProperties]
+	Root *Node
+}
+
+// NewDiagram creates a new mindmap without root
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram: basediagram.NewBaseDiagram(NewMindmapConfigurationProperties()),
+	}
+}
+
+// SetRoot creates the root node of the mindmap, replacing any previous root, and returns it
+func (d *Diagram) SetRoot(text string) *Node {
+	d.Root = NewNode(text)
+	return d.Root
+}
+
+// String generates the Mermaid syntax for the mindmap
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces a mindmap.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one node at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		if d.Root != nil {
+			d.Root.WriteTo(w)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package mindmap
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if diagram.Root != nil {
+		t.Error("NewDiagram() should create a mindmap without root")
+	}
+}
+
+func TestDiagram_SetRoot(t *testing.T) {
+	diagram := NewDiagram()
+	diagram.SetRoot("First")
+	root := diagram.SetRoot("Second")
+
+	if diagram.Root != root || root.Text != "Second" {
+		t.Errorf("SetRoot() root = %+v, want node Second", diagram.Root)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func() *Diagram
+		want  string
+	}{
+		{
+			name: "Empty diagram",
+			setup: func() *Diagram {
+				return NewDiagram()
+			},
+			want: "mindmap\n",
+		},
+		{
+			name: "Complete diagram",
+			setup: func() *Diagram {
+				d := NewDiagram()
+
+				root := d.SetRoot("mindmap").SetID("root").SetShape(NodeShapeCircle)
+				origins := root.AddChild("Origins")
+				origins.AddChild("Long history").SetIcon("fa fa-book")
+				origins.AddChild("Popularisation").AddChild("British popular psychology author Tony Buzan")
+				root.AddChild("Tools").AddClass("urgent").AddClass("large")
+
+				return d
+			},
+			want: "mindmap\n" +
+				"    root((\"mindmap\"))\n" +
+				"        Origins\n" +
+				"            Long history\n" +
+				"            ::icon(fa fa-book)\n" +
+				"            Popularisation\n" +
+				"                British popular psychology author Tony Buzan\n" +
+				"        Tools\n" +
+				"        :::urgent large\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagram := tt.setup()
+			result := diagram.String()
+
+			if !strings.HasSuffix(result, "---\n"+tt.want) {
+				t.Errorf("String() = %q, want body %q", result, tt.want)
+			}
+		})
+	}
+}
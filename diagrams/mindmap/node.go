@@ -0,0 +1,128 @@
+package mindmap
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+type nodeShape string
+
+// List of possible Node shapes.
+// Reference: https://mermaid.js.org/syntax/mindmap.html#different-shapes
+const (
+	NodeShapeDefault nodeShape = "%s"
+	NodeShapeSquare  nodeShape = "[%s]"
+	NodeShapeRounded nodeShape = "(%s)"
+	NodeShapeCircle  nodeShape = "((%s))"
+	NodeShapeBang    nodeShape = "))%s(("
+	NodeShapeCloud   nodeShape = ")%s("
+	NodeShapeHexagon nodeShape = "{{%s}}"
+)
+
+// Base string formats for mindmap nodes
+const (
+	baseNode        string = basediagram.Indentation + "%s%s\n"
+	baseNodeIcon    string = basediagram.Indentation + "::icon(%s)\n"
+	baseNodeClasses string = basediagram.Indentation + ":::%s\n"
+)
+
+// Node represents a node of a mindmap and the subtree growing from it.
+// The ID is only rendered for nodes with a shape, as Mermaid reads the
+// text of a node without shape as its ID.
+type Node struct {
+	ID       string
+	Text     string
+	Shape    nodeShape
+	Icon     string
+	Classes  []string
+	Children []*Node
+}
+
+// NewNode creates a new Node with the given text and no shape
+func NewNode(text string) *Node {
+	return &Node{
+		Text:     text,
+		Shape:    NodeShapeDefault,
+		Classes:  make([]string, 0),
+		Children: make([]*Node, 0),
+	}
+}
+
+// AddChild creates and adds a new child node and returns it
+func (n *Node) AddChild(text string) *Node {
+	child := NewNode(text)
+	n.Children = append(n.Children, child)
+	return child
+}
+
+// SetID sets the node ID and returns the node for chaining
+func (n *Node) SetID(id string) *Node {
+	n.ID = id
+	return n
+}
+
+// SetText sets the node text and returns the node for chaining
+func (n *Node) SetText(text string) *Node {
+	n.Text = text
+	return n
+}
+
+// SetShape sets the node shape and returns the node for chaining
+func (n *Node) SetShape(shape nodeShape) *Node {
+	n.Shape = shape
+	return n
+}
+
+// SetIcon sets the icon classes of the node, e.g. "fa fa-book", and returns the node for chaining
+func (n *Node) SetIcon(icon string) *Node {
+	n.Icon = icon
+	return n
+}
+
+// AddClass adds a CSS class to the node and returns the node for chaining
+func (n *Node) AddClass(name string) *Node {
+	n.Classes = append(n.Classes, name)
+	return n
+}
+
+// String generates a Mermaid-formatted string representation of the node
+// and its descendants with custom indentation.
+func (n *Node) String(curIndentation string) string {
+	var sb strings.Builder
+	n.writeTo(basediagram.NewWriter(&sb), curIndentation)
+	return sb.String()
+}
+
+// WriteTo writes the Mermaid representation of the node and its descendants
+// to w, indented as the root of a mindmap.
+func (n *Node) WriteTo(w io.Writer) (int64, error) {
+	cw := basediagram.NewWriter(w)
+	n.writeTo(cw, "")
+	return cw.Result()
+}
+
+// writeTo writes the node to w with the specified indentation, followed by
+// its decorations and, one level deeper, its children.
+func (n *Node) writeTo(w *basediagram.Writer, curIndentation string) {
+	if n.Shape == NodeShapeDefault || n.Shape == "" {
+		w.Printf("%s%s", curIndentation, fmt.Sprintf(baseNode, "", basediagram.Escape(n.Text)))
+	} else {
+		w.Printf("%s%s", curIndentation, fmt.Sprintf(baseNode, n.ID, fmt.Sprintf(string(n.Shape), basediagram.Quote(n.Text))))
+	}
+
+	if n.Icon != "" {
+		w.Printf("%s%s", curIndentation, fmt.Sprintf(baseNodeIcon, n.Icon))
+	}
+
+	if len(n.Classes) > 0 {
+		w.Printf("%s%s", curIndentation, fmt.Sprintf(baseNodeClasses, strings.Join(n.Classes, " ")))
+	}
+
+	nextIndentation := curIndentation + basediagram.Indentation
+	for _, child := range n.Children {
+		child.writeTo(w, nextIndentation)
+	}
+}
@@ -0,0 +1,148 @@
+package mindmap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewNode(t *testing.T) {
+	got := NewNode("Origins")
+	want := &Node{
+		Text:     "Origins",
+		Shape:    NodeShapeDefault,
+		Classes:  make([]string, 0),
+		Children: make([]*Node, 0),
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewNode() = %+v, want %+v", got, want)
+	}
+}
+
+func TestNode_AddChild(t *testing.T) {
+	root := NewNode("Root")
+	child := root.AddChild("Child")
+
+	if len(root.Children) != 1 || root.Children[0] != child {
+		t.Fatalf("AddChild() children = %v, want [%v]", root.Children, child)
+	}
+
+	if child.Text != "Child" || child.Shape != NodeShapeDefault {
+		t.Errorf("AddChild() = %+v, want Child without shape", child)
+	}
+}
+
+func TestNode_Setters(t *testing.T) {
+	node := NewNode("Old")
+
+	result := node.SetID("a").SetText("New").SetShape(NodeShapeCloud).SetIcon("fa fa-cloud").AddClass("urgent").AddClass("large")
+	if result != node {
+		t.Error("Setters should return node for chaining")
+	}
+
+	if node.ID != "a" || node.Text != "New" || node.Shape != NodeShapeCloud || node.Icon != "fa fa-cloud" {
+		t.Errorf("Setters = %+v, want a, New, cloud and fa fa-cloud", node)
+	}
+
+	if !reflect.DeepEqual(node.Classes, []string{"urgent", "large"}) {
+		t.Errorf("AddClass() classes = %v, want [urgent large]", node.Classes)
+	}
+}
+
+func TestNode_String(t *testing.T) {
+	tests := []struct {
+		name        string
+		node        *Node
+		indentation string
+		want        string
+	}{
+		{
+			name: "Node without shape",
+			node: NewNode("Long history"),
+			want: "    Long history\n",
+		},
+		{
+			name: "Node without shape ignores the ID",
+			node: NewNode("Long history").SetID("a"),
+			want: "    Long history\n",
+		},
+		{
+			name: "Square node",
+			node: NewNode("Square").SetID("a").SetShape(NodeShapeSquare),
+			want: "    a[\"Square\"]\n",
+		},
+		{
+			name: "Rounded node",
+			node: NewNode("Rounded").SetID("a").SetShape(NodeShapeRounded),
+			want: "    a(\"Rounded\")\n",
+		},
+		{
+			name: "Circle node",
+			node: NewNode("Circle").SetID("a").SetShape(NodeShapeCircle),
+			want: "    a((\"Circle\"))\n",
+		},
+		{
+			name: "Bang node",
+			node: NewNode("Bang").SetID("a").SetShape(NodeShapeBang),
+			want: "    a))\"Bang\"((\n",
+		},
+		{
+			name: "Cloud node",
+			node: NewNode("Cloud").SetID("a").SetShape(NodeShapeCloud),
+			want: "    a)\"Cloud\"(\n",
+		},
+		{
+			name: "Hexagon node without ID",
+			node: NewNode("Hexagon").SetShape(NodeShapeHexagon),
+			want: "    {{\"Hexagon\"}}\n",
+		},
+		{
+			name: "Node with decorations",
+			node: NewNode("Tools").SetIcon("mdi mdi-tools").AddClass("urgent"),
+			want: "    Tools\n    ::icon(mdi mdi-tools)\n    :::urgent\n",
+		},
+		{
+			name: "Node with children and custom indentation",
+			node: func() *Node {
+				n := NewNode("Parent")
+				n.AddChild("Child").AddChild("Grandchild")
+				return n
+			}(),
+			indentation: "\t",
+			want:        "\t    Parent\n\t        Child\n\t            Grandchild\n",
+		},
+		{
+			name: "Text that needs escaping",
+			node: NewNode(`Say "hi"`).SetID("a").SetShape(NodeShapeSquare),
+			want: "    a[\"Say #quot;hi#quot;\"]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.node.String(tt.indentation); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNode_WriteTo(t *testing.T) {
+	node := NewNode("Root").SetShape(NodeShapeCircle)
+	node.AddChild("Child").SetIcon("fa fa-book").AddChild("Grandchild")
+
+	var sb strings.Builder
+	n, err := node.WriteTo(&sb)
+	if err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+
+	want := node.String("")
+	if sb.String() != want {
+		t.Errorf("WriteTo() = %q, want %q", sb.String(), want)
+	}
+	if n != int64(len(want)) {
+		t.Errorf("WriteTo() wrote %d bytes, want %d", n, len(want))
+	}
+}
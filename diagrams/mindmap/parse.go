@@ -0,0 +1,156 @@
+package mindmap
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// shapeDelimiters lists the opening and closing delimiters of the node
+// shapes, longest first so that "((" is not read as "(".
+var shapeDelimiters = []struct {
+	open  string
+	close string
+	shape nodeShape
+}{
+	{"((", "))", NodeShapeCircle},
+	{"))", "((", NodeShapeBang},
+	{"{{", "}}", NodeShapeHexagon},
+	{"[", "]", NodeShapeSquare},
+	{"(", ")", NodeShapeRounded},
+	{")", "(", NodeShapeCloud},
+}
+
+// level is a node on the path from the root to the last parsed node,
+// with the indentation it was declared at.
+type level struct {
+	node   *Node
+	offset int
+}
+
+type mindmapParser struct {
+	diagram *Diagram
+	path    []level
+}
+
+// Parse reads Mermaid mindmap syntax and returns the corresponding Diagram.
+// The hierarchy is read from the indentation of the nodes: a node is a child
+// of the closest node above it that is less indented. The `::icon()` and
+// `:::class` decorations apply to the node declared before them.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &mindmapParser{diagram: NewDiagram()}
+	p.diagram.Title = doc.Title
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, mindmapConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.diagram, nil
+}
+
+func (p *mindmapParser) parseLine(line parser.Line) error {
+	if strings.HasPrefix(line.Text, "::") {
+		return p.parseDecoration(line)
+	}
+
+	node, err := parseNode(line)
+	if err != nil {
+		return err
+	}
+
+	for len(p.path) > 0 && p.path[len(p.path)-1].offset >= line.Offset {
+		p.path = p.path[:len(p.path)-1]
+	}
+
+	if len(p.path) == 0 {
+		if p.diagram.Root != nil {
+			return line.Errorf(0, "mindmap has more than one root")
+		}
+		p.diagram.Root = node
+	} else {
+		parent := p.path[len(p.path)-1].node
+		parent.Children = append(parent.Children, node)
+	}
+
+	p.path = append(p.path, level{node: node, offset: line.Offset})
+
+	return nil
+}
+
+// parseDecoration reads `::icon(classes)` or `:::class class`.
+func (p *mindmapParser) parseDecoration(line parser.Line) error {
+	if len(p.path) == 0 {
+		return line.Errorf(0, "decoration without node")
+	}
+	node := p.path[len(p.path)-1].node
+
+	if classes, ok := strings.CutPrefix(line.Text, ":::"); ok {
+		node.Classes = append(node.Classes, strings.Fields(classes)...)
+		return nil
+	}
+
+	icon, ok := strings.CutPrefix(line.Text, "::icon(")
+	if !ok || !strings.HasSuffix(icon, ")") {
+		return line.Errorf(0, "expected ::icon() or ::: decoration")
+	}
+	node.Icon = strings.TrimSuffix(icon, ")")
+
+	return nil
+}
+
+// parseNode reads `text` or `id` followed by a shape holding the text.
+func parseNode(line parser.Line) (*Node, error) {
+	s := parser.NewScanner(line)
+	id := s.ReadWhile(func(r rune) bool {
+		return !strings.ContainsRune(shapeCharacters, r)
+	})
+
+	if s.EOF() {
+		return NewNode(basediagram.Unescape(strings.TrimSpace(id))), nil
+	}
+
+	for _, delimiter := range shapeDelimiters {
+		if !s.HasPrefix(delimiter.open) {
+			continue
+		}
+
+		start := s.Pos()
+		rest := s.Rest()
+		if len(rest) < len(delimiter.open)+len(delimiter.close) || !strings.HasSuffix(rest, delimiter.close) {
+			return nil, s.ErrorAt(start, "expected %q at the end of the node", delimiter.close)
+		}
+
+		text := rest[len(delimiter.open) : len(rest)-len(delimiter.close)]
+		if len(text) >= 2 && strings.HasPrefix(text, `"`) && strings.HasSuffix(text, `"`) {
+			text = text[1 : len(text)-1]
+		}
+
+		node := NewNode(basediagram.Unescape(strings.TrimSpace(text)))
+		node.ID = strings.TrimSpace(id)
+		node.Shape = delimiter.shape
+		return node, nil
+	}
+
+	return nil, s.Errorf("unexpected %q", s.Rest())
+}
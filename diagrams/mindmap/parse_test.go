@@ -0,0 +1,174 @@
+package mindmap
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty mindmap",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Mindmap with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Ideas"
+				d.Config.SetPadding(12)
+				d.EnableMarkdownFence()
+				d.SetRoot("Root")
+			},
+		},
+		{
+			name: "Mindmap with every shape",
+			setup: func(d *Diagram) {
+				root := d.SetRoot("Shapes").SetID("root").SetShape(NodeShapeCircle)
+				root.AddChild("Square").SetID("a").SetShape(NodeShapeSquare)
+				root.AddChild("Rounded").SetID("b").SetShape(NodeShapeRounded)
+				root.AddChild("Bang").SetID("c").SetShape(NodeShapeBang)
+				root.AddChild("Cloud").SetID("d").SetShape(NodeShapeCloud)
+				root.AddChild("Hexagon").SetShape(NodeShapeHexagon)
+			},
+		},
+		{
+			name: "Mindmap with nesting and decorations",
+			setup: func(d *Diagram) {
+				root := d.SetRoot("mindmap")
+				origins := root.AddChild("Origins").SetIcon("fa fa-book")
+				origins.AddChild("Long history").AddChild("Ancient").AddClass("old").AddClass("faded")
+				origins.AddChild("Popularisation")
+				root.AddChild("Research").AddChild("On effectiveness")
+			},
+		},
+		{
+			name: "Mindmap with text that needs escaping",
+			setup: func(d *Diagram) {
+				d.SetRoot(`Say "hi" (f(x)); #1`).SetShape(NodeShapeSquare).AddChild("Issue #2")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `mindmap
+  root((mindmap))
+    Origins
+      Long history
+      ::icon(fa fa-book)
+    Tools
+      id[Pen and paper]
+      :::urgent
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	root := d.Root
+	if root == nil || root.ID != "root" || root.Text != "mindmap" || root.Shape != NodeShapeCircle {
+		t.Fatalf("Parse() root = %+v, want root((mindmap))", root)
+	}
+
+	if len(root.Children) != 2 || root.Children[0].Text != "Origins" || root.Children[1].Text != "Tools" {
+		t.Fatalf("Parse() root children = %+v, want Origins and Tools", root.Children)
+	}
+
+	history := root.Children[0].Children[0]
+	if history.Text != "Long history" || history.Icon != "fa fa-book" {
+		t.Errorf("Parse() history = %+v, want Long history with a book icon", history)
+	}
+
+	pen := root.Children[1].Children[0]
+	if pen.ID != "id" || pen.Text != "Pen and paper" || pen.Shape != NodeShapeSquare || len(pen.Classes) != 1 || pen.Classes[0] != "urgent" {
+		t.Errorf("Parse() pen = %+v, want urgent square Pen and paper", pen)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "root\n",
+			line:    1,
+			column:  1,
+			message: "expected mindmap declaration",
+		},
+		{
+			name:    "Second root",
+			input:   "mindmap\n    A\n        B\n    C\n",
+			line:    4,
+			column:  5,
+			message: "mindmap has more than one root",
+		},
+		{
+			name:    "Unterminated shape",
+			input:   "mindmap\n    a[Square\n",
+			line:    2,
+			column:  6,
+			message: `expected "]" at the end of the node`,
+		},
+		{
+			name:    "Decoration without node",
+			input:   "mindmap\n    ::icon(fa fa-book)\n",
+			line:    2,
+			column:  5,
+			message: "decoration without node",
+		},
+		{
+			name:    "Unknown decoration",
+			input:   "mindmap\n    A\n    ::color(red)\n",
+			line:    3,
+			column:  5,
+			message: "expected ::icon() or ::: decoration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package mindmap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// shapeCharacters are the characters that make Mermaid read the text of a
+// node without shape as an ID followed by a shape.
+const shapeCharacters = "[](){}"
+
+// Validate checks the mindmap for problems that String would render silently:
+// nodes without text, text of nodes without shape that Mermaid would read
+// as a shape, class names containing spaces, and IDs of nodes without shape,
+// which are not rendered.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	if d.Root != nil {
+		validateNode(&v, "Root", d.Root)
+	}
+
+	return v.Errors()
+}
+
+func validateNode(v *basediagram.Validator, path string, node *Node) {
+	defaultShape := node.Shape == NodeShapeDefault || node.Shape == ""
+
+	switch {
+	case node.Text == "":
+		v.Error(basediagram.CodeInvalidValue, path+".Text", "node has no text")
+	case defaultShape && (strings.ContainsAny(node.Text, shapeCharacters) || strings.HasPrefix(node.Text, "::")):
+		v.Error(basediagram.CodeInvalidValue, path+".Text", "text %q of a node without shape is read as a shape or decoration", node.Text)
+	}
+
+	if defaultShape && node.ID != "" {
+		v.Warning(basediagram.CodeIgnoredValue, path+".ID", "ID %q is ignored for a node without shape", node.ID)
+	}
+
+	for i, class := range node.Classes {
+		if class == "" || strings.ContainsAny(class, " \t\n") {
+			v.Error(basediagram.CodeInvalidValue, fmt.Sprintf("%s.Classes[%d]", path, i), "class name %q is not a single word", class)
+		}
+	}
+
+	for i, child := range node.Children {
+		if child == nil {
+			v.Error(basediagram.CodeMissingReference, fmt.Sprintf("%s.Children[%d]", path, i), "missing node")
+			continue
+		}
+		validateNode(v, fmt.Sprintf("%s.Children[%d]", path, i), child)
+	}
+}
@@ -0,0 +1,65 @@
+package mindmap
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				root := d.SetRoot("Root (main)").SetID("root").SetShape(NodeShapeCircle)
+				root.AddChild("Child").SetIcon("fa fa-book").AddClass("urgent")
+			},
+		},
+		{
+			name: "Invalid texts",
+			setup: func(d *Diagram) {
+				root := d.SetRoot("")
+				root.AddChild("Call f(x)")
+				root.AddChild("::icon")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Root.Text", Message: "node has no text"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Root.Children[0].Text", Message: `text "Call f(x)" of a node without shape is read as a shape or decoration`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Root.Children[1].Text", Message: `text "::icon" of a node without shape is read as a shape or decoration`},
+			},
+		},
+		{
+			name: "Ignored ID, invalid class and missing child",
+			setup: func(d *Diagram) {
+				root := d.SetRoot("Root")
+				root.AddChild("Child").SetID("c").AddClass("two words")
+				root.Children = append(root.Children, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Root.Children[0].ID", Message: `ID "c" is ignored for a node without shape`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Root.Children[0].Classes[0]", Message: `class name "two words" is not a single word`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Root.Children[1]", Message: "missing node"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
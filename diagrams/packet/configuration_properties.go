@@ -0,0 +1,115 @@
+package packet
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	packetConfigurationSection        string = "packet"
+	basePacketConfigurationProperties string = basediagram.Indentation + packetConfigurationSection + ":\n"
+
+	packetPropertyBitsPerRow  string = "bitsPerRow"
+	packetPropertyBitWidth    string = "bitWidth"
+	packetPropertyRowHeight   string = "rowHeight"
+	packetPropertyShowBits    string = "showBits"
+	packetPropertyPaddingX    string = "paddingX"
+	packetPropertyPaddingY    string = "paddingY"
+	packetPropertyUseMaxWidth string = "useMaxWidth"
+)
+
+// PacketConfigurationProperties holds packet-specific configuration
+type PacketConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewPacketConfigurationProperties() PacketConfigurationProperties {
+	return PacketConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *PacketConfigurationProperties) SetBitsPerRow(v int) *PacketConfigurationProperties {
+	c.properties[packetPropertyBitsPerRow] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: packetPropertyBitsPerRow,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *PacketConfigurationProperties) SetBitWidth(v int) *PacketConfigurationProperties {
+	c.properties[packetPropertyBitWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: packetPropertyBitWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *PacketConfigurationProperties) SetRowHeight(v int) *PacketConfigurationProperties {
+	c.properties[packetPropertyRowHeight] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: packetPropertyRowHeight,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *PacketConfigurationProperties) SetShowBits(v bool) *PacketConfigurationProperties {
+	c.properties[packetPropertyShowBits] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: packetPropertyShowBits,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *PacketConfigurationProperties) SetPaddingX(v int) *PacketConfigurationProperties {
+	c.properties[packetPropertyPaddingX] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: packetPropertyPaddingX,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *PacketConfigurationProperties) SetPaddingY(v int) *PacketConfigurationProperties {
+	c.properties[packetPropertyPaddingY] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: packetPropertyPaddingY,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *PacketConfigurationProperties) SetUseMaxWidth(v bool) *PacketConfigurationProperties {
+	c.properties[packetPropertyUseMaxWidth] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: packetPropertyUseMaxWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c PacketConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(basePacketConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
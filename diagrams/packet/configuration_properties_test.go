@@ -0,0 +1,194 @@
+package packet
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewPacketConfigurationProperties(t *testing.T) {
+	got := NewPacketConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewPacketConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewPacketConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestPacketConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   PacketConfigurationProperties
+		setup    func(*PacketConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewPacketConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewPacketConfigurationProperties(),
+			setup: func(c *PacketConfigurationProperties) {
+				c.SetBitsPerRow(32)
+			},
+			contains: []string{
+				"packet:",
+				"bitsPerRow: 32",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewPacketConfigurationProperties(),
+			setup: func(c *PacketConfigurationProperties) {
+				c.SetBitWidth(32)
+				c.SetUseMaxWidth(true)
+			},
+			contains: []string{
+				"packet:",
+				"bitWidth: 32",
+				"useMaxWidth: true",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewPacketConfigurationProperties(),
+			setup: func(c *PacketConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetBitsPerRow(32)
+			},
+			contains: []string{
+				"fontSize: 12",
+				"packet:",
+				"bitsPerRow: 32",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestPacketConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*PacketConfigurationProperties) *PacketConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set bits per row",
+			setup: func(c *PacketConfigurationProperties) *PacketConfigurationProperties {
+				return c.SetBitsPerRow(32)
+			},
+			property: packetPropertyBitsPerRow,
+			value:    32,
+		},
+		{
+			name: "Set bit width",
+			setup: func(c *PacketConfigurationProperties) *PacketConfigurationProperties {
+				return c.SetBitWidth(32)
+			},
+			property: packetPropertyBitWidth,
+			value:    32,
+		},
+		{
+			name: "Set row height",
+			setup: func(c *PacketConfigurationProperties) *PacketConfigurationProperties {
+				return c.SetRowHeight(32)
+			},
+			property: packetPropertyRowHeight,
+			value:    32,
+		},
+		{
+			name: "Set show bits",
+			setup: func(c *PacketConfigurationProperties) *PacketConfigurationProperties {
+				return c.SetShowBits(true)
+			},
+			property: packetPropertyShowBits,
+			value:    true,
+		},
+		{
+			name: "Set padding x",
+			setup: func(c *PacketConfigurationProperties) *PacketConfigurationProperties {
+				return c.SetPaddingX(5)
+			},
+			property: packetPropertyPaddingX,
+			value:    5,
+		},
+		{
+			name: "Set padding y",
+			setup: func(c *PacketConfigurationProperties) *PacketConfigurationProperties {
+				return c.SetPaddingY(5)
+			},
+			property: packetPropertyPaddingY,
+			value:    5,
+		},
+		{
+			name: "Set use max width",
+			setup: func(c *PacketConfigurationProperties) *PacketConfigurationProperties {
+				return c.SetUseMaxWidth(true)
+			},
+			property: packetPropertyUseMaxWidth,
+			value:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewPacketConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Package packet provides functionality for creating Mermaid packet diagrams
+package packet
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for packet diagrams
+const (
+	diagramType     string = "packet-beta"
+	baseDiagramType string = diagramType + "\n"
+)
+
+// defaultBitsPerRow is the number of bits Mermaid draws per row unless
+// configured otherwise.
+const defaultBitsPerRow int = 32
+
+// Diagram represents a Mermaid packet diagram, the layout of the fields of
+// a binary header drawn as rows of bits.
+// Reference: https://mermaid.js.org/syntax/packet.html
+type Diagram struct {
+	basediagram.BaseDiagram[PacketConfigurationProperties]
+	Fields []*Field
+}
+
+// NewDiagram creates a new packet diagram
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram: basediagram.NewBaseDiagram(NewPacketConfigurationProperties()),
+		Fields:      make([]*Field, 0),
+	}
+}
+
+// AddField creates and adds a new field of the given width in bits, starting
+// right after the last field
+func (d *Diagram) AddField(bits int, label string) *Field {
+	start := d.NextBit()
+	return d.AddFieldRange(start, start+bits-1, label)
+}
+
+// AddFieldRange creates and adds a new field covering the bits from start to end included
+func (d *Diagram) AddFieldRange(start int, end int, label string) *Field {
+	field := NewField(start, end, label)
+	d.Fields = append(d.Fields, field)
+	return field
+}
+
+// NextBit returns the bit following the last field, where AddField starts
+// the next one.
+func (d *Diagram) NextBit() int {
+	for i := len(d.Fields) - 1; i >= 0; i-- {
+		if d.Fields[i] != nil {
+			return d.Fields[i].End + 1
+		}
+	}
+
+	return 0
+}
+
+// String generates the Mermaid syntax for the packet diagram
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces a packet diagram.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one element at a time. Fields that
+// cross the end of a row are written as one range per row, so that each
+// row of the rendered diagram holds exactly the configured bits per row.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	bitsPerRow := d.bitsPerRow()
+
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		for _, field := range d.Fields {
+			if field == nil {
+				continue
+			}
+
+			if field.Start < 0 || field.End < field.Start {
+				w.WriteString(field.String())
+				continue
+			}
+
+			for start := field.Start; start <= field.End; {
+				end := (start/bitsPerRow+1)*bitsPerRow - 1
+				if end > field.End {
+					end = field.End
+				}
+				w.WriteString(formatRange(start, end, field.Label))
+				start = end + 1
+			}
+		}
+	})
+}
+
+// bitsPerRow returns the configured number of bits per row, or the Mermaid
+// default when it is unset or not positive.
+func (d *Diagram) bitsPerRow() int {
+	if property, ok := d.Config.properties[packetPropertyBitsPerRow].(*basediagram.IntProperty); ok {
+		if bits, ok := property.Val.(int); ok && bits > 0 {
+			return bits
+		}
+	}
+
+	return defaultBitsPerRow
+}
@@ -0,0 +1,125 @@
+package packet
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if len(diagram.Fields) != 0 {
+		t.Error("NewDiagram() should create empty fields slice")
+	}
+}
+
+func TestDiagram_AddField(t *testing.T) {
+	diagram := NewDiagram()
+	source := diagram.AddField(16, "Source Port")
+	destination := diagram.AddField(16, "Destination Port")
+	diagram.Fields = append(diagram.Fields, nil)
+	flag := diagram.AddField(1, "URG")
+
+	if len(diagram.Fields) != 4 || diagram.Fields[0] != source || diagram.Fields[1] != destination || diagram.Fields[3] != flag {
+		t.Fatalf("AddField() fields = %v, want [%v %v <nil> %v]", diagram.Fields, source, destination, flag)
+	}
+
+	if source.Start != 0 || source.End != 15 || source.Label != "Source Port" {
+		t.Errorf("AddField() = %+v, want bits 0-15", source)
+	}
+	if destination.Start != 16 || destination.End != 31 {
+		t.Errorf("AddField() = %+v, want bits 16-31", destination)
+	}
+	if flag.Start != 32 || flag.End != 32 {
+		t.Errorf("AddField() = %+v, want bit 32", flag)
+	}
+}
+
+func TestDiagram_AddFieldRange(t *testing.T) {
+	diagram := NewDiagram()
+	field := diagram.AddFieldRange(8, 15, "Flags")
+
+	if len(diagram.Fields) != 1 || diagram.Fields[0] != field {
+		t.Fatalf("AddFieldRange() fields = %v, want [%v]", diagram.Fields, field)
+	}
+
+	if field.Start != 8 || field.End != 15 || field.Label != "Flags" {
+		t.Errorf("AddFieldRange() = %+v, want bits 8-15", field)
+	}
+
+	if got := diagram.NextBit(); got != 16 {
+		t.Errorf("NextBit() = %d, want 16", got)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func() *Diagram
+		want  string
+	}{
+		{
+			name: "Empty diagram",
+			setup: func() *Diagram {
+				return NewDiagram()
+			},
+			want: "packet-beta\n",
+		},
+		{
+			name: "Fields within rows",
+			setup: func() *Diagram {
+				d := NewDiagram()
+				d.SetTitle("UDP Packet")
+				d.AddField(16, "Source Port")
+				d.AddField(16, "Destination Port")
+				d.Fields = append(d.Fields, nil)
+				d.AddField(1, `"Urgent" flag`)
+				return d
+			},
+			want: "packet-beta\n" +
+				"    0-15: \"Source Port\"\n" +
+				"    16-31: \"Destination Port\"\n" +
+				"    32: \"#quot;Urgent#quot; flag\"\n",
+		},
+		{
+			name: "Fields wrapped at the default bits per row",
+			setup: func() *Diagram {
+				d := NewDiagram()
+				d.AddField(24, "Header")
+				d.AddField(80, "Address")
+				return d
+			},
+			want: "packet-beta\n" +
+				"    0-23: \"Header\"\n" +
+				"    24-31: \"Address\"\n" +
+				"    32-63: \"Address\"\n" +
+				"    64-95: \"Address\"\n" +
+				"    96-103: \"Address\"\n",
+		},
+		{
+			name: "Fields wrapped at the configured bits per row",
+			setup: func() *Diagram {
+				d := NewDiagram()
+				d.Config.SetBitsPerRow(16)
+				d.AddField(8, "Type")
+				d.AddField(9, "Length")
+				d.AddFieldRange(40, 30, "Invalid")
+				return d
+			},
+			want: "packet-beta\n" +
+				"    0-7: \"Type\"\n" +
+				"    8-15: \"Length\"\n" +
+				"    16: \"Length\"\n" +
+				"    40-30: \"Invalid\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.setup().String()
+			if !strings.HasSuffix(got, "---\n"+tt.want) {
+				t.Errorf("String() = %q, want suffix %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package packet
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for fields
+const (
+	baseField    string = basediagram.Indentation + "%d-%d: %s\n"
+	baseFieldBit string = basediagram.Indentation + "%d: %s\n"
+)
+
+// Field represents a labelled range of bits of the packet, from Start to
+// End included.
+type Field struct {
+	Start int
+	End   int
+	Label string
+}
+
+// NewField creates a new field covering the bits from start to end included
+func NewField(start int, end int, label string) *Field {
+	return &Field{
+		Start: start,
+		End:   end,
+		Label: label,
+	}
+}
+
+// Bits returns the width of the field in bits.
+func (f *Field) Bits() int {
+	return f.End - f.Start + 1
+}
+
+// SetLabel sets the field label and returns the field for chaining
+func (f *Field) SetLabel(label string) *Field {
+	f.Label = label
+	return f
+}
+
+// String generates the Mermaid syntax for the field as a single range
+func (f *Field) String() string {
+	return formatRange(f.Start, f.End, f.Label)
+}
+
+// formatRange returns the row declaring the bits from start to end, which
+// is written as a single bit when both are the same.
+func formatRange(start int, end int, label string) string {
+	if start == end {
+		return fmt.Sprintf(baseFieldBit, start, basediagram.Quote(label))
+	}
+
+	return fmt.Sprintf(baseField, start, end, basediagram.Quote(label))
+}
@@ -0,0 +1,66 @@
+package packet
+
+import (
+	"testing"
+)
+
+func TestNewField(t *testing.T) {
+	got := NewField(16, 31, "Destination Port")
+
+	if got.Start != 16 || got.End != 31 || got.Label != "Destination Port" {
+		t.Errorf("NewField() = %+v, want bits 16-31", got)
+	}
+
+	if bits := got.Bits(); bits != 16 {
+		t.Errorf("Bits() = %d, want 16", bits)
+	}
+}
+
+func TestField_SetLabel(t *testing.T) {
+	field := NewField(0, 7, "")
+
+	if got := field.SetLabel("Version"); got != field {
+		t.Fatal("SetLabel() should return the field for chaining")
+	}
+
+	if field.Label != "Version" {
+		t.Errorf("SetLabel() label = %q, want %q", field.Label, "Version")
+	}
+}
+
+func TestField_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		field *Field
+		want  string
+	}{
+		{
+			name:  "Range of bits",
+			field: NewField(0, 15, "Source Port"),
+			want:  "    0-15: \"Source Port\"\n",
+		},
+		{
+			name:  "Single bit",
+			field: NewField(106, 106, "URG"),
+			want:  "    106: \"URG\"\n",
+		},
+		{
+			name:  "Field across rows",
+			field: NewField(24, 39, "Checksum"),
+			want:  "    24-39: \"Checksum\"\n",
+		},
+		{
+			name:  "Label with special characters",
+			field: NewField(0, 3, `Data "variable" #1`),
+			want:  "    0-3: \"Data #quot;variable#quot; #35;1\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.field.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package packet
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Parse reads Mermaid packet diagram syntax and returns the corresponding
+// Diagram. It understands the syntax generated by Diagram.String as well as
+// the `packet` keyword, the `title` statement and fields given by their
+// width as `+16: "label"`, which start right after the previous field.
+// Rows written for the same field split across rows are read as separate
+// fields.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType, "packet")
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	d := NewDiagram()
+	d.Title = doc.Title
+	if err := doc.Config.Apply(&d.Config.ConfigurationProperties, packetConfigurationSection, d.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := parseLine(d, line); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+func parseLine(d *Diagram, line parser.Line) error {
+	keyword, rest, _ := strings.Cut(line.Text, " ")
+	switch keyword {
+	case "title":
+		d.Title = strings.TrimSpace(rest)
+		return nil
+	case "accTitle", "accDescr", "accTitle:", "accDescr:":
+		return line.Errorf(0, "unsupported statement %q", strings.TrimSuffix(keyword, ":"))
+	}
+
+	s := parser.NewScanner(line)
+	relative := s.Consume("+")
+
+	start, err := readBit(s)
+	if err != nil {
+		return err
+	}
+	end := start
+
+	if relative {
+		if start <= 0 {
+			return s.ErrorAt(1, "width %d is not positive", start)
+		}
+		start, end = d.NextBit(), d.NextBit()+start-1
+	} else {
+		s.SkipSpaces()
+		if s.Consume("-") {
+			s.SkipSpaces()
+			if end, err = readBit(s); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.SkipSpaces()
+	if !s.Consume(":") {
+		return s.Errorf("expected ':'")
+	}
+	s.SkipSpaces()
+
+	label, err := s.ReadQuoted()
+	if err != nil {
+		return err
+	}
+	s.SkipSpaces()
+	if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	d.AddFieldRange(start, end, basediagram.Unescape(label))
+
+	return nil
+}
+
+// readBit reads a bit number.
+func readBit(s *parser.Scanner) (int, error) {
+	pos := s.Pos()
+	text := s.ReadWhile(func(r rune) bool { return r >= '0' && r <= '9' })
+	if text == "" {
+		return 0, s.Errorf("expected bit number")
+	}
+
+	bit, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, s.ErrorAt(pos, "invalid bit number %q", text)
+	}
+
+	return bit, nil
+}
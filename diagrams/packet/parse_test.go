@@ -0,0 +1,188 @@
+package packet
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Diagram with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "UDP Packet"
+				d.Config.SetBitsPerRow(16).SetShowBits(false)
+				d.EnableMarkdownFence()
+				d.AddField(16, "Source Port")
+				d.AddField(32, "Length and checksum")
+			},
+		},
+		{
+			name: "Diagram with single bits and special characters",
+			setup: func(d *Diagram) {
+				d.AddField(4, "Data Offset")
+				d.AddField(1, "URG")
+				d.AddField(1, `"ACK" #1`)
+				d.AddField(26, "Window: size")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `packet
+title TCP Packet
+0-15: "Source Port"
++16: "Destination Port"
+32 - 63 : "Sequence Number"
+64: "Flag"
++7: "Reserved"
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if d.Title != "TCP Packet" {
+		t.Errorf("Parse() title = %q, want %q", d.Title, "TCP Packet")
+	}
+
+	want := []*Field{
+		NewField(0, 15, "Source Port"),
+		NewField(16, 31, "Destination Port"),
+		NewField(32, 63, "Sequence Number"),
+		NewField(64, 64, "Flag"),
+		NewField(65, 71, "Reserved"),
+	}
+	if !reflect.DeepEqual(d.Fields, want) {
+		t.Errorf("Parse() fields = %+v, want %+v", d.Fields, want)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "0-15: \"Source Port\"\n",
+			line:    1,
+			column:  1,
+			message: "expected packet-beta declaration",
+		},
+		{
+			name:    "Header with arguments",
+			input:   "packet-beta LR\n",
+			line:    1,
+			column:  13,
+			message: `unexpected "LR"`,
+		},
+		{
+			name:    "Missing bit number",
+			input:   "packet-beta\n    Source: \"Port\"\n",
+			line:    2,
+			column:  5,
+			message: "expected bit number",
+		},
+		{
+			name:    "Missing end bit",
+			input:   "packet-beta\n    0-: \"Port\"\n",
+			line:    2,
+			column:  7,
+			message: "expected bit number",
+		},
+		{
+			name:    "Zero width",
+			input:   "packet-beta\n    +0: \"Port\"\n",
+			line:    2,
+			column:  6,
+			message: "width 0 is not positive",
+		},
+		{
+			name:    "Missing colon",
+			input:   "packet-beta\n    0-15 \"Port\"\n",
+			line:    2,
+			column:  10,
+			message: "expected ':'",
+		},
+		{
+			name:    "Unquoted label",
+			input:   "packet-beta\n    0-15: Port\n",
+			line:    2,
+			column:  11,
+			message: "expected '\"'",
+		},
+		{
+			name:    "Unterminated label",
+			input:   "packet-beta\n    0-15: \"Port\n",
+			line:    2,
+			column:  11,
+			message: "unterminated string",
+		},
+		{
+			name:    "Text after the label",
+			input:   "packet-beta\n    0-15: \"Port\" 16\n",
+			line:    2,
+			column:  18,
+			message: `unexpected "16"`,
+		},
+		{
+			name:    "Accessibility statement",
+			input:   "packet-beta\n    accTitle: Header\n",
+			line:    2,
+			column:  5,
+			message: `unsupported statement "accTitle"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package packet
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// nil fields, fields starting at a negative bit or ending before they start,
+// and fields that leave a gap after, or overlap, the fields before them, as
+// Mermaid requires the fields to cover the bits from 0 in order.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	next := 0
+	for i, field := range d.Fields {
+		path := fmt.Sprintf("Fields[%d]", i)
+		if field == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing field")
+			continue
+		}
+
+		if field.Start < 0 {
+			v.Error(basediagram.CodeOutOfRange, path+".Start", "start %d is negative", field.Start)
+			continue
+		}
+		if field.End < field.Start {
+			v.Error(basediagram.CodeOutOfRange, path+".End", "field %q ends at bit %d before it starts at bit %d", field.Label, field.End, field.Start)
+			continue
+		}
+
+		switch {
+		case field.Start > next:
+			v.Error(basediagram.CodeInvalidValue, path+".Start", "gap at %s before field %q", formatBits(next, field.Start-1), field.Label)
+		case field.Start < next:
+			overlap := next - 1
+			if field.End < overlap {
+				overlap = field.End
+			}
+			v.Error(basediagram.CodeInvalidValue, path+".Start", "field %q overlaps %s of the fields before it", field.Label, formatBits(field.Start, overlap))
+		}
+
+		if field.End >= next {
+			next = field.End + 1
+		}
+	}
+
+	return v.Errors()
+}
+
+// formatBits describes the bits from start to end included.
+func formatBits(start int, end int) string {
+	if start == end {
+		return fmt.Sprintf("bit %d", start)
+	}
+
+	return fmt.Sprintf("bits %d-%d", start, end)
+}
@@ -0,0 +1,70 @@
+package packet
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				d.AddField(16, "Source Port")
+				d.AddField(16, "Destination Port")
+				d.AddField(1, "URG")
+				d.AddFieldRange(33, 63, "Reserved")
+			},
+		},
+		{
+			name: "Invalid fields",
+			setup: func(d *Diagram) {
+				d.AddFieldRange(-1, 3, "Negative")
+				d.AddField(0, "Empty")
+				d.Fields = append(d.Fields, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityError, Path: "Fields[0].Start", Message: "start -1 is negative"},
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityError, Path: "Fields[1].End", Message: `field "Empty" ends at bit 3 before it starts at bit 4`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Fields[2]", Message: "missing field"},
+			},
+		},
+		{
+			name: "Gaps and overlaps",
+			setup: func(d *Diagram) {
+				d.AddFieldRange(1, 7, "Version")
+				d.AddFieldRange(10, 15, "Flags")
+				d.AddFieldRange(12, 31, "Length")
+				d.AddFieldRange(20, 20, "Bit")
+				d.AddFieldRange(32, 47, "Checksum")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Fields[0].Start", Message: `gap at bit 0 before field "Version"`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Fields[1].Start", Message: `gap at bits 8-9 before field "Flags"`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Fields[2].Start", Message: `field "Length" overlaps bits 12-15 of the fields before it`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Fields[3].Start", Message: `field "Bit" overlaps bit 20 of the fields before it`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package pie
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	pieConfigurationSection        string = "pie"
+	basePieConfigurationProperties string = basediagram.Indentation + pieConfigurationSection + ":\n"
+
+	piePropertyTextPosition string = "textPosition"
+	piePropertyUseMaxWidth  string = "useMaxWidth"
+	piePropertyUseWidth     string = "useWidth"
+)
+
+// PieConfigurationProperties holds pie-specific configuration
+type PieConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewPieConfigurationProperties() PieConfigurationProperties {
+	return PieConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *PieConfigurationProperties) SetTextPosition(v float64) *PieConfigurationProperties {
+	c.properties[piePropertyTextPosition] = &basediagram.FloatProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: piePropertyTextPosition,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *PieConfigurationProperties) SetUseMaxWidth(v bool) *PieConfigurationProperties {
+	c.properties[piePropertyUseMaxWidth] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: piePropertyUseMaxWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *PieConfigurationProperties) SetUseWidth(v int) *PieConfigurationProperties {
+	c.properties[piePropertyUseWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: piePropertyUseWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c PieConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(basePieConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,162 @@
+package pie
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewPieConfigurationProperties(t *testing.T) {
+	got := NewPieConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewPieConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewPieConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestPieConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   PieConfigurationProperties
+		setup    func(*PieConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewPieConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewPieConfigurationProperties(),
+			setup: func(c *PieConfigurationProperties) {
+				c.SetTextPosition(0.75)
+			},
+			contains: []string{
+				"pie:",
+				"textPosition: 0.75",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewPieConfigurationProperties(),
+			setup: func(c *PieConfigurationProperties) {
+				c.SetUseMaxWidth(false)
+				c.SetUseWidth(800)
+			},
+			contains: []string{
+				"pie:",
+				"useMaxWidth: false",
+				"useWidth: 800",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewPieConfigurationProperties(),
+			setup: func(c *PieConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetTextPosition(0.75)
+			},
+			contains: []string{
+				"fontSize: 12",
+				"pie:",
+				"textPosition: 0.75",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestPieConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*PieConfigurationProperties) *PieConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set text position",
+			setup: func(c *PieConfigurationProperties) *PieConfigurationProperties {
+				return c.SetTextPosition(0.75)
+			},
+			property: piePropertyTextPosition,
+			value:    0.75,
+		},
+		{
+			name: "Set use max width",
+			setup: func(c *PieConfigurationProperties) *PieConfigurationProperties {
+				return c.SetUseMaxWidth(false)
+			},
+			property: piePropertyUseMaxWidth,
+			value:    false,
+		},
+		{
+			name: "Set use width",
+			setup: func(c *PieConfigurationProperties) *PieConfigurationProperties {
+				return c.SetUseWidth(800)
+			},
+			property: piePropertyUseWidth,
+			value:    800,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewPieConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Package pie provides functionality for creating Mermaid pie charts
+package pie
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for pie charts
+const (
+	diagramType         string = "pie"
+	baseDiagramType     string = diagramType + "\n"
+	baseDiagramShowData string = diagramType + " showData\n"
+)
+
+// Diagram represents a Mermaid pie chart. When ShowData is set, the value
+// of each slice is displayed next to its label in the legend.
+// Reference: https://mermaid.js.org/syntax/pie.html
+type Diagram struct {
+	basediagram.BaseDiagram[PieConfigurationProperties]
+	ShowData bool
+	Slices   []*Slice
+}
+
+// NewDiagram creates a new pie chart
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram: basediagram.NewBaseDiagram(NewPieConfigurationProperties()),
+		Slices:      make([]*Slice, 0),
+	}
+}
+
+// SetShowData sets whether the slice values are displayed and returns the diagram for chaining
+func (d *Diagram) SetShowData(showData bool) *Diagram {
+	d.ShowData = showData
+	return d
+}
+
+// AddSlice creates and adds a new slice to the pie chart
+func (d *Diagram) AddSlice(label string, value float64) *Slice {
+	slice := NewSlice(label, value)
+	d.Slices = append(d.Slices, slice)
+	return slice
+}
+
+// String generates the Mermaid syntax for the pie chart
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces a pie chart.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one element at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		if d.ShowData {
+			w.WriteString(baseDiagramShowData)
+		} else {
+			w.WriteString(baseDiagramType)
+		}
+
+		for _, slice := range d.Slices {
+			w.WriteString(slice.String())
+		}
+	})
+}
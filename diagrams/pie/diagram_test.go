@@ -0,0 +1,89 @@
+package pie
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if len(diagram.Slices) != 0 {
+		t.Error("NewDiagram() should create empty slices slice")
+	}
+
+	if diagram.ShowData {
+		t.Error("NewDiagram() should not show data by default")
+	}
+}
+
+func TestDiagram_AddSlice(t *testing.T) {
+	diagram := NewDiagram()
+	slice := diagram.AddSlice("Dogs", 386)
+
+	if len(diagram.Slices) != 1 || diagram.Slices[0] != slice {
+		t.Fatalf("AddSlice() slices = %v, want [%v]", diagram.Slices, slice)
+	}
+
+	if slice.Label != "Dogs" || slice.Value != 386 {
+		t.Errorf("AddSlice() = %+v, want Dogs with 386", slice)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func() *Diagram
+		contains []string
+	}{
+		{
+			name: "Empty diagram",
+			setup: func() *Diagram {
+				return NewDiagram()
+			},
+			contains: []string{
+				"pie\n",
+			},
+		},
+		{
+			name: "Diagram with show data",
+			setup: func() *Diagram {
+				return NewDiagram().SetShowData(true)
+			},
+			contains: []string{
+				"pie showData\n",
+			},
+		},
+		{
+			name: "Complete diagram",
+			setup: func() *Diagram {
+				d := NewDiagram()
+				d.SetTitle("Pets adopted by volunteers")
+				d.AddSlice("Dogs", 386)
+				d.AddSlice("Cats", 85.5)
+				d.AddSlice("Rats", 15)
+				return d
+			},
+			contains: []string{
+				"title: Pets adopted by volunteers",
+				"pie\n",
+				`"Dogs" : 386`,
+				`"Cats" : 85.5`,
+				`"Rats" : 15`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagram := tt.setup()
+			result := diagram.String()
+
+			for _, want := range tt.contains {
+				if !strings.Contains(result, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, result)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package pie
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Parse reads Mermaid pie chart syntax and returns the corresponding Diagram.
+// It understands the syntax generated by Diagram.String as well as the
+// `title` statement, either on its own line or after the pie keyword.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+
+	d := NewDiagram()
+	d.Title = doc.Title
+	if err := doc.Config.Apply(&d.Config.ConfigurationProperties, pieConfigurationSection, d.Config.properties); err != nil {
+		return nil, err
+	}
+
+	if rest != "" {
+		if err := parseHeader(d, header, rest); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, line := range doc.Body() {
+		if err := parseLine(d, line); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+// parseHeader reads the `showData` and `title` arguments of the pie keyword.
+func parseHeader(d *Diagram, header parser.Line, rest string) error {
+	pos := len(header.Text) - len(rest)
+
+	if arguments, found := strings.CutPrefix(rest, "showData"); found && (arguments == "" || arguments[0] == ' ') {
+		d.ShowData = true
+		rest = strings.TrimSpace(arguments)
+		pos = len(header.Text) - len(rest)
+	}
+
+	if rest == "" {
+		return nil
+	}
+
+	keyword, title, _ := strings.Cut(rest, " ")
+	if keyword != "title" {
+		return header.Errorf(pos, "unexpected %q", rest)
+	}
+	d.Title = strings.TrimSpace(title)
+
+	return nil
+}
+
+// parseLine reads a `title` statement or a `"Label" : value` slice.
+func parseLine(d *Diagram, line parser.Line) error {
+	keyword, rest, _ := strings.Cut(line.Text, " ")
+
+	switch keyword {
+	case "title":
+		d.Title = strings.TrimSpace(rest)
+		return nil
+	case "accTitle", "accDescr":
+		return line.Errorf(0, "unsupported statement %q", keyword)
+	}
+
+	s := parser.NewScanner(line)
+	label, err := s.ReadQuoted()
+	if err != nil {
+		return err
+	}
+
+	s.SkipSpaces()
+	if !s.Consume(":") {
+		return s.Errorf("expected ':'")
+	}
+	s.SkipSpaces()
+
+	valuePos := s.Pos()
+	value, err := strconv.ParseFloat(strings.TrimSpace(s.Rest()), 64)
+	if err != nil {
+		return s.ErrorAt(valuePos, "invalid value %q", strings.TrimSpace(s.Rest()))
+	}
+
+	d.AddSlice(basediagram.Unescape(label), value)
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package pie
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty pie chart",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Pie chart with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Pets"
+				d.Config.SetTextPosition(0.5)
+				d.EnableMarkdownFence()
+				d.AddSlice("Dogs", 386)
+			},
+		},
+		{
+			name: "Pie chart with show data and decimal values",
+			setup: func(d *Diagram) {
+				d.SetShowData(true)
+				d.AddSlice("Calcium", 42.96)
+				d.AddSlice("Potassium", 50.05)
+				d.AddSlice("Iron", 5)
+			},
+		},
+		{
+			name: "Pie chart with text that needs escaping",
+			setup: func(d *Diagram) {
+				d.AddSlice(`Say "hi"; #1`, 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		wantTitle    string
+		wantShowData bool
+	}{
+		{
+			name:      "Title after the keyword",
+			input:     "pie title Pets adopted by volunteers\n    \"Dogs\" : 386\n    \"Cats\" : 85\n",
+			wantTitle: "Pets adopted by volunteers",
+		},
+		{
+			name:         "Show data and title statement",
+			input:        "pie showData\n    title Key elements\n    \"Dogs\" : 386\n    \"Cats\" : 85\n",
+			wantTitle:    "Key elements",
+			wantShowData: true,
+		},
+		{
+			name:         "Show data and title after the keyword",
+			input:        "pie showData title Key elements\n    \"Dogs\":386\n    \"Cats\" :85\n",
+			wantTitle:    "Key elements",
+			wantShowData: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if d.Title != tt.wantTitle || d.ShowData != tt.wantShowData {
+				t.Errorf("Parse() = title %q, show data %v, want %q and %v", d.Title, d.ShowData, tt.wantTitle, tt.wantShowData)
+			}
+
+			if len(d.Slices) != 2 || d.Slices[0].Label != "Dogs" || d.Slices[0].Value != 386 || d.Slices[1].Value != 85 {
+				t.Errorf("Parse() slices = %+v, want Dogs 386 and Cats 85", d.Slices)
+			}
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "\"Dogs\" : 386\n",
+			line:    1,
+			column:  1,
+			message: "expected pie declaration",
+		},
+		{
+			name:    "Unexpected header argument",
+			input:   "pie showNothing\n",
+			line:    1,
+			column:  5,
+			message: `unexpected "showNothing"`,
+		},
+		{
+			name:    "Unquoted label",
+			input:   "pie\n    Dogs : 386\n",
+			line:    2,
+			column:  5,
+			message: `expected '"'`,
+		},
+		{
+			name:    "Missing colon",
+			input:   "pie\n    \"Dogs\" 386\n",
+			line:    2,
+			column:  12,
+			message: "expected ':'",
+		},
+		{
+			name:    "Invalid value",
+			input:   "pie\n    \"Dogs\" : many\n",
+			line:    2,
+			column:  14,
+			message: `invalid value "many"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
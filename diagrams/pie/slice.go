@@ -0,0 +1,33 @@
+package pie
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for pie slices
+const (
+	baseSlice string = basediagram.Indentation + "%s : %s\n"
+)
+
+// Slice represents a labelled value of a pie chart
+type Slice struct {
+	Label string
+	Value float64
+}
+
+// NewSlice creates a new Slice with the given label and value
+func NewSlice(label string, value float64) *Slice {
+	return &Slice{
+		Label: label,
+		Value: value,
+	}
+}
+
+// String generates the Mermaid syntax for the slice, writing the value
+// with as few digits as needed.
+func (s *Slice) String() string {
+	return fmt.Sprintf(baseSlice, basediagram.Quote(s.Label), strconv.FormatFloat(s.Value, 'f', -1, 64))
+}
@@ -0,0 +1,50 @@
+package pie
+
+import (
+	"testing"
+)
+
+func TestNewSlice(t *testing.T) {
+	got := NewSlice("Dogs", 386)
+
+	if got.Label != "Dogs" || got.Value != 386 {
+		t.Errorf("NewSlice() = %+v, want Dogs with 386", got)
+	}
+}
+
+func TestSlice_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice *Slice
+		want  string
+	}{
+		{
+			name:  "Integer value",
+			slice: NewSlice("Dogs", 386),
+			want:  "    \"Dogs\" : 386\n",
+		},
+		{
+			name:  "Decimal value",
+			slice: NewSlice("Calcium", 42.96),
+			want:  "    \"Calcium\" : 42.96\n",
+		},
+		{
+			name:  "Large value without exponent",
+			slice: NewSlice("Bytes", 12500000),
+			want:  "    \"Bytes\" : 12500000\n",
+		},
+		{
+			name:  "Label that needs escaping",
+			slice: NewSlice(`Say "hi"`, 1),
+			want:  "    \"Say #quot;hi#quot;\" : 1\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.slice.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
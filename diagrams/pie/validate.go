@@ -0,0 +1,39 @@
+package pie
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// missing slices, negative or non-finite values, which Mermaid cannot draw, and duplicate
+// labels, whose slices Mermaid merges into the last one.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	labels := make(map[string]string, len(d.Slices))
+	for i, slice := range d.Slices {
+		path := fmt.Sprintf("Slices[%d]", i)
+		if slice == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing slice")
+			continue
+		}
+
+		if first, ok := labels[slice.Label]; ok {
+			v.Error(basediagram.CodeDuplicateID, path+".Label", "label %q is already used by %s", slice.Label, first)
+		} else {
+			labels[slice.Label] = path
+		}
+
+		switch {
+		case math.IsNaN(slice.Value) || math.IsInf(slice.Value, 0):
+			v.Error(basediagram.CodeInvalidValue, path+".Value", "value %v is not a number", slice.Value)
+		case slice.Value < 0:
+			v.Error(basediagram.CodeOutOfRange, path+".Value", "value %v is negative", slice.Value)
+		}
+	}
+
+	return v.Errors()
+}
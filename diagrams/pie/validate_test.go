@@ -0,0 +1,74 @@
+package pie
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				d.AddSlice("Dogs", 386)
+				d.AddSlice("Cats", 0)
+			},
+		},
+		{
+			name: "Negative and invalid values",
+			setup: func(d *Diagram) {
+				d.AddSlice("Dogs", -1.5)
+				d.AddSlice("Cats", math.NaN())
+				d.AddSlice("Rats", math.Inf(1))
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityError, Path: "Slices[0].Value", Message: "value -1.5 is negative"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Slices[1].Value", Message: "value NaN is not a number"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Slices[2].Value", Message: "value +Inf is not a number"},
+			},
+		},
+		{
+			name: "Duplicate labels",
+			setup: func(d *Diagram) {
+				d.AddSlice("Dogs", 1)
+				d.AddSlice("Cats", 2)
+				d.AddSlice("Dogs", 3)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Slices[2].Label", Message: `label "Dogs" is already used by Slices[0]`},
+			},
+		},
+		{
+			name: "Missing slice",
+			setup: func(d *Diagram) {
+				d.AddSlice("Dogs", 1)
+				d.Slices = append(d.Slices, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Slices[1]", Message: "missing slice"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package quadrant
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for axes
+const (
+	xAxisName       string = "x-axis"
+	yAxisName       string = "y-axis"
+	baseAxisLow     string = basediagram.Indentation + "%s %s\n"
+	baseAxisLowHigh string = basediagram.Indentation + "%s %s --> %s\n"
+)
+
+// Axis holds the labels of both ends of an axis. Mermaid accepts an axis
+// with only its low label, but not one with only its high label.
+type Axis struct {
+	Low  string
+	High string
+}
+
+// format generates the Mermaid syntax for the axis with the given keyword,
+// or nothing when the axis has no labels.
+func (a Axis) format(name string) string {
+	switch {
+	case a.Low == "" && a.High == "":
+		return ""
+	case a.High == "":
+		return fmt.Sprintf(baseAxisLow, name, basediagram.Quote(a.Low))
+	default:
+		return fmt.Sprintf(baseAxisLowHigh, name, basediagram.Quote(a.Low), basediagram.Quote(a.High))
+	}
+}
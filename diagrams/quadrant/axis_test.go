@@ -0,0 +1,40 @@
+package quadrant
+
+import "testing"
+
+func TestAxis_format(t *testing.T) {
+	tests := []struct {
+		name string
+		axis Axis
+		want string
+	}{
+		{
+			name: "Axis without labels",
+			axis: Axis{},
+			want: "",
+		},
+		{
+			name: "Axis with low label only",
+			axis: Axis{Low: "Low"},
+			want: "    x-axis \"Low\"\n",
+		},
+		{
+			name: "Axis with both labels",
+			axis: Axis{Low: "Low", High: "High"},
+			want: "    x-axis \"Low\" --> \"High\"\n",
+		},
+		{
+			name: "Axis with labels that need escaping",
+			axis: Axis{Low: `"Cheap"`, High: "Costly; #1"},
+			want: "    x-axis \"#quot;Cheap#quot;\" --> \"Costly#59; #35;1\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.axis.format(xAxisName); got != tt.want {
+				t.Errorf("format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
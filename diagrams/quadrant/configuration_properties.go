@@ -0,0 +1,236 @@
+package quadrant
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	quadrantConfigurationSection        string = "quadrantChart"
+	baseQuadrantConfigurationProperties string = basediagram.Indentation + quadrantConfigurationSection + ":\n"
+
+	quadrantPropertyChartWidth                        string = "chartWidth"
+	quadrantPropertyChartHeight                       string = "chartHeight"
+	quadrantPropertyTitleFontSize                     string = "titleFontSize"
+	quadrantPropertyTitlePadding                      string = "titlePadding"
+	quadrantPropertyQuadrantPadding                   string = "quadrantPadding"
+	quadrantPropertyXAxisLabelPadding                 string = "xAxisLabelPadding"
+	quadrantPropertyYAxisLabelPadding                 string = "yAxisLabelPadding"
+	quadrantPropertyXAxisLabelFontSize                string = "xAxisLabelFontSize"
+	quadrantPropertyYAxisLabelFontSize                string = "yAxisLabelFontSize"
+	quadrantPropertyQuadrantLabelFontSize             string = "quadrantLabelFontSize"
+	quadrantPropertyQuadrantTextTopPadding            string = "quadrantTextTopPadding"
+	quadrantPropertyPointTextPadding                  string = "pointTextPadding"
+	quadrantPropertyPointLabelFontSize                string = "pointLabelFontSize"
+	quadrantPropertyPointRadius                       string = "pointRadius"
+	quadrantPropertyXAxisPosition                     string = "xAxisPosition"
+	quadrantPropertyYAxisPosition                     string = "yAxisPosition"
+	quadrantPropertyQuadrantInternalBorderStrokeWidth string = "quadrantInternalBorderStrokeWidth"
+	quadrantPropertyQuadrantExternalBorderStrokeWidth string = "quadrantExternalBorderStrokeWidth"
+)
+
+// QuadrantConfigurationProperties holds quadrantChart-specific configuration
+type QuadrantConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewQuadrantConfigurationProperties() QuadrantConfigurationProperties {
+	return QuadrantConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *QuadrantConfigurationProperties) SetChartWidth(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyChartWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyChartWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetChartHeight(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyChartHeight] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyChartHeight,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetTitleFontSize(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyTitleFontSize] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyTitleFontSize,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetTitlePadding(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyTitlePadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyTitlePadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetQuadrantPadding(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyQuadrantPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyQuadrantPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetXAxisLabelPadding(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyXAxisLabelPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyXAxisLabelPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetYAxisLabelPadding(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyYAxisLabelPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyYAxisLabelPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetXAxisLabelFontSize(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyXAxisLabelFontSize] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyXAxisLabelFontSize,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetYAxisLabelFontSize(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyYAxisLabelFontSize] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyYAxisLabelFontSize,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetQuadrantLabelFontSize(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyQuadrantLabelFontSize] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyQuadrantLabelFontSize,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetQuadrantTextTopPadding(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyQuadrantTextTopPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyQuadrantTextTopPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetPointTextPadding(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyPointTextPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyPointTextPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetPointLabelFontSize(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyPointLabelFontSize] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyPointLabelFontSize,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetPointRadius(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyPointRadius] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyPointRadius,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetXAxisPosition(v string) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyXAxisPosition] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyXAxisPosition,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetYAxisPosition(v string) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyYAxisPosition] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyYAxisPosition,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetQuadrantInternalBorderStrokeWidth(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyQuadrantInternalBorderStrokeWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyQuadrantInternalBorderStrokeWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *QuadrantConfigurationProperties) SetQuadrantExternalBorderStrokeWidth(v int) *QuadrantConfigurationProperties {
+	c.properties[quadrantPropertyQuadrantExternalBorderStrokeWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: quadrantPropertyQuadrantExternalBorderStrokeWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c QuadrantConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(baseQuadrantConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
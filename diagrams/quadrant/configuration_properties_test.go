@@ -0,0 +1,282 @@
+package quadrant
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewQuadrantConfigurationProperties(t *testing.T) {
+	got := NewQuadrantConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewQuadrantConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewQuadrantConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestQuadrantConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   QuadrantConfigurationProperties
+		setup    func(*QuadrantConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewQuadrantConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewQuadrantConfigurationProperties(),
+			setup: func(c *QuadrantConfigurationProperties) {
+				c.SetChartWidth(600)
+			},
+			contains: []string{
+				"quadrantChart:",
+				"chartWidth: 600",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewQuadrantConfigurationProperties(),
+			setup: func(c *QuadrantConfigurationProperties) {
+				c.SetChartHeight(600)
+				c.SetQuadrantExternalBorderStrokeWidth(2)
+			},
+			contains: []string{
+				"quadrantChart:",
+				"chartHeight: 600",
+				"quadrantExternalBorderStrokeWidth: 2",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewQuadrantConfigurationProperties(),
+			setup: func(c *QuadrantConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetChartWidth(600)
+			},
+			contains: []string{
+				"fontSize: 12",
+				"quadrantChart:",
+				"chartWidth: 600",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestQuadrantConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*QuadrantConfigurationProperties) *QuadrantConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set chart width",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetChartWidth(600)
+			},
+			property: quadrantPropertyChartWidth,
+			value:    600,
+		},
+		{
+			name: "Set chart height",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetChartHeight(600)
+			},
+			property: quadrantPropertyChartHeight,
+			value:    600,
+		},
+		{
+			name: "Set title font size",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetTitleFontSize(20)
+			},
+			property: quadrantPropertyTitleFontSize,
+			value:    20,
+		},
+		{
+			name: "Set title padding",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetTitlePadding(10)
+			},
+			property: quadrantPropertyTitlePadding,
+			value:    10,
+		},
+		{
+			name: "Set quadrant padding",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrantPadding(5)
+			},
+			property: quadrantPropertyQuadrantPadding,
+			value:    5,
+		},
+		{
+			name: "Set x axis label padding",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetXAxisLabelPadding(5)
+			},
+			property: quadrantPropertyXAxisLabelPadding,
+			value:    5,
+		},
+		{
+			name: "Set y axis label padding",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetYAxisLabelPadding(5)
+			},
+			property: quadrantPropertyYAxisLabelPadding,
+			value:    5,
+		},
+		{
+			name: "Set x axis label font size",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetXAxisLabelFontSize(16)
+			},
+			property: quadrantPropertyXAxisLabelFontSize,
+			value:    16,
+		},
+		{
+			name: "Set y axis label font size",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetYAxisLabelFontSize(16)
+			},
+			property: quadrantPropertyYAxisLabelFontSize,
+			value:    16,
+		},
+		{
+			name: "Set quadrant label font size",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrantLabelFontSize(16)
+			},
+			property: quadrantPropertyQuadrantLabelFontSize,
+			value:    16,
+		},
+		{
+			name: "Set quadrant text top padding",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrantTextTopPadding(5)
+			},
+			property: quadrantPropertyQuadrantTextTopPadding,
+			value:    5,
+		},
+		{
+			name: "Set point text padding",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetPointTextPadding(5)
+			},
+			property: quadrantPropertyPointTextPadding,
+			value:    5,
+		},
+		{
+			name: "Set point label font size",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetPointLabelFontSize(12)
+			},
+			property: quadrantPropertyPointLabelFontSize,
+			value:    12,
+		},
+		{
+			name: "Set point radius",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetPointRadius(5)
+			},
+			property: quadrantPropertyPointRadius,
+			value:    5,
+		},
+		{
+			name: "Set x axis position",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetXAxisPosition("bottom")
+			},
+			property: quadrantPropertyXAxisPosition,
+			value:    "bottom",
+		},
+		{
+			name: "Set y axis position",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetYAxisPosition("right")
+			},
+			property: quadrantPropertyYAxisPosition,
+			value:    "right",
+		},
+		{
+			name: "Set quadrant internal border stroke width",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrantInternalBorderStrokeWidth(1)
+			},
+			property: quadrantPropertyQuadrantInternalBorderStrokeWidth,
+			value:    1,
+		},
+		{
+			name: "Set quadrant external border stroke width",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrantExternalBorderStrokeWidth(2)
+			},
+			property: quadrantPropertyQuadrantExternalBorderStrokeWidth,
+			value:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewQuadrantConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
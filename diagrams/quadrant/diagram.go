@@ -0,0 +1,116 @@
+// Package quadrant provides functionality for creating Mermaid quadrant charts
+package quadrant
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+type quadrantPosition int
+
+// List of the quadrants, numbered like in the Mermaid syntax.
+const (
+	QuadrantTopRight    quadrantPosition = 1
+	QuadrantTopLeft     quadrantPosition = 2
+	QuadrantBottomLeft  quadrantPosition = 3
+	QuadrantBottomRight quadrantPosition = 4
+)
+
+// Base string formats for quadrant charts
+const (
+	diagramType       string = "quadrantChart"
+	baseDiagramType   string = diagramType + "\n"
+	baseQuadrantLabel string = basediagram.Indentation + "quadrant-%d %s\n"
+)
+
+// Diagram represents a Mermaid quadrant chart: points placed on two axes
+// going from 0 to 1, over four labelled quadrants.
+// Reference: https://mermaid.js.org/syntax/quadrantChart.html
+type Diagram struct {
+	basediagram.BaseDiagram[QuadrantConfigurationProperties]
+	XAxis Axis
+	YAxis Axis
+	// QuadrantLabels holds the label of each quadrant, indexed by its
+	// position minus one: QuadrantLabels[0] is the top right quadrant.
+	QuadrantLabels [4]string
+	Points         []*Point
+}
+
+// NewDiagram creates a new quadrant chart without labels or points
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram: basediagram.NewBaseDiagram(NewQuadrantConfigurationProperties()),
+		Points:      make([]*Point, 0),
+	}
+}
+
+// SetXAxis sets the labels of the left and right ends of the x-axis and returns the diagram for chaining
+func (d *Diagram) SetXAxis(low string, high string) *Diagram {
+	d.XAxis.Low = low
+	d.XAxis.High = high
+	return d
+}
+
+// SetYAxis sets the labels of the bottom and top ends of the y-axis and returns the diagram for chaining
+func (d *Diagram) SetYAxis(low string, high string) *Diagram {
+	d.YAxis.Low = low
+	d.YAxis.High = high
+	return d
+}
+
+// SetQuadrantLabel sets the label of a quadrant and returns the diagram for chaining
+func (d *Diagram) SetQuadrantLabel(quadrant quadrantPosition, label string) *Diagram {
+	if quadrant >= QuadrantTopRight && quadrant <= QuadrantBottomRight {
+		d.QuadrantLabels[quadrant-1] = label
+	}
+	return d
+}
+
+// AddPoint creates and adds a new point to the chart
+func (d *Diagram) AddPoint(name string, x float64, y float64) *Point {
+	point := NewPoint(name, x, y)
+	d.Points = append(d.Points, point)
+	return point
+}
+
+// String generates the Mermaid syntax for the quadrant chart
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces a quadrant chart.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one point at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+		w.WriteString(d.XAxis.format(xAxisName))
+		w.WriteString(d.YAxis.format(yAxisName))
+
+		for i, label := range d.QuadrantLabels {
+			if label != "" {
+				w.WriteString(fmt.Sprintf(baseQuadrantLabel, i+1, basediagram.Quote(label)))
+			}
+		}
+
+		for _, point := range d.Points {
+			if point != nil {
+				w.WriteString(point.String())
+			}
+		}
+	})
+}
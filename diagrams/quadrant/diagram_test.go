@@ -0,0 +1,100 @@
+package quadrant
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if diagram.XAxis != (Axis{}) || diagram.YAxis != (Axis{}) || diagram.QuadrantLabels != [4]string{} {
+		t.Errorf("NewDiagram() = %+v, want no labels", diagram)
+	}
+
+	if diagram.Points == nil || len(diagram.Points) != 0 {
+		t.Errorf("NewDiagram() points = %v, want empty slice", diagram.Points)
+	}
+}
+
+func TestDiagram_Setters(t *testing.T) {
+	diagram := NewDiagram()
+
+	result := diagram.
+		SetXAxis("Low Reach", "High Reach").
+		SetYAxis("Low Engagement", "").
+		SetQuadrantLabel(QuadrantTopRight, "Expand").
+		SetQuadrantLabel(QuadrantBottomRight, "Improve").
+		SetQuadrantLabel(5, "Ignored")
+	if result != diagram {
+		t.Error("Setters should return diagram for chaining")
+	}
+
+	if diagram.XAxis != (Axis{Low: "Low Reach", High: "High Reach"}) || diagram.YAxis != (Axis{Low: "Low Engagement"}) {
+		t.Errorf("SetXAxis() and SetYAxis() = %+v and %+v", diagram.XAxis, diagram.YAxis)
+	}
+
+	if want := [4]string{"Expand", "", "", "Improve"}; diagram.QuadrantLabels != want {
+		t.Errorf("SetQuadrantLabel() labels = %q, want %q", diagram.QuadrantLabels, want)
+	}
+}
+
+func TestDiagram_AddPoint(t *testing.T) {
+	diagram := NewDiagram()
+	point := diagram.AddPoint("Campaign A", 0.3, 0.6)
+
+	if len(diagram.Points) != 1 || diagram.Points[0] != point {
+		t.Fatalf("AddPoint() points = %v, want [%v]", diagram.Points, point)
+	}
+
+	if point.Name != "Campaign A" || point.X != 0.3 || point.Y != 0.6 {
+		t.Errorf("AddPoint() = %+v, want Campaign A at [0.3, 0.6]", point)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  string
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+			want:  "quadrantChart\n",
+		},
+		{
+			name: "Complete diagram",
+			setup: func(d *Diagram) {
+				d.SetXAxis("Low Reach", "High Reach")
+				d.SetYAxis("Low Engagement", "High Engagement")
+				d.SetQuadrantLabel(QuadrantTopRight, "We should expand")
+				d.SetQuadrantLabel(QuadrantTopLeft, "Need to promote")
+				d.SetQuadrantLabel(QuadrantBottomLeft, "Re-evaluate")
+				d.SetQuadrantLabel(QuadrantBottomRight, "May be improved")
+				d.AddPoint("Campaign A", 0.3, 0.6)
+				d.AddPoint("Campaign B", 0.45, 0.23).SetRadius(12).SetColor("#ff3300")
+			},
+			want: "quadrantChart\n" +
+				"    x-axis \"Low Reach\" --> \"High Reach\"\n" +
+				"    y-axis \"Low Engagement\" --> \"High Engagement\"\n" +
+				"    quadrant-1 \"We should expand\"\n" +
+				"    quadrant-2 \"Need to promote\"\n" +
+				"    quadrant-3 \"Re-evaluate\"\n" +
+				"    quadrant-4 \"May be improved\"\n" +
+				"    \"Campaign A\": [0.3, 0.6]\n" +
+				"    \"Campaign B\": [0.45, 0.23] radius: 12, color: #ff3300\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.String(); !strings.HasSuffix(got, "---\n"+tt.want) {
+				t.Errorf("String() = %q, want body %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package quadrant
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Parse reads Mermaid quadrant chart syntax and returns the corresponding Diagram.
+// It understands the syntax generated by Diagram.String as well as the
+// `title` statement and labels written without quotes.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	d := NewDiagram()
+	d.Title = doc.Title
+	if err := doc.Config.Apply(&d.Config.ConfigurationProperties, quadrantConfigurationSection, d.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := parseLine(d, line); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+func parseLine(d *Diagram, line parser.Line) error {
+	keyword, rest, _ := strings.Cut(line.Text, " ")
+	rest = strings.TrimSpace(rest)
+
+	switch keyword {
+	case "title":
+		d.Title = rest
+		return nil
+	case xAxisName:
+		return parseAxis(line, &d.XAxis)
+	case yAxisName:
+		return parseAxis(line, &d.YAxis)
+	case "quadrant-1", "quadrant-2", "quadrant-3", "quadrant-4":
+		s := parser.NewScanner(line)
+		s.Advance(len(keyword))
+		label, err := readLabel(s, "")
+		if err != nil {
+			return err
+		}
+		if !s.EOF() {
+			return s.Errorf("unexpected %q", s.Rest())
+		}
+		d.SetQuadrantLabel(quadrantPosition(keyword[len(keyword)-1]-'0'), label)
+		return nil
+	case "classDef", "accTitle", "accDescr":
+		return line.Errorf(0, "unsupported statement %q", keyword)
+	}
+
+	return parsePoint(d, line)
+}
+
+// parseAxis reads `x-axis Low --> High`, where the high label is optional.
+func parseAxis(line parser.Line, axis *Axis) error {
+	s := parser.NewScanner(line)
+	s.Advance(len(xAxisName))
+
+	low, err := readLabel(s, "-->")
+	if err != nil {
+		return err
+	}
+
+	high := ""
+	if s.Consume("-->") {
+		if high, err = readLabel(s, ""); err != nil {
+			return err
+		}
+	}
+
+	if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	axis.Low = low
+	axis.High = high
+
+	return nil
+}
+
+// parsePoint reads `Name: [x, y]` followed by optional comma separated styles.
+func parsePoint(d *Diagram, line parser.Line) error {
+	s := parser.NewScanner(line)
+
+	var name string
+	if s.HasPrefix(`"`) {
+		quoted, err := s.ReadQuoted()
+		if err != nil {
+			return err
+		}
+		name = basediagram.Unescape(quoted)
+		if !s.Consume(":") {
+			return s.Errorf("expected ':'")
+		}
+	} else {
+		text, ok := s.ReadUntil(":")
+		if !ok {
+			return line.Errorf(0, "unknown statement %q", line.Text)
+		}
+		name = basediagram.Unescape(strings.TrimSpace(text))
+	}
+
+	s.SkipSpaces()
+	if !s.Consume("[") {
+		return s.Errorf("expected '['")
+	}
+
+	x, err := readNumber(s, ",")
+	if err != nil {
+		return err
+	}
+	y, err := readNumber(s, "]")
+	if err != nil {
+		return err
+	}
+
+	point := d.AddPoint(name, x, y)
+
+	s.SkipSpaces()
+	for !s.EOF() {
+		keyPos := s.Pos()
+		key, ok := s.ReadUntil(":")
+		if !ok {
+			return s.Errorf("expected ':'")
+		}
+
+		s.SkipSpaces()
+		valuePos := s.Pos()
+		value, ok := s.ReadUntil(",")
+		if !ok {
+			value = s.Rest()
+			s.Advance(len(value))
+		}
+		value = strings.TrimSpace(value)
+
+		switch strings.TrimSpace(key) {
+		case "radius":
+			if point.Radius, err = strconv.ParseFloat(value, 64); err != nil {
+				return s.ErrorAt(valuePos, "invalid radius %q", value)
+			}
+		case "color":
+			point.Color = value
+		case "stroke-color":
+			point.StrokeColor = value
+		case "stroke-width":
+			if point.StrokeWidth, err = strconv.ParseFloat(strings.TrimSuffix(value, "px"), 64); err != nil {
+				return s.ErrorAt(valuePos, "invalid stroke width %q", value)
+			}
+		default:
+			return s.ErrorAt(keyPos, "unknown style %q", strings.TrimSpace(key))
+		}
+
+		s.SkipSpaces()
+	}
+
+	return nil
+}
+
+// readNumber reads a coordinate up to delimiter, which is consumed as well.
+func readNumber(s *parser.Scanner, delimiter string) (float64, error) {
+	s.SkipSpaces()
+	pos := s.Pos()
+
+	text, ok := s.ReadUntil(delimiter)
+	if !ok {
+		return 0, s.Errorf("expected %q", delimiter)
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+	if err != nil {
+		return 0, s.ErrorAt(pos, "invalid coordinate %q", strings.TrimSpace(text))
+	}
+
+	return value, nil
+}
+
+// readLabel reads a label written either quoted or plain. A plain label
+// ends at delimiter, or at the end of the line when delimiter is empty.
+func readLabel(s *parser.Scanner, delimiter string) (string, error) {
+	s.SkipSpaces()
+
+	if s.HasPrefix(`"`) {
+		quoted, err := s.ReadQuoted()
+		if err != nil {
+			return "", err
+		}
+		s.SkipSpaces()
+		return basediagram.Unescape(quoted), nil
+	}
+
+	text := s.Rest()
+	if delimiter != "" {
+		if i := strings.Index(text, delimiter); i >= 0 {
+			text = text[:i]
+		}
+	}
+	s.Advance(len(text))
+
+	return basediagram.Unescape(strings.TrimSpace(text)), nil
+}
@@ -0,0 +1,176 @@
+package quadrant
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty chart",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Chart with title, config, theme variables and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Tech debt"
+				d.Config.SetChartWidth(500).SetXAxisPosition("top")
+				d.Config.SetQuadrant1Fill("#ff0000").SetQuadrantPointFill("#00ff00")
+				d.EnableMarkdownFence()
+				d.SetXAxis("Low effort", "High effort")
+			},
+		},
+		{
+			name: "Chart with labels and points",
+			setup: func(d *Diagram) {
+				d.SetXAxis("Low Reach", "High Reach")
+				d.SetYAxis("Low Engagement", "")
+				d.SetQuadrantLabel(QuadrantTopRight, "We should expand")
+				d.SetQuadrantLabel(QuadrantBottomLeft, "Re-evaluate")
+				d.AddPoint("Campaign A", 0.3, 0.6)
+				d.AddPoint("Campaign B", 0.45, 0.23).SetRadius(12).SetColor("#ff3300")
+				d.AddPoint("Campaign C", 0.57, 0.69).SetStrokeColor("#10f0f0").SetStrokeWidth(2.5)
+			},
+		},
+		{
+			name: "Chart with text that needs escaping",
+			setup: func(d *Diagram) {
+				d.SetXAxis("a --> b", `"quoted"`)
+				d.SetQuadrantLabel(QuadrantTopLeft, "Issue #1; done")
+				d.AddPoint("Name: with colon", 0.5, 0.5)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `quadrantChart
+    title Reach and engagement of campaigns
+    x-axis Low Reach --> High Reach
+    y-axis Low Engagement
+    quadrant-1 We should expand
+    Campaign A: [0.3, 0.6]
+    Point D: [0.6, 0.3] radius: 15, stroke-color: #00ff0f, stroke-width: 5px ,color: #ff33f0
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if d.Title != "Reach and engagement of campaigns" {
+		t.Errorf("Parse() title = %q", d.Title)
+	}
+
+	if d.XAxis != (Axis{Low: "Low Reach", High: "High Reach"}) || d.YAxis != (Axis{Low: "Low Engagement"}) {
+		t.Errorf("Parse() axes = %+v and %+v", d.XAxis, d.YAxis)
+	}
+
+	if d.QuadrantLabels[0] != "We should expand" {
+		t.Errorf("Parse() quadrant labels = %q", d.QuadrantLabels)
+	}
+
+	if len(d.Points) != 2 {
+		t.Fatalf("Parse() got %d points, want 2", len(d.Points))
+	}
+
+	want := Point{Name: "Point D", X: 0.6, Y: 0.3, Radius: 15, Color: "#ff33f0", StrokeColor: "#00ff0f", StrokeWidth: 5}
+	if *d.Points[1] != want {
+		t.Errorf("Parse() point = %+v, want %+v", *d.Points[1], want)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "x-axis Low\n",
+			line:    1,
+			column:  1,
+			message: "expected quadrantChart declaration",
+		},
+		{
+			name:    "Unknown statement",
+			input:   "quadrantChart\n    quadrant-5 Extra\n",
+			line:    2,
+			column:  5,
+			message: `unknown statement "quadrant-5 Extra"`,
+		},
+		{
+			name:    "Invalid coordinate",
+			input:   "quadrantChart\n    A: [high, 0.5]\n",
+			line:    2,
+			column:  9,
+			message: `invalid coordinate "high"`,
+		},
+		{
+			name:    "Missing closing bracket",
+			input:   "quadrantChart\n    A: [0.5, 0.5\n",
+			line:    2,
+			column:  14,
+			message: `expected "]"`,
+		},
+		{
+			name:    "Unknown style",
+			input:   "quadrantChart\n    A: [0.5, 0.5] size: 4\n",
+			line:    2,
+			column:  19,
+			message: `unknown style "size"`,
+		},
+		{
+			name:    "Text after quoted axis label",
+			input:   "quadrantChart\n    x-axis \"Low\" High\n",
+			line:    2,
+			column:  18,
+			message: `unexpected "High"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package quadrant
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for points
+const (
+	basePoint            string = basediagram.Indentation + "%s: [%s, %s]"
+	basePointRadius      string = "radius: %s"
+	basePointColor       string = "color: %s"
+	basePointStrokeColor string = "stroke-color: %s"
+	basePointStrokeWidth string = "stroke-width: %spx"
+)
+
+// Point represents a point of the chart. X and Y go from 0 to 1, from the
+// low end to the high end of each axis. The styling fields are optional:
+// a zero Radius or StrokeWidth and an empty color keep the theme defaults.
+type Point struct {
+	Name        string
+	X           float64
+	Y           float64
+	Radius      float64
+	Color       string
+	StrokeColor string
+	StrokeWidth float64
+}
+
+// NewPoint creates a new point with the default style
+func NewPoint(name string, x float64, y float64) *Point {
+	return &Point{
+		Name: name,
+		X:    x,
+		Y:    y,
+	}
+}
+
+// SetRadius sets the point radius and returns the point for chaining
+func (p *Point) SetRadius(radius float64) *Point {
+	p.Radius = radius
+	return p
+}
+
+// SetColor sets the point fill color and returns the point for chaining
+func (p *Point) SetColor(color string) *Point {
+	p.Color = color
+	return p
+}
+
+// SetStrokeColor sets the point border color and returns the point for chaining
+func (p *Point) SetStrokeColor(color string) *Point {
+	p.StrokeColor = color
+	return p
+}
+
+// SetStrokeWidth sets the point border width in pixels and returns the point for chaining
+func (p *Point) SetStrokeWidth(width float64) *Point {
+	p.StrokeWidth = width
+	return p
+}
+
+// String generates the Mermaid syntax for the point
+func (p *Point) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf(basePoint, basediagram.Quote(p.Name), formatNumber(p.X), formatNumber(p.Y)))
+
+	var styles []string
+	if p.Radius > 0 {
+		styles = append(styles, fmt.Sprintf(basePointRadius, formatNumber(p.Radius)))
+	}
+	if p.Color != "" {
+		styles = append(styles, fmt.Sprintf(basePointColor, p.Color))
+	}
+	if p.StrokeColor != "" {
+		styles = append(styles, fmt.Sprintf(basePointStrokeColor, p.StrokeColor))
+	}
+	if p.StrokeWidth > 0 {
+		styles = append(styles, fmt.Sprintf(basePointStrokeWidth, formatNumber(p.StrokeWidth)))
+	}
+	if len(styles) > 0 {
+		sb.WriteString(" ")
+		sb.WriteString(strings.Join(styles, ", "))
+	}
+
+	sb.WriteString("\n")
+
+	return sb.String()
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,59 @@
+package quadrant
+
+import "testing"
+
+func TestPoint_Setters(t *testing.T) {
+	point := NewPoint("A", 0.5, 0.5)
+
+	result := point.SetRadius(10).SetColor("#ff0000").SetStrokeColor("#00ff00").SetStrokeWidth(2)
+	if result != point {
+		t.Error("Setters should return point for chaining")
+	}
+
+	want := Point{Name: "A", X: 0.5, Y: 0.5, Radius: 10, Color: "#ff0000", StrokeColor: "#00ff00", StrokeWidth: 2}
+	if *point != want {
+		t.Errorf("Setters = %+v, want %+v", *point, want)
+	}
+}
+
+func TestPoint_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		point *Point
+		want  string
+	}{
+		{
+			name:  "Point with default style",
+			point: NewPoint("Campaign A", 0.3, 0.6),
+			want:  "    \"Campaign A\": [0.3, 0.6]\n",
+		},
+		{
+			name:  "Point on the edges",
+			point: NewPoint("Origin", 0, 1),
+			want:  "    \"Origin\": [0, 1]\n",
+		},
+		{
+			name:  "Point with every style",
+			point: NewPoint("Point D", 0.6, 0.3).SetRadius(15).SetColor("#ff33f0").SetStrokeColor("#00ff0f").SetStrokeWidth(5),
+			want:  "    \"Point D\": [0.6, 0.3] radius: 15, color: #ff33f0, stroke-color: #00ff0f, stroke-width: 5px\n",
+		},
+		{
+			name:  "Point with negative sizes",
+			point: NewPoint("A", 0.5, 0.5).SetRadius(-1).SetStrokeWidth(-1),
+			want:  "    \"A\": [0.5, 0.5]\n",
+		},
+		{
+			name:  "Point name that needs escaping",
+			point: NewPoint(`Say "hi"`, 0.5, 0.5),
+			want:  "    \"Say #quot;hi#quot;\": [0.5, 0.5]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.point.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package quadrant
+
+// List of the theme variables specific to quadrant charts.
+// Reference: https://mermaid.js.org/syntax/quadrantChart.html#available-theme-variables
+const (
+	ThemeVarQuadrant1Fill                    = "quadrant1Fill"
+	ThemeVarQuadrant2Fill                    = "quadrant2Fill"
+	ThemeVarQuadrant3Fill                    = "quadrant3Fill"
+	ThemeVarQuadrant4Fill                    = "quadrant4Fill"
+	ThemeVarQuadrant1TextFill                = "quadrant1TextFill"
+	ThemeVarQuadrant2TextFill                = "quadrant2TextFill"
+	ThemeVarQuadrant3TextFill                = "quadrant3TextFill"
+	ThemeVarQuadrant4TextFill                = "quadrant4TextFill"
+	ThemeVarQuadrantPointFill                = "quadrantPointFill"
+	ThemeVarQuadrantPointTextFill            = "quadrantPointTextFill"
+	ThemeVarQuadrantXAxisTextFill            = "quadrantXAxisTextFill"
+	ThemeVarQuadrantYAxisTextFill            = "quadrantYAxisTextFill"
+	ThemeVarQuadrantInternalBorderStrokeFill = "quadrantInternalBorderStrokeFill"
+	ThemeVarQuadrantExternalBorderStrokeFill = "quadrantExternalBorderStrokeFill"
+	ThemeVarQuadrantTitleFill                = "quadrantTitleFill"
+)
+
+// setThemeVariable sets a quadrant chart theme variable in the themeVariables
+// section shared with the common theme variables.
+func (c *QuadrantConfigurationProperties) setThemeVariable(name string, v string) *QuadrantConfigurationProperties {
+	if c.Theme.Variables == nil {
+		c.Theme.Variables = make(map[string]interface{})
+	}
+	c.Theme.Variables[name] = v
+	return c
+}
+
+// SetQuadrant1Fill sets the fill color of the top right quadrant
+func (c *QuadrantConfigurationProperties) SetQuadrant1Fill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrant1Fill, v)
+}
+
+// SetQuadrant2Fill sets the fill color of the top left quadrant
+func (c *QuadrantConfigurationProperties) SetQuadrant2Fill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrant2Fill, v)
+}
+
+// SetQuadrant3Fill sets the fill color of the bottom left quadrant
+func (c *QuadrantConfigurationProperties) SetQuadrant3Fill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrant3Fill, v)
+}
+
+// SetQuadrant4Fill sets the fill color of the bottom right quadrant
+func (c *QuadrantConfigurationProperties) SetQuadrant4Fill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrant4Fill, v)
+}
+
+// SetQuadrant1TextFill sets the text color of the top right quadrant
+func (c *QuadrantConfigurationProperties) SetQuadrant1TextFill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrant1TextFill, v)
+}
+
+// SetQuadrant2TextFill sets the text color of the top left quadrant
+func (c *QuadrantConfigurationProperties) SetQuadrant2TextFill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrant2TextFill, v)
+}
+
+// SetQuadrant3TextFill sets the text color of the bottom left quadrant
+func (c *QuadrantConfigurationProperties) SetQuadrant3TextFill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrant3TextFill, v)
+}
+
+// SetQuadrant4TextFill sets the text color of the bottom right quadrant
+func (c *QuadrantConfigurationProperties) SetQuadrant4TextFill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrant4TextFill, v)
+}
+
+// SetQuadrantPointFill sets the default fill color of the points
+func (c *QuadrantConfigurationProperties) SetQuadrantPointFill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrantPointFill, v)
+}
+
+// SetQuadrantPointTextFill sets the text color of the point labels
+func (c *QuadrantConfigurationProperties) SetQuadrantPointTextFill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrantPointTextFill, v)
+}
+
+// SetQuadrantXAxisTextFill sets the text color of the x-axis labels
+func (c *QuadrantConfigurationProperties) SetQuadrantXAxisTextFill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrantXAxisTextFill, v)
+}
+
+// SetQuadrantYAxisTextFill sets the text color of the y-axis labels
+func (c *QuadrantConfigurationProperties) SetQuadrantYAxisTextFill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrantYAxisTextFill, v)
+}
+
+// SetQuadrantInternalBorderStrokeFill sets the color of the borders between the quadrants
+func (c *QuadrantConfigurationProperties) SetQuadrantInternalBorderStrokeFill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrantInternalBorderStrokeFill, v)
+}
+
+// SetQuadrantExternalBorderStrokeFill sets the color of the border around the chart
+func (c *QuadrantConfigurationProperties) SetQuadrantExternalBorderStrokeFill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrantExternalBorderStrokeFill, v)
+}
+
+// SetQuadrantTitleFill sets the text color of the title
+func (c *QuadrantConfigurationProperties) SetQuadrantTitleFill(v string) *QuadrantConfigurationProperties {
+	return c.setThemeVariable(ThemeVarQuadrantTitleFill, v)
+}
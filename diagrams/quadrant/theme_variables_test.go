@@ -0,0 +1,137 @@
+package quadrant
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestQuadrantConfigurationProperties_ThemeVariables(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*QuadrantConfigurationProperties) *QuadrantConfigurationProperties
+		variable string
+	}{
+		{
+			name: "Set quadrant1Fill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrant1Fill("#f96")
+			},
+			variable: ThemeVarQuadrant1Fill,
+		},
+		{
+			name: "Set quadrant2Fill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrant2Fill("#f96")
+			},
+			variable: ThemeVarQuadrant2Fill,
+		},
+		{
+			name: "Set quadrant3Fill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrant3Fill("#f96")
+			},
+			variable: ThemeVarQuadrant3Fill,
+		},
+		{
+			name: "Set quadrant4Fill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrant4Fill("#f96")
+			},
+			variable: ThemeVarQuadrant4Fill,
+		},
+		{
+			name: "Set quadrant1TextFill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrant1TextFill("#f96")
+			},
+			variable: ThemeVarQuadrant1TextFill,
+		},
+		{
+			name: "Set quadrant2TextFill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrant2TextFill("#f96")
+			},
+			variable: ThemeVarQuadrant2TextFill,
+		},
+		{
+			name: "Set quadrant3TextFill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrant3TextFill("#f96")
+			},
+			variable: ThemeVarQuadrant3TextFill,
+		},
+		{
+			name: "Set quadrant4TextFill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrant4TextFill("#f96")
+			},
+			variable: ThemeVarQuadrant4TextFill,
+		},
+		{
+			name: "Set quadrantPointFill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrantPointFill("#f96")
+			},
+			variable: ThemeVarQuadrantPointFill,
+		},
+		{
+			name: "Set quadrantPointTextFill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrantPointTextFill("#f96")
+			},
+			variable: ThemeVarQuadrantPointTextFill,
+		},
+		{
+			name: "Set quadrantXAxisTextFill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrantXAxisTextFill("#f96")
+			},
+			variable: ThemeVarQuadrantXAxisTextFill,
+		},
+		{
+			name: "Set quadrantYAxisTextFill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrantYAxisTextFill("#f96")
+			},
+			variable: ThemeVarQuadrantYAxisTextFill,
+		},
+		{
+			name: "Set quadrantInternalBorderStrokeFill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrantInternalBorderStrokeFill("#f96")
+			},
+			variable: ThemeVarQuadrantInternalBorderStrokeFill,
+		},
+		{
+			name: "Set quadrantExternalBorderStrokeFill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrantExternalBorderStrokeFill("#f96")
+			},
+			variable: ThemeVarQuadrantExternalBorderStrokeFill,
+		},
+		{
+			name: "Set quadrantTitleFill",
+			setup: func(c *QuadrantConfigurationProperties) *QuadrantConfigurationProperties {
+				return c.SetQuadrantTitleFill("#f96")
+			},
+			variable: ThemeVarQuadrantTitleFill,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewQuadrantConfigurationProperties()
+			if result := tt.setup(&config); result != &config {
+				t.Error("Setter should return config for chaining")
+			}
+
+			if got := config.Theme.Variables[tt.variable]; got != "#f96" {
+				t.Errorf("theme variable %s = %v, want #f96", tt.variable, got)
+			}
+
+			if want := tt.variable + `: "#f96"`; !strings.Contains(config.String(), want) {
+				t.Errorf("String() missing expected content %q in:\n%s", want, config.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package quadrant
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// axes with only a high label, points without name, coordinates outside of
+// [0, 1], negative sizes and colors that would break the point syntax.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	validateAxis(&v, "XAxis", d.XAxis)
+	validateAxis(&v, "YAxis", d.YAxis)
+
+	for i, point := range d.Points {
+		path := fmt.Sprintf("Points[%d]", i)
+		if point == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing point")
+			continue
+		}
+
+		if point.Name == "" {
+			v.Error(basediagram.CodeInvalidValue, path+".Name", "point has no name")
+		}
+
+		validateCoordinate(&v, path+".X", point.X)
+		validateCoordinate(&v, path+".Y", point.Y)
+
+		if point.Radius < 0 {
+			v.Warning(basediagram.CodeIgnoredValue, path+".Radius", "negative radius %v is ignored", point.Radius)
+		}
+		if point.StrokeWidth < 0 {
+			v.Warning(basediagram.CodeIgnoredValue, path+".StrokeWidth", "negative stroke width %v is ignored", point.StrokeWidth)
+		}
+
+		validateColor(&v, path+".Color", point.Color)
+		validateColor(&v, path+".StrokeColor", point.StrokeColor)
+	}
+
+	return v.Errors()
+}
+
+func validateAxis(v *basediagram.Validator, path string, axis Axis) {
+	if axis.Low == "" && axis.High != "" {
+		v.Error(basediagram.CodeInvalidValue, path+".Low", "axis with high label %q has no low label", axis.High)
+	}
+}
+
+func validateCoordinate(v *basediagram.Validator, path string, value float64) {
+	switch {
+	case math.IsNaN(value):
+		v.Error(basediagram.CodeInvalidValue, path, "coordinate is not a number")
+	case value < 0 || value > 1:
+		v.Error(basediagram.CodeOutOfRange, path, "coordinate %v is outside of [0, 1]", value)
+	}
+}
+
+func validateColor(v *basediagram.Validator, path string, color string) {
+	if strings.ContainsAny(color, ", \t\n") {
+		v.Error(basediagram.CodeInvalidValue, path, "color %q is not a single value", color)
+	}
+}
@@ -0,0 +1,67 @@
+package quadrant
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				d.SetXAxis("Low", "High").SetYAxis("Low", "")
+				d.AddPoint("A", 0, 1).SetRadius(5).SetColor("#fff")
+			},
+		},
+		{
+			name: "Axis without low label",
+			setup: func(d *Diagram) {
+				d.SetYAxis("", "High")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "YAxis.Low", Message: `axis with high label "High" has no low label`},
+			},
+		},
+		{
+			name: "Invalid points",
+			setup: func(d *Diagram) {
+				d.AddPoint("", 1.5, math.NaN())
+				d.AddPoint("B", -0.1, 0.5).SetRadius(-2).SetStrokeWidth(-1).SetColor("red, blue")
+				d.Points = append(d.Points, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Points[0].Name", Message: "point has no name"},
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityError, Path: "Points[0].X", Message: "coordinate 1.5 is outside of [0, 1]"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Points[0].Y", Message: "coordinate is not a number"},
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityError, Path: "Points[1].X", Message: "coordinate -0.1 is outside of [0, 1]"},
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Points[1].Radius", Message: "negative radius -2 is ignored"},
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Points[1].StrokeWidth", Message: "negative stroke width -1 is ignored"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Points[1].Color", Message: `color "red, blue" is not a single value`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Points[2]", Message: "missing point"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package radar
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for axes
+const (
+	baseAxis string = basediagram.Indentation + "axis %s\n"
+)
+
+// Axis represents a spoke of the chart. The ID is displayed when the
+// axis has no label.
+type Axis struct {
+	ID    string
+	Label string
+}
+
+// NewAxis creates a new axis with the given ID and label
+func NewAxis(id string, label string) *Axis {
+	return &Axis{
+		ID:    id,
+		Label: label,
+	}
+}
+
+// SetLabel sets the axis label and returns the axis for chaining
+func (a *Axis) SetLabel(label string) *Axis {
+	a.Label = label
+	return a
+}
+
+// String generates the Mermaid syntax for the axis
+func (a *Axis) String() string {
+	return fmt.Sprintf(baseAxis, formatName(a.ID, a.Label))
+}
+
+// formatName returns an ID followed by its label, if any, as `id["Label"]`.
+func formatName(id string, label string) string {
+	if label == "" {
+		return id
+	}
+
+	return id + "[" + basediagram.Quote(label) + "]"
+}
@@ -0,0 +1,57 @@
+package radar
+
+import (
+	"testing"
+)
+
+func TestNewAxis(t *testing.T) {
+	got := NewAxis("math", "Math")
+
+	if got.ID != "math" || got.Label != "Math" {
+		t.Errorf("NewAxis() = %+v, want math labelled Math", got)
+	}
+}
+
+func TestAxis_SetLabel(t *testing.T) {
+	axis := NewAxis("math", "")
+
+	if got := axis.SetLabel("Mathematics"); got != axis {
+		t.Fatal("SetLabel() should return the axis for chaining")
+	}
+
+	if axis.Label != "Mathematics" {
+		t.Errorf("SetLabel() label = %q, want %q", axis.Label, "Mathematics")
+	}
+}
+
+func TestAxis_String(t *testing.T) {
+	tests := []struct {
+		name string
+		axis *Axis
+		want string
+	}{
+		{
+			name: "Axis without label",
+			axis: NewAxis("math", ""),
+			want: "    axis math\n",
+		},
+		{
+			name: "Axis with label",
+			axis: NewAxis("math", "Math"),
+			want: "    axis math[\"Math\"]\n",
+		},
+		{
+			name: "Axis with special characters",
+			axis: NewAxis("ops", `On-call "load" #1`),
+			want: "    axis ops[\"On-call #quot;load#quot; #35;1\"]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.axis.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package radar
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	radarConfigurationSection        string = "radar"
+	baseRadarConfigurationProperties string = basediagram.Indentation + radarConfigurationSection + ":\n"
+
+	radarPropertyWidth           string = "width"
+	radarPropertyHeight          string = "height"
+	radarPropertyMarginTop       string = "marginTop"
+	radarPropertyMarginRight     string = "marginRight"
+	radarPropertyMarginBottom    string = "marginBottom"
+	radarPropertyMarginLeft      string = "marginLeft"
+	radarPropertyAxisScaleFactor string = "axisScaleFactor"
+	radarPropertyAxisLabelFactor string = "axisLabelFactor"
+	radarPropertyCurveTension    string = "curveTension"
+	radarPropertyUseMaxWidth     string = "useMaxWidth"
+)
+
+// RadarConfigurationProperties holds radar-specific configuration
+type RadarConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewRadarConfigurationProperties() RadarConfigurationProperties {
+	return RadarConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *RadarConfigurationProperties) SetWidth(v int) *RadarConfigurationProperties {
+	c.properties[radarPropertyWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: radarPropertyWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RadarConfigurationProperties) SetHeight(v int) *RadarConfigurationProperties {
+	c.properties[radarPropertyHeight] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: radarPropertyHeight,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RadarConfigurationProperties) SetMarginTop(v int) *RadarConfigurationProperties {
+	c.properties[radarPropertyMarginTop] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: radarPropertyMarginTop,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RadarConfigurationProperties) SetMarginRight(v int) *RadarConfigurationProperties {
+	c.properties[radarPropertyMarginRight] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: radarPropertyMarginRight,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RadarConfigurationProperties) SetMarginBottom(v int) *RadarConfigurationProperties {
+	c.properties[radarPropertyMarginBottom] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: radarPropertyMarginBottom,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RadarConfigurationProperties) SetMarginLeft(v int) *RadarConfigurationProperties {
+	c.properties[radarPropertyMarginLeft] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: radarPropertyMarginLeft,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RadarConfigurationProperties) SetAxisScaleFactor(v float64) *RadarConfigurationProperties {
+	c.properties[radarPropertyAxisScaleFactor] = &basediagram.FloatProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: radarPropertyAxisScaleFactor,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RadarConfigurationProperties) SetAxisLabelFactor(v float64) *RadarConfigurationProperties {
+	c.properties[radarPropertyAxisLabelFactor] = &basediagram.FloatProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: radarPropertyAxisLabelFactor,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RadarConfigurationProperties) SetCurveTension(v float64) *RadarConfigurationProperties {
+	c.properties[radarPropertyCurveTension] = &basediagram.FloatProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: radarPropertyCurveTension,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RadarConfigurationProperties) SetUseMaxWidth(v bool) *RadarConfigurationProperties {
+	c.properties[radarPropertyUseMaxWidth] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: radarPropertyUseMaxWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c RadarConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(baseRadarConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,218 @@
+package radar
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewRadarConfigurationProperties(t *testing.T) {
+	got := NewRadarConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewRadarConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewRadarConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestRadarConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   RadarConfigurationProperties
+		setup    func(*RadarConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewRadarConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewRadarConfigurationProperties(),
+			setup: func(c *RadarConfigurationProperties) {
+				c.SetWidth(600)
+			},
+			contains: []string{
+				"radar:",
+				"width: 600",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewRadarConfigurationProperties(),
+			setup: func(c *RadarConfigurationProperties) {
+				c.SetHeight(600)
+				c.SetUseMaxWidth(true)
+			},
+			contains: []string{
+				"radar:",
+				"height: 600",
+				"useMaxWidth: true",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewRadarConfigurationProperties(),
+			setup: func(c *RadarConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetWidth(600)
+			},
+			contains: []string{
+				"fontSize: 12",
+				"radar:",
+				"width: 600",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRadarConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*RadarConfigurationProperties) *RadarConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set width",
+			setup: func(c *RadarConfigurationProperties) *RadarConfigurationProperties {
+				return c.SetWidth(600)
+			},
+			property: radarPropertyWidth,
+			value:    600,
+		},
+		{
+			name: "Set height",
+			setup: func(c *RadarConfigurationProperties) *RadarConfigurationProperties {
+				return c.SetHeight(600)
+			},
+			property: radarPropertyHeight,
+			value:    600,
+		},
+		{
+			name: "Set margin top",
+			setup: func(c *RadarConfigurationProperties) *RadarConfigurationProperties {
+				return c.SetMarginTop(50)
+			},
+			property: radarPropertyMarginTop,
+			value:    50,
+		},
+		{
+			name: "Set margin right",
+			setup: func(c *RadarConfigurationProperties) *RadarConfigurationProperties {
+				return c.SetMarginRight(50)
+			},
+			property: radarPropertyMarginRight,
+			value:    50,
+		},
+		{
+			name: "Set margin bottom",
+			setup: func(c *RadarConfigurationProperties) *RadarConfigurationProperties {
+				return c.SetMarginBottom(50)
+			},
+			property: radarPropertyMarginBottom,
+			value:    50,
+		},
+		{
+			name: "Set margin left",
+			setup: func(c *RadarConfigurationProperties) *RadarConfigurationProperties {
+				return c.SetMarginLeft(50)
+			},
+			property: radarPropertyMarginLeft,
+			value:    50,
+		},
+		{
+			name: "Set axis scale factor",
+			setup: func(c *RadarConfigurationProperties) *RadarConfigurationProperties {
+				return c.SetAxisScaleFactor(1.2)
+			},
+			property: radarPropertyAxisScaleFactor,
+			value:    1.2,
+		},
+		{
+			name: "Set axis label factor",
+			setup: func(c *RadarConfigurationProperties) *RadarConfigurationProperties {
+				return c.SetAxisLabelFactor(1.05)
+			},
+			property: radarPropertyAxisLabelFactor,
+			value:    1.05,
+		},
+		{
+			name: "Set curve tension",
+			setup: func(c *RadarConfigurationProperties) *RadarConfigurationProperties {
+				return c.SetCurveTension(0.17)
+			},
+			property: radarPropertyCurveTension,
+			value:    0.17,
+		},
+		{
+			name: "Set use max width",
+			setup: func(c *RadarConfigurationProperties) *RadarConfigurationProperties {
+				return c.SetUseMaxWidth(true)
+			},
+			property: radarPropertyUseMaxWidth,
+			value:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewRadarConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
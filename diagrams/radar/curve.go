@@ -0,0 +1,58 @@
+package radar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for curves
+const (
+	baseCurve string = basediagram.Indentation + "curve %s{%s}\n"
+)
+
+// Curve represents a series of values drawn as a closed line, one value
+// per axis in the order of the axes of the chart. The ID is displayed in
+// the legend when the curve has no label.
+type Curve struct {
+	ID     string
+	Label  string
+	Values []float64
+}
+
+// NewCurve creates a new curve with the given ID, label and values
+func NewCurve(id string, label string, values ...float64) *Curve {
+	return &Curve{
+		ID:     id,
+		Label:  label,
+		Values: values,
+	}
+}
+
+// SetLabel sets the curve label and returns the curve for chaining
+func (c *Curve) SetLabel(label string) *Curve {
+	c.Label = label
+	return c
+}
+
+// AddValue appends the value for the next axis and returns the curve for chaining
+func (c *Curve) AddValue(value float64) *Curve {
+	c.Values = append(c.Values, value)
+	return c
+}
+
+// String generates the Mermaid syntax for the curve
+func (c *Curve) String() string {
+	values := make([]string, len(c.Values))
+	for i, value := range c.Values {
+		values[i] = formatNumber(value)
+	}
+
+	return fmt.Sprintf(baseCurve, formatName(c.ID, c.Label), strings.Join(values, ", "))
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
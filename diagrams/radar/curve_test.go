@@ -0,0 +1,53 @@
+package radar
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewCurve(t *testing.T) {
+	got := NewCurve("alice", "Alice", 85, 90)
+
+	if got.ID != "alice" || got.Label != "Alice" || !reflect.DeepEqual(got.Values, []float64{85, 90}) {
+		t.Errorf("NewCurve() = %+v, want alice with 85 and 90", got)
+	}
+}
+
+func TestCurve_Setters(t *testing.T) {
+	curve := NewCurve("alice", "")
+
+	if got := curve.SetLabel("Alice").AddValue(1).AddValue(2.5); got != curve {
+		t.Fatal("Setters should return the curve for chaining")
+	}
+
+	if curve.Label != "Alice" || !reflect.DeepEqual(curve.Values, []float64{1, 2.5}) {
+		t.Errorf("Setters = %+v, want label Alice with 1 and 2.5", curve)
+	}
+}
+
+func TestCurve_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		curve *Curve
+		want  string
+	}{
+		{
+			name:  "Curve without values",
+			curve: NewCurve("empty", ""),
+			want:  "    curve empty{}\n",
+		},
+		{
+			name:  "Curve with label and values",
+			curve: NewCurve("alice", "Alice", 85, 90.5, -3, 1200000),
+			want:  "    curve alice[\"Alice\"]{85, 90.5, -3, 1200000}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.curve.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Package radar provides functionality for creating Mermaid radar charts
+package radar
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+type graticuleShape string
+
+// List of possible graticule shapes.
+const (
+	GraticuleDefault graticuleShape = ""
+	GraticuleCircle  graticuleShape = "circle"
+	GraticulePolygon graticuleShape = "polygon"
+)
+
+// Base string formats for radar charts
+const (
+	diagramType          string = "radar-beta"
+	baseDiagramType      string = diagramType + "\n"
+	baseDiagramMax       string = basediagram.Indentation + "max %s\n"
+	baseDiagramMin       string = basediagram.Indentation + "min %s\n"
+	baseDiagramTicks     string = basediagram.Indentation + "ticks %d\n"
+	baseDiagramGraticule string = basediagram.Indentation + "graticule %s\n"
+	baseDiagramLegend    string = basediagram.Indentation + "showLegend %t\n"
+)
+
+// Diagram represents a Mermaid radar chart: curves of values drawn over
+// axes spreading from a common center. Max, Min and ShowLegend are left to
+// Mermaid when nil, as are Ticks when zero and the graticule when default.
+// Reference: https://mermaid.js.org/syntax/radar.html
+type Diagram struct {
+	basediagram.BaseDiagram[RadarConfigurationProperties]
+	Axes       []*Axis
+	Curves     []*Curve
+	Max        *float64
+	Min        *float64
+	Ticks      int
+	Graticule  graticuleShape
+	ShowLegend *bool
+}
+
+// NewDiagram creates a new radar chart without axes
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram: basediagram.NewBaseDiagram(NewRadarConfigurationProperties()),
+		Axes:        make([]*Axis, 0),
+		Curves:      make([]*Curve, 0),
+	}
+}
+
+// AddAxis creates and adds a new axis
+func (d *Diagram) AddAxis(id string, label string) *Axis {
+	axis := NewAxis(id, label)
+	d.Axes = append(d.Axes, axis)
+	return axis
+}
+
+// AddCurve creates and adds a new curve with one value per axis
+func (d *Diagram) AddCurve(id string, label string, values ...float64) *Curve {
+	curve := NewCurve(id, label, values...)
+	d.Curves = append(d.Curves, curve)
+	return curve
+}
+
+// SetMax sets the value at the outer edge of the chart and returns the diagram for chaining
+func (d *Diagram) SetMax(max float64) *Diagram {
+	d.Max = &max
+	return d
+}
+
+// SetMin sets the value at the center of the chart and returns the diagram for chaining
+func (d *Diagram) SetMin(min float64) *Diagram {
+	d.Min = &min
+	return d
+}
+
+// SetTicks sets the number of graticule rings and returns the diagram for chaining
+func (d *Diagram) SetTicks(ticks int) *Diagram {
+	d.Ticks = ticks
+	return d
+}
+
+// SetGraticule sets the shape of the graticule and returns the diagram for chaining
+func (d *Diagram) SetGraticule(graticule graticuleShape) *Diagram {
+	d.Graticule = graticule
+	return d
+}
+
+// SetShowLegend sets whether the legend is displayed and returns the diagram for chaining
+func (d *Diagram) SetShowLegend(showLegend bool) *Diagram {
+	d.ShowLegend = &showLegend
+	return d
+}
+
+// String generates the Mermaid syntax for the radar chart
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces a radar chart.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one element at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		for _, axis := range d.Axes {
+			if axis != nil {
+				w.WriteString(axis.String())
+			}
+		}
+
+		for _, curve := range d.Curves {
+			if curve != nil {
+				w.WriteString(curve.String())
+			}
+		}
+
+		if d.Max != nil {
+			w.Printf(baseDiagramMax, formatNumber(*d.Max))
+		}
+		if d.Min != nil {
+			w.Printf(baseDiagramMin, formatNumber(*d.Min))
+		}
+		if d.Ticks != 0 {
+			w.Printf(baseDiagramTicks, d.Ticks)
+		}
+		if d.Graticule != GraticuleDefault {
+			w.Printf(baseDiagramGraticule, d.Graticule)
+		}
+		if d.ShowLegend != nil {
+			w.Printf(baseDiagramLegend, *d.ShowLegend)
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package radar
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if len(diagram.Axes) != 0 || len(diagram.Curves) != 0 {
+		t.Error("NewDiagram() should create empty axes and curves")
+	}
+
+	if diagram.Max != nil || diagram.Min != nil || diagram.ShowLegend != nil || diagram.Ticks != 0 || diagram.Graticule != GraticuleDefault {
+		t.Errorf("NewDiagram() = %+v, want options left to Mermaid", diagram)
+	}
+}
+
+func TestDiagram_AddAxis(t *testing.T) {
+	diagram := NewDiagram()
+	axis := diagram.AddAxis("math", "Math")
+
+	if len(diagram.Axes) != 1 || diagram.Axes[0] != axis {
+		t.Fatalf("AddAxis() axes = %v, want [%v]", diagram.Axes, axis)
+	}
+
+	if axis.ID != "math" || axis.Label != "Math" {
+		t.Errorf("AddAxis() = %+v, want math labelled Math", axis)
+	}
+}
+
+func TestDiagram_AddCurve(t *testing.T) {
+	diagram := NewDiagram()
+	curve := diagram.AddCurve("alice", "Alice", 85, 90)
+
+	if len(diagram.Curves) != 1 || diagram.Curves[0] != curve {
+		t.Fatalf("AddCurve() curves = %v, want [%v]", diagram.Curves, curve)
+	}
+
+	if curve.ID != "alice" || curve.Label != "Alice" || !reflect.DeepEqual(curve.Values, []float64{85, 90}) {
+		t.Errorf("AddCurve() = %+v, want alice with 85 and 90", curve)
+	}
+}
+
+func TestDiagram_Setters(t *testing.T) {
+	diagram := NewDiagram()
+
+	got := diagram.SetMax(100).SetMin(0).SetTicks(4).SetGraticule(GraticulePolygon).SetShowLegend(false)
+	if got != diagram {
+		t.Fatal("Setters should return the diagram for chaining")
+	}
+
+	if diagram.Max == nil || *diagram.Max != 100 || diagram.Min == nil || *diagram.Min != 0 {
+		t.Errorf("SetMax() and SetMin() = %v and %v, want 100 and 0", diagram.Max, diagram.Min)
+	}
+
+	if diagram.Ticks != 4 || diagram.Graticule != GraticulePolygon || diagram.ShowLegend == nil || *diagram.ShowLegend {
+		t.Errorf("Setters = %+v, want 4 ticks, polygon graticule and hidden legend", diagram)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func() *Diagram
+		want  string
+	}{
+		{
+			name: "Empty diagram",
+			setup: func() *Diagram {
+				return NewDiagram()
+			},
+			want: "radar-beta\n",
+		},
+		{
+			name: "Complete diagram",
+			setup: func() *Diagram {
+				d := NewDiagram()
+				d.SetTitle("Grades")
+				d.AddAxis("m", "Math")
+				d.AddAxis("s", "")
+				d.Axes = append(d.Axes, nil)
+				d.AddCurve("a", "Alice", 85, 90)
+				d.Curves = append(d.Curves, nil)
+				d.AddCurve("b", "", 70, 75.5)
+				d.SetMax(100).SetMin(0).SetTicks(5).SetGraticule(GraticuleCircle).SetShowLegend(true)
+				return d
+			},
+			want: "radar-beta\n" +
+				"    axis m[\"Math\"]\n" +
+				"    axis s\n" +
+				"    curve a[\"Alice\"]{85, 90}\n" +
+				"    curve b{70, 75.5}\n" +
+				"    max 100\n" +
+				"    min 0\n" +
+				"    ticks 5\n" +
+				"    graticule circle\n" +
+				"    showLegend true\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.setup().String()
+			if !strings.HasSuffix(got, "---\n"+tt.want) {
+				t.Errorf("String() = %q, want suffix %q", got, tt.want)
+			}
+		})
+	}
+}
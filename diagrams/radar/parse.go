@@ -0,0 +1,230 @@
+package radar
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Parse reads Mermaid radar chart syntax and returns the corresponding
+// Diagram. It understands the syntax generated by Diagram.String as well as
+// the `title` statement, several axes or curves declared in one statement
+// separated by commas, and curve values given by axis as `{math: 80, art: 75}`,
+// which are read into the order of the axes declared before the curve.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	d := NewDiagram()
+	d.Title = doc.Title
+	if err := doc.Config.Apply(&d.Config.ConfigurationProperties, radarConfigurationSection, d.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := parseLine(d, line); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+func parseLine(d *Diagram, line parser.Line) error {
+	keyword, rest, _ := strings.Cut(line.Text, " ")
+	valuePos := len(line.Text) - len(strings.TrimLeft(rest, " "))
+	rest = strings.TrimSpace(rest)
+
+	switch keyword {
+	case "title":
+		d.Title = rest
+		return nil
+	case "accTitle", "accDescr", "accTitle:", "accDescr:":
+		return line.Errorf(0, "unsupported statement %q", strings.TrimSuffix(keyword, ":"))
+	case "axis":
+		return parseList(line, keyword, func(s *parser.Scanner) error {
+			id, label, err := readName(s)
+			if err != nil {
+				return err
+			}
+			d.AddAxis(id, label)
+			return nil
+		})
+	case "curve":
+		return parseList(line, keyword, func(s *parser.Scanner) error {
+			return parseCurve(d, s)
+		})
+	case "max", "min":
+		value, err := strconv.ParseFloat(rest, 64)
+		if err != nil {
+			return line.Errorf(valuePos, "invalid %s %q", keyword, rest)
+		}
+		if keyword == "max" {
+			d.SetMax(value)
+		} else {
+			d.SetMin(value)
+		}
+		return nil
+	case "ticks":
+		ticks, err := strconv.Atoi(rest)
+		if err != nil {
+			return line.Errorf(valuePos, "invalid ticks %q", rest)
+		}
+		d.SetTicks(ticks)
+		return nil
+	case "graticule":
+		switch graticule := graticuleShape(rest); graticule {
+		case GraticuleCircle, GraticulePolygon:
+			d.SetGraticule(graticule)
+			return nil
+		}
+		return line.Errorf(valuePos, "unknown graticule %q", rest)
+	case "showLegend":
+		if rest != "true" && rest != "false" {
+			return line.Errorf(valuePos, "invalid showLegend %q", rest)
+		}
+		d.SetShowLegend(rest == "true")
+		return nil
+	}
+
+	return line.Errorf(0, "unknown statement %q", keyword)
+}
+
+// parseList reads the comma separated items following keyword.
+func parseList(line parser.Line, keyword string, parseItem func(*parser.Scanner) error) error {
+	s := parser.NewScanner(line)
+	s.Advance(len(keyword))
+
+	for {
+		s.SkipSpaces()
+		if err := parseItem(s); err != nil {
+			return err
+		}
+
+		s.SkipSpaces()
+		if s.EOF() {
+			return nil
+		}
+		if !s.Consume(",") {
+			return s.Errorf("unexpected %q", s.Rest())
+		}
+	}
+}
+
+// parseCurve reads `id["Label"]{values}`, where the values are either all
+// positional or all given by axis ID.
+func parseCurve(d *Diagram, s *parser.Scanner) error {
+	id, label, err := readName(s)
+	if err != nil {
+		return err
+	}
+	s.SkipSpaces()
+
+	if !s.Consume("{") {
+		return s.Errorf("expected '{'")
+	}
+
+	var values []float64
+	byAxis := make(map[string]float64)
+	for i := 0; ; i++ {
+		s.SkipSpaces()
+		if i == 0 && s.Consume("}") {
+			break
+		}
+
+		entryPos := s.Pos()
+		entry := s.ReadWhile(func(r rune) bool { return r != ',' && r != '}' })
+		key, text, keyed := strings.Cut(entry, ":")
+		if !keyed {
+			key, text = "", key
+		}
+		key, text = strings.TrimSpace(key), strings.TrimSpace(text)
+
+		if i > 0 && keyed != (len(byAxis) > 0) {
+			return s.ErrorAt(entryPos, "mixed positional values and values by axis")
+		}
+
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return s.ErrorAt(entryPos, "invalid value %q", strings.TrimSpace(entry))
+		}
+
+		if keyed {
+			if !hasAxis(d, key) {
+				return s.ErrorAt(entryPos, "unknown axis %q", key)
+			}
+			if _, ok := byAxis[key]; ok {
+				return s.ErrorAt(entryPos, "duplicate value for axis %q", key)
+			}
+			byAxis[key] = value
+		} else {
+			values = append(values, value)
+		}
+
+		if s.Consume("}") {
+			break
+		}
+		if !s.Consume(",") {
+			return s.Errorf("expected '}'")
+		}
+	}
+
+	if len(byAxis) > 0 {
+		for _, axis := range d.Axes {
+			value, ok := byAxis[axis.ID]
+			if !ok {
+				return s.ErrorAt(s.Pos()-1, "missing value for axis %q", axis.ID)
+			}
+			values = append(values, value)
+		}
+	}
+
+	d.AddCurve(id, label, values...)
+
+	return nil
+}
+
+func hasAxis(d *Diagram, id string) bool {
+	for _, axis := range d.Axes {
+		if axis.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// readName reads an ID followed by an optional quoted label in brackets.
+func readName(s *parser.Scanner) (string, string, error) {
+	id := s.ReadWhile(parser.IsIdentifier)
+	if id == "" {
+		return "", "", s.Errorf("expected ID")
+	}
+
+	if !s.Consume("[") {
+		return id, "", nil
+	}
+	label, err := s.ReadQuoted()
+	if err != nil {
+		return "", "", err
+	}
+	if !s.Consume("]") {
+		return "", "", s.Errorf("expected ']'")
+	}
+
+	return id, basediagram.Unescape(label), nil
+}
@@ -0,0 +1,222 @@
+package radar
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Diagram with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Grades"
+				d.Config.SetWidth(500).SetCurveTension(0.3)
+				d.EnableMarkdownFence()
+				d.AddAxis("m", "Math")
+				d.AddCurve("a", "Alice", 85)
+			},
+		},
+		{
+			name: "Diagram with every option",
+			setup: func(d *Diagram) {
+				d.AddAxis("rel", `Reliability "SLO" #1`)
+				d.AddAxis("sec", "")
+				d.AddAxis("obs", "Observability")
+				d.AddCurve("payments", "Payments", 3.5, 4, 2)
+				d.AddCurve("search", "", 1, 0, -2)
+				d.AddCurve("empty", "")
+				d.SetMax(5).SetMin(-2).SetTicks(7).SetGraticule(GraticulePolygon).SetShowLegend(false)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `radar-beta
+  title Grades
+  axis m["Math"], s["Science"], e["English"]
+  curve a["Alice"]{85, 90, 80}, b["Bob"]{ e: 85, m: 70, s: 75 }
+
+  max 100
+  min 0
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if d.Title != "Grades" {
+		t.Errorf("Parse() title = %q, want %q", d.Title, "Grades")
+	}
+
+	wantAxes := []*Axis{NewAxis("m", "Math"), NewAxis("s", "Science"), NewAxis("e", "English")}
+	if !reflect.DeepEqual(d.Axes, wantAxes) {
+		t.Errorf("Parse() axes = %+v, want %+v", d.Axes, wantAxes)
+	}
+
+	wantCurves := []*Curve{NewCurve("a", "Alice", 85, 90, 80), NewCurve("b", "Bob", 70, 75, 85)}
+	if !reflect.DeepEqual(d.Curves, wantCurves) {
+		t.Errorf("Parse() curves = %+v, want %+v", d.Curves, wantCurves)
+	}
+
+	if d.Max == nil || *d.Max != 100 || d.Min == nil || *d.Min != 0 {
+		t.Errorf("Parse() max and min = %v and %v, want 100 and 0", d.Max, d.Min)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "axis m\n",
+			line:    1,
+			column:  1,
+			message: "expected radar-beta declaration",
+		},
+		{
+			name:    "Header with arguments",
+			input:   "radar-beta LR\n",
+			line:    1,
+			column:  12,
+			message: `unexpected "LR"`,
+		},
+		{
+			name:    "Unknown statement",
+			input:   "radar-beta\n    legend true\n",
+			line:    2,
+			column:  5,
+			message: `unknown statement "legend"`,
+		},
+		{
+			name:    "Missing axis ID",
+			input:   "radar-beta\n    axis m, [\"Science\"]\n",
+			line:    2,
+			column:  13,
+			message: "expected ID",
+		},
+		{
+			name:    "Unquoted label",
+			input:   "radar-beta\n    axis m[Math]\n",
+			line:    2,
+			column:  12,
+			message: "expected '\"'",
+		},
+		{
+			name:    "Missing values",
+			input:   "radar-beta\n    curve a[\"Alice\"]\n",
+			line:    2,
+			column:  21,
+			message: "expected '{'",
+		},
+		{
+			name:    "Invalid value",
+			input:   "radar-beta\n    curve a{1, two}\n",
+			line:    2,
+			column:  16,
+			message: `invalid value "two"`,
+		},
+		{
+			name:    "Unterminated values",
+			input:   "radar-beta\n    curve a{1, 2\n",
+			line:    2,
+			column:  17,
+			message: "expected '}'",
+		},
+		{
+			name:    "Mixed values",
+			input:   "radar-beta\n    axis m, s\n    curve a{1, s: 2}\n",
+			line:    3,
+			column:  16,
+			message: "mixed positional values and values by axis",
+		},
+		{
+			name:    "Unknown axis",
+			input:   "radar-beta\n    axis m\n    curve a{x: 2}\n",
+			line:    3,
+			column:  13,
+			message: `unknown axis "x"`,
+		},
+		{
+			name:    "Missing value for an axis",
+			input:   "radar-beta\n    axis m, s\n    curve a{s: 2}\n",
+			line:    3,
+			column:  17,
+			message: `missing value for axis "m"`,
+		},
+		{
+			name:    "Invalid max",
+			input:   "radar-beta\n    max  high\n",
+			line:    2,
+			column:  10,
+			message: `invalid max "high"`,
+		},
+		{
+			name:    "Unknown graticule",
+			input:   "radar-beta\n    graticule square\n",
+			line:    2,
+			column:  15,
+			message: `unknown graticule "square"`,
+		},
+		{
+			name:    "Invalid legend flag",
+			input:   "radar-beta\n    showLegend yes\n",
+			line:    2,
+			column:  16,
+			message: `invalid showLegend "yes"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
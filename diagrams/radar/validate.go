@@ -0,0 +1,95 @@
+package radar
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// missing, duplicate or multi-word IDs, checked separately for axes and
+// curves, curves without exactly one value per axis, values that are not
+// numbers or fall outside of the min to max range, a min that is not below
+// the max, negative ticks and unknown graticule shapes.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	axisIDs := make(map[string]string, len(d.Axes))
+	for i, axis := range d.Axes {
+		path := fmt.Sprintf("Axes[%d]", i)
+		if axis == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing axis")
+			continue
+		}
+
+		validateID(&v, path+".ID", axis.ID, axisIDs)
+	}
+
+	curveIDs := make(map[string]string, len(d.Curves))
+	for i, curve := range d.Curves {
+		path := fmt.Sprintf("Curves[%d]", i)
+		if curve == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing curve")
+			continue
+		}
+
+		validateID(&v, path+".ID", curve.ID, curveIDs)
+
+		if len(curve.Values) != len(d.Axes) {
+			v.Error(basediagram.CodeInvalidValue, path+".Values", "curve %q has %d values for %d axes", curve.ID, len(curve.Values), len(d.Axes))
+		}
+
+		for j, value := range curve.Values {
+			valuePath := fmt.Sprintf("%s.Values[%d]", path, j)
+			switch {
+			case math.IsNaN(value) || math.IsInf(value, 0):
+				v.Error(basediagram.CodeInvalidValue, valuePath, "value %v is not a number", value)
+			case d.Min != nil && value < *d.Min:
+				v.Warning(basediagram.CodeOutOfRange, valuePath, "value %v is below the min %v", value, *d.Min)
+			case d.Max != nil && value > *d.Max:
+				v.Warning(basediagram.CodeOutOfRange, valuePath, "value %v is above the max %v", value, *d.Max)
+			}
+		}
+	}
+
+	if d.Min != nil && d.Max != nil && *d.Min >= *d.Max {
+		v.Error(basediagram.CodeOutOfRange, "Min", "min %v is not below the max %v", *d.Min, *d.Max)
+	}
+
+	if d.Ticks < 0 {
+		v.Error(basediagram.CodeOutOfRange, "Ticks", "ticks %d is negative", d.Ticks)
+	}
+
+	switch d.Graticule {
+	case GraticuleDefault, GraticuleCircle, GraticulePolygon:
+	default:
+		v.Error(basediagram.CodeInvalidValue, "Graticule", "unknown graticule %q", d.Graticule)
+	}
+
+	return v.Errors()
+}
+
+// validateID checks that id is a single word, not already used by another
+// axis or curve of the same kind, as recorded in ids.
+func validateID(v *basediagram.Validator, path string, id string, ids map[string]string) {
+	switch {
+	case id == "":
+		v.Error(basediagram.CodeEmptyID, path, "missing ID")
+		return
+	case strings.IndexFunc(id, isNotIdentifier) >= 0:
+		v.Error(basediagram.CodeInvalidValue, path, "ID %q may only hold letters, digits and underscores", id)
+	}
+
+	if previous, ok := ids[id]; ok {
+		v.Error(basediagram.CodeDuplicateID, path, "ID %q is already used by %s", id, previous)
+		return
+	}
+	ids[id] = path
+}
+
+func isNotIdentifier(r rune) bool {
+	return !parser.IsIdentifier(r)
+}
@@ -0,0 +1,83 @@
+package radar
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				d.AddAxis("a", "Reliability")
+				d.AddAxis("b", "Security")
+				d.AddCurve("a", "Payments", 3, 4)
+				d.AddCurve("b", "", 0, 5)
+				d.SetMin(0).SetMax(5).SetTicks(5).SetGraticule(GraticulePolygon)
+			},
+		},
+		{
+			name: "Invalid axes and curves",
+			setup: func(d *Diagram) {
+				d.AddAxis("", "Empty")
+				d.AddAxis("on call", "")
+				d.AddAxis("on call", "")
+				d.Axes = append(d.Axes, nil)
+				d.AddCurve("payments", "", 1, 2)
+				d.AddCurve("payments", "", 1, 2, math.NaN())
+				d.Curves = append(d.Curves, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeEmptyID, Severity: basediagram.SeverityError, Path: "Axes[0].ID", Message: "missing ID"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Axes[1].ID", Message: `ID "on call" may only hold letters, digits and underscores`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Axes[2].ID", Message: `ID "on call" may only hold letters, digits and underscores`},
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Axes[2].ID", Message: `ID "on call" is already used by Axes[1].ID`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Axes[3]", Message: "missing axis"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Curves[0].Values", Message: `curve "payments" has 2 values for 4 axes`},
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Curves[1].ID", Message: `ID "payments" is already used by Curves[0].ID`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Curves[1].Values", Message: `curve "payments" has 3 values for 4 axes`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Curves[1].Values[2]", Message: "value NaN is not a number"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Curves[2]", Message: "missing curve"},
+			},
+		},
+		{
+			name: "Invalid options",
+			setup: func(d *Diagram) {
+				d.AddAxis("a", "")
+				d.AddCurve("low", "", -1)
+				d.AddCurve("high", "", 11)
+				d.SetMin(10).SetMax(10).SetTicks(-1).SetGraticule("square")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityWarning, Path: "Curves[0].Values[0]", Message: "value -1 is below the min 10"},
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityWarning, Path: "Curves[1].Values[0]", Message: "value 11 is above the max 10"},
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityError, Path: "Min", Message: "min 10 is not below the max 10"},
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityError, Path: "Ticks", Message: "ticks -1 is negative"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Graticule", Message: `unknown graticule "square"`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package requirement
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	requirementConfigurationSection        string = "requirement"
+	baseRequirementConfigurationProperties string = basediagram.Indentation + requirementConfigurationSection + ":\n"
+
+	requirementPropertyRectFill        string = "rect_fill"
+	requirementPropertyTextColor       string = "text_color"
+	requirementPropertyRectBorderSize  string = "rect_border_size"
+	requirementPropertyRectBorderColor string = "rect_border_color"
+	requirementPropertyRectMinWidth    string = "rect_min_width"
+	requirementPropertyRectMinHeight   string = "rect_min_height"
+	requirementPropertyRectPadding     string = "rect_padding"
+	requirementPropertyLineHeight      string = "line_height"
+	requirementPropertyUseMaxWidth     string = "useMaxWidth"
+)
+
+// RequirementConfigurationProperties holds requirement-specific configuration
+type RequirementConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewRequirementConfigurationProperties() RequirementConfigurationProperties {
+	return RequirementConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *RequirementConfigurationProperties) SetRectFill(v string) *RequirementConfigurationProperties {
+	c.properties[requirementPropertyRectFill] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: requirementPropertyRectFill,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RequirementConfigurationProperties) SetTextColor(v string) *RequirementConfigurationProperties {
+	c.properties[requirementPropertyTextColor] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: requirementPropertyTextColor,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RequirementConfigurationProperties) SetRectBorderSize(v float64) *RequirementConfigurationProperties {
+	c.properties[requirementPropertyRectBorderSize] = &basediagram.FloatProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: requirementPropertyRectBorderSize,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RequirementConfigurationProperties) SetRectBorderColor(v string) *RequirementConfigurationProperties {
+	c.properties[requirementPropertyRectBorderColor] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: requirementPropertyRectBorderColor,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RequirementConfigurationProperties) SetRectMinWidth(v int) *RequirementConfigurationProperties {
+	c.properties[requirementPropertyRectMinWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: requirementPropertyRectMinWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RequirementConfigurationProperties) SetRectMinHeight(v int) *RequirementConfigurationProperties {
+	c.properties[requirementPropertyRectMinHeight] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: requirementPropertyRectMinHeight,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RequirementConfigurationProperties) SetRectPadding(v int) *RequirementConfigurationProperties {
+	c.properties[requirementPropertyRectPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: requirementPropertyRectPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RequirementConfigurationProperties) SetLineHeight(v int) *RequirementConfigurationProperties {
+	c.properties[requirementPropertyLineHeight] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: requirementPropertyLineHeight,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *RequirementConfigurationProperties) SetUseMaxWidth(v bool) *RequirementConfigurationProperties {
+	c.properties[requirementPropertyUseMaxWidth] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: requirementPropertyUseMaxWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c RequirementConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(baseRequirementConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,210 @@
+package requirement
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewRequirementConfigurationProperties(t *testing.T) {
+	got := NewRequirementConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewRequirementConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewRequirementConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestRequirementConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   RequirementConfigurationProperties
+		setup    func(*RequirementConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewRequirementConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewRequirementConfigurationProperties(),
+			setup: func(c *RequirementConfigurationProperties) {
+				c.SetRectFill("#f9f9f9")
+			},
+			contains: []string{
+				"requirement:",
+				`rect_fill: "#f9f9f9"`,
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewRequirementConfigurationProperties(),
+			setup: func(c *RequirementConfigurationProperties) {
+				c.SetTextColor("#333")
+				c.SetUseMaxWidth(true)
+			},
+			contains: []string{
+				"requirement:",
+				`text_color: "#333"`,
+				"useMaxWidth: true",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewRequirementConfigurationProperties(),
+			setup: func(c *RequirementConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetRectFill("#f9f9f9")
+			},
+			contains: []string{
+				"fontSize: 12",
+				"requirement:",
+				`rect_fill: "#f9f9f9"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRequirementConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*RequirementConfigurationProperties) *RequirementConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set rect fill",
+			setup: func(c *RequirementConfigurationProperties) *RequirementConfigurationProperties {
+				return c.SetRectFill("#f9f9f9")
+			},
+			property: requirementPropertyRectFill,
+			value:    "#f9f9f9",
+		},
+		{
+			name: "Set text color",
+			setup: func(c *RequirementConfigurationProperties) *RequirementConfigurationProperties {
+				return c.SetTextColor("#333")
+			},
+			property: requirementPropertyTextColor,
+			value:    "#333",
+		},
+		{
+			name: "Set rect border size",
+			setup: func(c *RequirementConfigurationProperties) *RequirementConfigurationProperties {
+				return c.SetRectBorderSize(0.5)
+			},
+			property: requirementPropertyRectBorderSize,
+			value:    0.5,
+		},
+		{
+			name: "Set rect border color",
+			setup: func(c *RequirementConfigurationProperties) *RequirementConfigurationProperties {
+				return c.SetRectBorderColor("#bbb")
+			},
+			property: requirementPropertyRectBorderColor,
+			value:    "#bbb",
+		},
+		{
+			name: "Set rect min width",
+			setup: func(c *RequirementConfigurationProperties) *RequirementConfigurationProperties {
+				return c.SetRectMinWidth(200)
+			},
+			property: requirementPropertyRectMinWidth,
+			value:    200,
+		},
+		{
+			name: "Set rect min height",
+			setup: func(c *RequirementConfigurationProperties) *RequirementConfigurationProperties {
+				return c.SetRectMinHeight(200)
+			},
+			property: requirementPropertyRectMinHeight,
+			value:    200,
+		},
+		{
+			name: "Set rect padding",
+			setup: func(c *RequirementConfigurationProperties) *RequirementConfigurationProperties {
+				return c.SetRectPadding(10)
+			},
+			property: requirementPropertyRectPadding,
+			value:    10,
+		},
+		{
+			name: "Set line height",
+			setup: func(c *RequirementConfigurationProperties) *RequirementConfigurationProperties {
+				return c.SetLineHeight(20)
+			},
+			property: requirementPropertyLineHeight,
+			value:    20,
+		},
+		{
+			name: "Set use max width",
+			setup: func(c *RequirementConfigurationProperties) *RequirementConfigurationProperties {
+				return c.SetUseMaxWidth(true)
+			},
+			property: requirementPropertyUseMaxWidth,
+			value:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewRequirementConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
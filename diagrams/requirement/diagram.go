@@ -0,0 +1,102 @@
+// Package requirement provides functionality for creating Mermaid requirement diagrams
+package requirement
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for requirement diagrams
+const (
+	diagramType     string = "requirementDiagram"
+	baseDiagramType string = diagramType + "\n"
+)
+
+// Diagram represents a Mermaid requirement diagram: requirements, the
+// elements that relate to them, and the relationships between both.
+// Reference: https://mermaid.js.org/syntax/requirementDiagram.html
+type Diagram struct {
+	basediagram.BaseDiagram[RequirementConfigurationProperties]
+	Requirements  []*Requirement
+	Elements      []*Element
+	Relationships []*Relationship
+}
+
+// NewDiagram creates a new requirement diagram
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram:   basediagram.NewBaseDiagram(NewRequirementConfigurationProperties()),
+		Requirements:  make([]*Requirement, 0),
+		Elements:      make([]*Element, 0),
+		Relationships: make([]*Relationship, 0),
+	}
+}
+
+// AddRequirement creates and adds a new requirement to the diagram
+func (d *Diagram) AddRequirement(requirementType RequirementType, name string) *Requirement {
+	requirement := NewRequirement(requirementType, name)
+	d.Requirements = append(d.Requirements, requirement)
+	return requirement
+}
+
+// AddElement creates and adds a new element to the diagram
+func (d *Diagram) AddElement(name string) *Element {
+	element := NewElement(name)
+	d.Elements = append(d.Elements, element)
+	return element
+}
+
+// AddRelationship creates a new relationship from source to target
+func (d *Diagram) AddRelationship(source Node, relationshipType RelationshipType, target Node) *Relationship {
+	relationship := NewRelationship(source, relationshipType, target)
+	d.Relationships = append(d.Relationships, relationship)
+	return relationship
+}
+
+// String generates the Mermaid syntax for the requirement diagram
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces a requirement diagram.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one element at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		for _, requirement := range d.Requirements {
+			if requirement != nil {
+				w.WriteString(requirement.String())
+			}
+		}
+
+		for _, element := range d.Elements {
+			if element != nil {
+				w.WriteString(element.String())
+			}
+		}
+
+		if len(d.Relationships) > 0 {
+			w.WriteString("\n")
+			for _, relationship := range d.Relationships {
+				if relationship != nil && !isNil(relationship.Source) && !isNil(relationship.Target) {
+					w.WriteString(relationship.String())
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package requirement
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if len(diagram.Requirements) != 0 || len(diagram.Elements) != 0 || len(diagram.Relationships) != 0 {
+		t.Error("NewDiagram() should create empty requirements, elements and relationships slices")
+	}
+}
+
+func TestDiagram_Add(t *testing.T) {
+	diagram := NewDiagram()
+	requirement := diagram.AddRequirement(TypeInterfaceRequirement, "api")
+	element := diagram.AddElement("client")
+	relationship := diagram.AddRelationship(element, RelationshipTraces, requirement)
+
+	if len(diagram.Requirements) != 1 || diagram.Requirements[0] != requirement {
+		t.Errorf("AddRequirement() requirements = %v, want [%v]", diagram.Requirements, requirement)
+	}
+
+	if len(diagram.Elements) != 1 || diagram.Elements[0] != element {
+		t.Errorf("AddElement() elements = %v, want [%v]", diagram.Elements, element)
+	}
+
+	if len(diagram.Relationships) != 1 || diagram.Relationships[0] != relationship {
+		t.Errorf("AddRelationship() relationships = %v, want [%v]", diagram.Relationships, relationship)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func() *Diagram
+		want  string
+	}{
+		{
+			name: "Empty diagram",
+			setup: func() *Diagram {
+				return NewDiagram()
+			},
+			want: "requirementDiagram\n",
+		},
+		{
+			name: "Complete diagram",
+			setup: func() *Diagram {
+				d := NewDiagram()
+				req := d.AddRequirement(TypeRequirement, "test_req").SetID("1").SetRisk(RiskLow)
+				entity := d.AddElement("test_entity").SetType("simulation")
+				d.AddRelationship(entity, RelationshipSatisfies, req)
+				d.AddRelationship(nil, RelationshipTraces, req)
+				d.Relationships = append(d.Relationships, nil)
+				return d
+			},
+			want: "requirementDiagram\n" +
+				"    requirement test_req {\n" +
+				"        id: 1\n" +
+				"        risk: Low\n" +
+				"    }\n" +
+				"    element test_entity {\n" +
+				"        type: simulation\n" +
+				"    }\n" +
+				"\n" +
+				"    test_entity - satisfies -> test_req\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.setup().String()
+			if !strings.HasSuffix(got, "---\n"+tt.want) {
+				t.Errorf("String() = %q, want suffix %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package requirement
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for elements
+const (
+	baseElement string = basediagram.Indentation + "element %s {\n"
+)
+
+// Element represents something outside of the requirements, such as a
+// test suite or a document, that relationships connect to requirements.
+type Element struct {
+	Name   string
+	Type   string
+	DocRef string
+}
+
+// NewElement creates a new Element
+func NewElement(name string) *Element {
+	return &Element{
+		Name: name,
+	}
+}
+
+// SetType sets the element type, such as "simulation", and returns the element for chaining
+func (e *Element) SetType(elementType string) *Element {
+	e.Type = elementType
+	return e
+}
+
+// SetDocRef sets the reference to the element documentation and returns the element for chaining
+func (e *Element) SetDocRef(docRef string) *Element {
+	e.DocRef = docRef
+	return e
+}
+
+func (e *Element) nodeName() string {
+	return e.Name
+}
+
+// String generates the Mermaid syntax for the element block
+func (e *Element) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf(baseElement, formatValue(e.Name)))
+	if e.Type != "" {
+		sb.WriteString(fmt.Sprintf(baseRequirementField, "type", formatValue(e.Type)))
+	}
+	if e.DocRef != "" {
+		sb.WriteString(fmt.Sprintf(baseRequirementField, "docref", formatValue(e.DocRef)))
+	}
+	sb.WriteString(baseRequirementEnd)
+
+	return sb.String()
+}
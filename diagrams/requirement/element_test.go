@@ -0,0 +1,64 @@
+package requirement
+
+import (
+	"testing"
+)
+
+func TestNewElement(t *testing.T) {
+	got := NewElement("test_entity")
+
+	if got.Name != "test_entity" || got.Type != "" || got.DocRef != "" {
+		t.Errorf("NewElement() = %+v, want test_entity without fields", got)
+	}
+}
+
+func TestElement_Setters(t *testing.T) {
+	element := NewElement("test_entity")
+	result := element.SetType("simulation").SetDocRef("reqs/test_entity")
+
+	if result != element {
+		t.Error("Setters should return element for chaining")
+	}
+
+	if element.Type != "simulation" || element.DocRef != "reqs/test_entity" {
+		t.Errorf("Setters = %+v", element)
+	}
+}
+
+func TestElement_String(t *testing.T) {
+	tests := []struct {
+		name    string
+		element *Element
+		want    string
+	}{
+		{
+			name:    "Element without fields",
+			element: NewElement("test_entity"),
+			want:    "    element test_entity {\n    }\n",
+		},
+		{
+			name:    "Element with all fields",
+			element: NewElement("test_entity").SetType("simulation").SetDocRef("reqs/test_entity"),
+			want: "    element test_entity {\n" +
+				"        type: simulation\n" +
+				"        docref: reqs/test_entity\n" +
+				"    }\n",
+		},
+		{
+			name:    "Element with values that need quoting",
+			element: NewElement("Login tests").SetType("test suite").SetDocRef("https://example.com/tests"),
+			want: "    element \"Login tests\" {\n" +
+				"        type: \"test suite\"\n" +
+				"        docref: \"https://example.com/tests\"\n" +
+				"    }\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.element.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package requirement
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Node is a requirement or an element, the two kinds of blocks that
+// relationships connect.
+type Node interface {
+	nodeName() string
+}
+
+// formatValue returns text as written in the diagram, quoting it unless it
+// is a plain word such as test_req, 1.2 or reqs/test_entity.
+func formatValue(text string) string {
+	if text != "" && parser.IsIdentifier(rune(text[0])) && strings.IndexFunc(text, isPlainRune) < 0 {
+		return text
+	}
+
+	return basediagram.Quote(text)
+}
+
+func isPlainRune(r rune) bool {
+	return !parser.IsIdentifier(r) && r != '.' && r != '/'
+}
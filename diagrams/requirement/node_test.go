@@ -0,0 +1,30 @@
+package requirement
+
+import (
+	"testing"
+)
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "Word", text: "test_req", want: "test_req"},
+		{name: "Number", text: "1.2", want: "1.2"},
+		{name: "Path", text: "reqs/test_entity", want: "reqs/test_entity"},
+		{name: "Empty", text: "", want: `""`},
+		{name: "Spaces", text: "Login page", want: `"Login page"`},
+		{name: "Hyphen", text: "REQ-1", want: `"REQ-1"`},
+		{name: "Leading dot", text: ".hidden", want: `".hidden"`},
+		{name: "Quotes", text: `Say "hi"`, want: `"Say #quot;hi#quot;"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatValue(tt.text); got != tt.want {
+				t.Errorf("formatValue() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,337 @@
+package requirement
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// pendingRelationship is a relationship whose nodes are looked up once the
+// whole diagram is read, since blocks may be declared after their use.
+type pendingRelationship struct {
+	relationship *Relationship
+	source       string
+	target       string
+	line         parser.Line
+	sourcePos    int
+	targetPos    int
+}
+
+type requirementParser struct {
+	diagram       *Diagram
+	requirement   *Requirement
+	element       *Element
+	blockLine     parser.Line
+	relationships []pendingRelationship
+}
+
+// Parse reads Mermaid requirement diagram syntax and returns the corresponding
+// Diagram. It understands the syntax generated by Diagram.String as well as
+// unquoted values, keywords written in any case and relationships written
+// from target to source as `target <- type - source`. Styling statements are
+// not supported by the model and are reported as errors.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &requirementParser{diagram: NewDiagram()}
+	p.diagram.Title = doc.Title
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, requirementConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.requirement != nil || p.element != nil {
+		return nil, p.blockLine.Errorf(0, "block is missing its '}'")
+	}
+
+	if err := p.resolveRelationships(); err != nil {
+		return nil, err
+	}
+
+	return p.diagram, nil
+}
+
+func (p *requirementParser) parseLine(line parser.Line) error {
+	switch {
+	case p.requirement != nil:
+		return p.parseRequirementField(line)
+	case p.element != nil:
+		return p.parseElementField(line)
+	}
+
+	s := parser.NewScanner(line)
+	keyword := s.ReadWhile(parser.IsIdentifier)
+
+	switch keyword {
+	case "direction", "style", "classDef", "class", "accTitle", "accDescr":
+		return line.Errorf(0, "unsupported statement %q", keyword)
+	}
+
+	if strings.HasSuffix(line.Text, "{") {
+		if keyword == "element" {
+			return p.parseBlock(s, func(name string) {
+				p.element = p.diagram.AddElement(name)
+			})
+		}
+		if requirementType, ok := lookupType(keyword); ok {
+			return p.parseBlock(s, func(name string) {
+				p.requirement = p.diagram.AddRequirement(requirementType, name)
+			})
+		}
+	}
+
+	return p.parseRelationship(parser.NewScanner(line))
+}
+
+// parseBlock reads the `name {` that follows a requirement type or the
+// element keyword, and opens the block with open.
+func (p *requirementParser) parseBlock(s *parser.Scanner, open func(name string)) error {
+	s.SkipSpaces()
+	name, err := readValue(s, "{")
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return s.Errorf("expected name")
+	}
+
+	s.SkipSpaces()
+	if !s.Consume("{") || !s.EOF() {
+		return s.Errorf("expected '{'")
+	}
+
+	open(name)
+	p.blockLine = s.Line()
+
+	return nil
+}
+
+// parseRequirementField reads an `id`, `text`, `risk` or `verifymethod` field,
+// or the `}` that closes the requirement.
+func (p *requirementParser) parseRequirementField(line parser.Line) error {
+	if line.Text == "}" {
+		p.requirement = nil
+		return nil
+	}
+
+	s, key, err := readField(line)
+	if err != nil {
+		return err
+	}
+
+	valuePos := s.Pos()
+	value, err := readValue(s, "")
+	if err != nil {
+		return err
+	}
+
+	switch key {
+	case "id":
+		p.requirement.SetID(value)
+	case "text":
+		p.requirement.SetText(value)
+	case "risk":
+		risk, ok := lookup(value, RiskLow, RiskMedium, RiskHigh)
+		if !ok {
+			return line.Errorf(valuePos, "unknown risk %q", value)
+		}
+		p.requirement.SetRisk(risk)
+	case "verifymethod":
+		method, ok := lookup(value, VerifyAnalysis, VerifyInspection, VerifyTest, VerifyDemonstration)
+		if !ok {
+			return line.Errorf(valuePos, "unknown verification method %q", value)
+		}
+		p.requirement.SetVerifyMethod(method)
+	default:
+		return line.Errorf(0, "unknown requirement field %q", key)
+	}
+
+	return nil
+}
+
+// parseElementField reads a `type` or `docref` field, or the `}` that closes
+// the element.
+func (p *requirementParser) parseElementField(line parser.Line) error {
+	if line.Text == "}" {
+		p.element = nil
+		return nil
+	}
+
+	s, key, err := readField(line)
+	if err != nil {
+		return err
+	}
+
+	value, err := readValue(s, "")
+	if err != nil {
+		return err
+	}
+
+	switch key {
+	case "type":
+		p.element.SetType(value)
+	case "docref":
+		p.element.SetDocRef(value)
+	default:
+		return line.Errorf(0, "unknown element field %q", key)
+	}
+
+	return nil
+}
+
+// parseRelationship reads `source - type -> target` or `target <- type - source`.
+func (p *requirementParser) parseRelationship(s *parser.Scanner) error {
+	pending := pendingRelationship{line: s.Line()}
+
+	firstPos := s.Pos()
+	first, err := readValue(s, "-<")
+	if err != nil {
+		return err
+	}
+	if first == "" {
+		return s.Errorf("expected name")
+	}
+	s.SkipSpaces()
+
+	reverse := s.Consume("<-")
+	if !reverse && !s.Consume("-") {
+		return s.Errorf("expected relationship")
+	}
+	s.SkipSpaces()
+
+	typePos := s.Pos()
+	keyword := s.ReadWhile(parser.IsIdentifier)
+	relationshipType, ok := lookup(keyword, RelationshipContains, RelationshipCopies, RelationshipDerives,
+		RelationshipSatisfies, RelationshipVerifies, RelationshipRefines, RelationshipTraces)
+	if !ok {
+		return s.ErrorAt(typePos, "unknown relationship type %q", keyword)
+	}
+	s.SkipSpaces()
+
+	arrow := "->"
+	if reverse {
+		arrow = "-"
+	}
+	if !s.Consume(arrow) {
+		return s.Errorf("expected '%s'", arrow)
+	}
+	s.SkipSpaces()
+
+	secondPos := s.Pos()
+	second, err := readValue(s, "")
+	if err != nil {
+		return err
+	}
+	if second == "" {
+		return s.Errorf("expected name")
+	}
+
+	pending.relationship = p.diagram.AddRelationship(nil, relationshipType, nil)
+	pending.source, pending.sourcePos = first, firstPos
+	pending.target, pending.targetPos = second, secondPos
+	if reverse {
+		pending.source, pending.sourcePos = second, secondPos
+		pending.target, pending.targetPos = first, firstPos
+	}
+	p.relationships = append(p.relationships, pending)
+
+	return nil
+}
+
+// resolveRelationships connects the relationships to the blocks they name.
+func (p *requirementParser) resolveRelationships() error {
+	nodes := make(map[string]Node)
+	for _, requirement := range p.diagram.Requirements {
+		nodes[requirement.Name] = requirement
+	}
+	for _, element := range p.diagram.Elements {
+		nodes[element.Name] = element
+	}
+
+	for _, pending := range p.relationships {
+		source, ok := nodes[pending.source]
+		if !ok {
+			return pending.line.Errorf(pending.sourcePos, "unknown requirement or element %q", pending.source)
+		}
+
+		target, ok := nodes[pending.target]
+		if !ok {
+			return pending.line.Errorf(pending.targetPos, "unknown requirement or element %q", pending.target)
+		}
+
+		pending.relationship.Source = source
+		pending.relationship.Target = target
+	}
+
+	return nil
+}
+
+// readField reads the `key:` that starts a field and returns the scanner
+// positioned at the value.
+func readField(line parser.Line) (*parser.Scanner, string, error) {
+	s := parser.NewScanner(line)
+
+	key := s.ReadWhile(parser.IsIdentifier)
+	s.SkipSpaces()
+	if !s.Consume(":") {
+		return nil, "", s.Errorf("expected ':'")
+	}
+	s.SkipSpaces()
+
+	return s, strings.ToLower(key), nil
+}
+
+// readValue reads a quoted value, or an unquoted one that ends before any of
+// the stop characters. Without stop characters the value must end the line.
+func readValue(s *parser.Scanner, stop string) (string, error) {
+	if !s.HasPrefix(`"`) {
+		return strings.TrimSpace(s.ReadWhile(func(r rune) bool { return !strings.ContainsRune(stop, r) })), nil
+	}
+
+	text, err := s.ReadQuoted()
+	if err != nil {
+		return "", err
+	}
+	if stop == "" && !s.EOF() {
+		return "", s.Errorf("unexpected %q", s.Rest())
+	}
+
+	return basediagram.Unescape(text), nil
+}
+
+// lookupType returns the requirement type named by keyword, in any case.
+func lookupType(keyword string) (RequirementType, bool) {
+	return lookup(keyword, TypeRequirement, TypeFunctionalRequirement, TypePerformanceRequirement,
+		TypeInterfaceRequirement, TypePhysicalRequirement, TypeDesignConstraint)
+}
+
+// lookup returns the value among values that matches text in any case.
+func lookup[T ~string](text string, values ...T) (T, bool) {
+	for _, value := range values {
+		if strings.EqualFold(text, string(value)) {
+			return value, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
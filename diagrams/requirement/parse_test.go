@@ -0,0 +1,232 @@
+package requirement
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Diagram with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Traceability"
+				d.Config.SetRectFill("#f9f9f9").SetRectMinWidth(250)
+				d.EnableMarkdownFence()
+				d.AddRequirement(TypeRequirement, "test_req")
+			},
+		},
+		{
+			name: "Diagram with every requirement type and field",
+			setup: func(d *Diagram) {
+				d.AddRequirement(TypeRequirement, "r1").SetID("1").SetText("the test text.").SetRisk(RiskHigh).SetVerifyMethod(VerifyTest)
+				d.AddRequirement(TypeFunctionalRequirement, "r2").SetRisk(RiskLow).SetVerifyMethod(VerifyInspection)
+				d.AddRequirement(TypePerformanceRequirement, "r3").SetRisk(RiskMedium).SetVerifyMethod(VerifyAnalysis)
+				d.AddRequirement(TypeInterfaceRequirement, "r4").SetVerifyMethod(VerifyDemonstration)
+				d.AddRequirement(TypePhysicalRequirement, "r5")
+				d.AddRequirement(TypeDesignConstraint, "r6")
+			},
+		},
+		{
+			name: "Diagram with elements and relationships",
+			setup: func(d *Diagram) {
+				parent := d.AddRequirement(TypeRequirement, "Parent req").SetID("REQ-1")
+				child := d.AddRequirement(TypeRequirement, "child")
+				entity := d.AddElement("element").SetType("test suite").SetDocRef("reqs/test_entity")
+				for _, relationshipType := range []RelationshipType{
+					RelationshipContains, RelationshipCopies, RelationshipDerives,
+					RelationshipRefines, RelationshipTraces,
+				} {
+					d.AddRelationship(parent, relationshipType, child)
+				}
+				d.AddRelationship(entity, RelationshipSatisfies, parent)
+				d.AddRelationship(entity, RelationshipVerifies, child)
+			},
+		},
+		{
+			name: "Diagram with text that needs escaping",
+			setup: func(d *Diagram) {
+				d.AddRequirement(TypeRequirement, `The "main" req`).SetText("Use #1; not 2 - or -> 3 {}")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `requirementDiagram
+
+    test_req <- satisfies - test_entity
+
+    requirement test_req {
+    id: 1
+    text: the test text.
+    risk: high
+    verifymethod: test
+    }
+
+    element test_entity {
+    type: simulation
+    }
+
+    functionalRequirement "login req" {
+    id: "1.1"
+    }
+
+    test_req - contains -> "login req"
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if len(d.Requirements) != 2 || len(d.Elements) != 1 || len(d.Relationships) != 2 {
+		t.Fatalf("Parse() = %d requirements, %d elements, %d relationships, want 2, 1, 2",
+			len(d.Requirements), len(d.Elements), len(d.Relationships))
+	}
+
+	req := d.Requirements[0]
+	if req.ID != "1" || req.Text != "the test text." || req.Risk != RiskHigh || req.VerifyMethod != VerifyTest {
+		t.Errorf("Parse() requirement = %+v", req)
+	}
+
+	if d.Requirements[1].Type != TypeFunctionalRequirement || d.Requirements[1].Name != "login req" {
+		t.Errorf("Parse() requirement = %+v", d.Requirements[1])
+	}
+
+	satisfies := d.Relationships[0]
+	if satisfies.Source != d.Elements[0] || satisfies.Target != req || satisfies.Type != RelationshipSatisfies {
+		t.Errorf("Parse() reversed relationship = %+v", satisfies)
+	}
+
+	contains := d.Relationships[1]
+	if contains.Source != req || contains.Target != d.Requirements[1] {
+		t.Errorf("Parse() relationship = %+v", contains)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "requirement r {\n}\n",
+			line:    1,
+			column:  1,
+			message: "expected requirementDiagram declaration",
+		},
+		{
+			name:    "Unclosed block",
+			input:   "requirementDiagram\n    requirement r {\n        id: 1\n",
+			line:    2,
+			column:  5,
+			message: "block is missing its '}'",
+		},
+		{
+			name:    "Unknown field",
+			input:   "requirementDiagram\n    requirement r {\n        docref: x\n    }\n",
+			line:    3,
+			column:  9,
+			message: `unknown requirement field "docref"`,
+		},
+		{
+			name:    "Unknown risk",
+			input:   "requirementDiagram\n    requirement r {\n        risk: extreme\n    }\n",
+			line:    3,
+			column:  15,
+			message: `unknown risk "extreme"`,
+		},
+		{
+			name:    "Missing colon",
+			input:   "requirementDiagram\n    element e {\n        type simulation\n    }\n",
+			line:    3,
+			column:  14,
+			message: "expected ':'",
+		},
+		{
+			name:    "Text after a quoted value",
+			input:   "requirementDiagram\n    requirement r {\n        text: \"a\" b\n    }\n",
+			line:    3,
+			column:  18,
+			message: `unexpected " b"`,
+		},
+		{
+			name:    "Unknown relationship type",
+			input:   "requirementDiagram\n    a - implements -> b\n",
+			line:    2,
+			column:  9,
+			message: `unknown relationship type "implements"`,
+		},
+		{
+			name:    "Missing arrow",
+			input:   "requirementDiagram\n    a - traces b\n",
+			line:    2,
+			column:  16,
+			message: "expected '->'",
+		},
+		{
+			name:    "Unknown node",
+			input:   "requirementDiagram\n    element a {\n    }\n    a - traces -> b\n",
+			line:    4,
+			column:  19,
+			message: `unknown requirement or element "b"`,
+		},
+		{
+			name:    "Unsupported statement",
+			input:   "requirementDiagram\n    direction LR\n",
+			line:    2,
+			column:  5,
+			message: `unsupported statement "direction"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package requirement
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// RelationshipType is the meaning of a relationship, read from its source
+// to its target, e.g. "test_entity satisfies test_req".
+type RelationshipType string
+
+// List of relationship types.
+const (
+	RelationshipContains  RelationshipType = "contains"
+	RelationshipCopies    RelationshipType = "copies"
+	RelationshipDerives   RelationshipType = "derives"
+	RelationshipSatisfies RelationshipType = "satisfies"
+	RelationshipVerifies  RelationshipType = "verifies"
+	RelationshipRefines   RelationshipType = "refines"
+	RelationshipTraces    RelationshipType = "traces"
+)
+
+// Base string formats for relationships
+const (
+	baseRelationship string = basediagram.Indentation + "%s - %s -> %s\n"
+)
+
+// Relationship represents a typed arrow from a source to a target node.
+type Relationship struct {
+	Source Node
+	Target Node
+	Type   RelationshipType
+}
+
+// NewRelationship creates a new relationship between two nodes
+func NewRelationship(source Node, relationshipType RelationshipType, target Node) *Relationship {
+	return &Relationship{
+		Source: source,
+		Target: target,
+		Type:   relationshipType,
+	}
+}
+
+// String generates the Mermaid syntax for the relationship
+func (r *Relationship) String() string {
+	return fmt.Sprintf(baseRelationship, formatValue(r.Source.nodeName()), r.Type, formatValue(r.Target.nodeName()))
+}
@@ -0,0 +1,42 @@
+package requirement
+
+import (
+	"testing"
+)
+
+func TestNewRelationship(t *testing.T) {
+	source := NewElement("test_entity")
+	target := NewRequirement(TypeRequirement, "test_req")
+	got := NewRelationship(source, RelationshipSatisfies, target)
+
+	if got.Source != source || got.Target != target || got.Type != RelationshipSatisfies {
+		t.Errorf("NewRelationship() = %+v, want test_entity satisfies test_req", got)
+	}
+}
+
+func TestRelationship_String(t *testing.T) {
+	tests := []struct {
+		name         string
+		relationship *Relationship
+		want         string
+	}{
+		{
+			name:         "Element to requirement",
+			relationship: NewRelationship(NewElement("test_entity"), RelationshipSatisfies, NewRequirement(TypeRequirement, "test_req")),
+			want:         "    test_entity - satisfies -> test_req\n",
+		},
+		{
+			name:         "Names that need quoting",
+			relationship: NewRelationship(NewRequirement(TypeRequirement, "Parent req"), RelationshipContains, NewRequirement(TypeRequirement, "REQ-2")),
+			want:         "    \"Parent req\" - contains -> \"REQ-2\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.relationship.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package requirement
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// RequirementType is the kind of a requirement, which is shown above its name.
+type RequirementType string
+
+// List of requirement types.
+const (
+	TypeRequirement            RequirementType = "requirement"
+	TypeFunctionalRequirement  RequirementType = "functionalRequirement"
+	TypePerformanceRequirement RequirementType = "performanceRequirement"
+	TypeInterfaceRequirement   RequirementType = "interfaceRequirement"
+	TypePhysicalRequirement    RequirementType = "physicalRequirement"
+	TypeDesignConstraint       RequirementType = "designConstraint"
+)
+
+// Risk is the risk of not meeting a requirement.
+type Risk string
+
+// List of risk levels. RiskNone omits the risk.
+const (
+	RiskNone   Risk = ""
+	RiskLow    Risk = "Low"
+	RiskMedium Risk = "Medium"
+	RiskHigh   Risk = "High"
+)
+
+// VerifyMethod is the way a requirement is verified.
+type VerifyMethod string
+
+// List of verification methods. VerifyNone omits the method.
+const (
+	VerifyNone          VerifyMethod = ""
+	VerifyAnalysis      VerifyMethod = "Analysis"
+	VerifyInspection    VerifyMethod = "Inspection"
+	VerifyTest          VerifyMethod = "Test"
+	VerifyDemonstration VerifyMethod = "Demonstration"
+)
+
+// Base string formats for requirements
+const (
+	baseRequirement      string = basediagram.Indentation + "%s %s {\n"
+	baseRequirementField string = basediagram.Indentation + basediagram.Indentation + "%s: %s\n"
+	baseRequirementEnd   string = basediagram.Indentation + "}\n"
+)
+
+// Requirement represents a requirement block. Only the fields that are set
+// are rendered.
+type Requirement struct {
+	Type         RequirementType
+	Name         string
+	ID           string
+	Text         string
+	Risk         Risk
+	VerifyMethod VerifyMethod
+}
+
+// NewRequirement creates a new Requirement of the given type
+func NewRequirement(requirementType RequirementType, name string) *Requirement {
+	return &Requirement{
+		Type: requirementType,
+		Name: name,
+	}
+}
+
+// SetID sets the requirement identifier, such as "REQ-1.2", and returns the requirement for chaining
+func (r *Requirement) SetID(id string) *Requirement {
+	r.ID = id
+	return r
+}
+
+// SetText sets the requirement text and returns the requirement for chaining
+func (r *Requirement) SetText(text string) *Requirement {
+	r.Text = text
+	return r
+}
+
+// SetRisk sets the requirement risk and returns the requirement for chaining
+func (r *Requirement) SetRisk(risk Risk) *Requirement {
+	r.Risk = risk
+	return r
+}
+
+// SetVerifyMethod sets the verification method and returns the requirement for chaining
+func (r *Requirement) SetVerifyMethod(method VerifyMethod) *Requirement {
+	r.VerifyMethod = method
+	return r
+}
+
+func (r *Requirement) nodeName() string {
+	return r.Name
+}
+
+// String generates the Mermaid syntax for the requirement block
+func (r *Requirement) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf(baseRequirement, r.Type, formatValue(r.Name)))
+	if r.ID != "" {
+		sb.WriteString(fmt.Sprintf(baseRequirementField, "id", formatValue(r.ID)))
+	}
+	if r.Text != "" {
+		sb.WriteString(fmt.Sprintf(baseRequirementField, "text", basediagram.Quote(r.Text)))
+	}
+	if r.Risk != RiskNone {
+		sb.WriteString(fmt.Sprintf(baseRequirementField, "risk", r.Risk))
+	}
+	if r.VerifyMethod != VerifyNone {
+		sb.WriteString(fmt.Sprintf(baseRequirementField, "verifymethod", r.VerifyMethod))
+	}
+	sb.WriteString(baseRequirementEnd)
+
+	return sb.String()
+}
@@ -0,0 +1,76 @@
+package requirement
+
+import (
+	"testing"
+)
+
+func TestNewRequirement(t *testing.T) {
+	got := NewRequirement(TypeFunctionalRequirement, "login")
+
+	if got.Type != TypeFunctionalRequirement || got.Name != "login" {
+		t.Errorf("NewRequirement() = %+v, want functionalRequirement login", got)
+	}
+
+	if got.Risk != RiskNone || got.VerifyMethod != VerifyNone {
+		t.Errorf("NewRequirement() = %+v, want no risk and no verification method", got)
+	}
+}
+
+func TestRequirement_Setters(t *testing.T) {
+	requirement := NewRequirement(TypeRequirement, "test_req")
+	result := requirement.SetID("1").SetText("the test text.").SetRisk(RiskHigh).SetVerifyMethod(VerifyTest)
+
+	if result != requirement {
+		t.Error("Setters should return requirement for chaining")
+	}
+
+	if requirement.ID != "1" || requirement.Text != "the test text." || requirement.Risk != RiskHigh || requirement.VerifyMethod != VerifyTest {
+		t.Errorf("Setters = %+v", requirement)
+	}
+}
+
+func TestRequirement_String(t *testing.T) {
+	tests := []struct {
+		name        string
+		requirement *Requirement
+		want        string
+	}{
+		{
+			name:        "Requirement without fields",
+			requirement: NewRequirement(TypeDesignConstraint, "test_req"),
+			want:        "    designConstraint test_req {\n    }\n",
+		},
+		{
+			name: "Requirement with all fields",
+			requirement: NewRequirement(TypeRequirement, "test_req").
+				SetID("1").
+				SetText("the test text.").
+				SetRisk(RiskHigh).
+				SetVerifyMethod(VerifyTest),
+			want: "    requirement test_req {\n" +
+				"        id: 1\n" +
+				"        text: \"the test text.\"\n" +
+				"        risk: High\n" +
+				"        verifymethod: Test\n" +
+				"    }\n",
+		},
+		{
+			name: "Requirement with values that need quoting",
+			requirement: NewRequirement(TypePerformanceRequirement, "Page load").
+				SetID("REQ-1.2").
+				SetText(`Under "2s"; always`),
+			want: "    performanceRequirement \"Page load\" {\n" +
+				"        id: \"REQ-1.2\"\n" +
+				"        text: \"Under #quot;2s#quot;#59; always\"\n" +
+				"    }\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.requirement.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
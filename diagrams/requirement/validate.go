@@ -0,0 +1,99 @@
+package requirement
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// missing or duplicate names, which requirements and elements share,
+// unknown requirement types, risks, verification methods and relationship
+// types, and relationships between nodes that are missing or were never
+// added to the diagram.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	nodes := make(map[Node]bool, len(d.Requirements)+len(d.Elements))
+	for i, requirement := range d.Requirements {
+		path := fmt.Sprintf("Requirements[%d]", i)
+		if requirement == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing requirement")
+			continue
+		}
+
+		v.UniqueID(path+".Name", requirement.Name)
+		nodes[requirement] = true
+
+		switch requirement.Type {
+		case TypeRequirement, TypeFunctionalRequirement, TypePerformanceRequirement,
+			TypeInterfaceRequirement, TypePhysicalRequirement, TypeDesignConstraint:
+		default:
+			v.Error(basediagram.CodeInvalidValue, path+".Type", "unknown requirement type %q", requirement.Type)
+		}
+
+		switch requirement.Risk {
+		case RiskNone, RiskLow, RiskMedium, RiskHigh:
+		default:
+			v.Error(basediagram.CodeInvalidValue, path+".Risk", "unknown risk %q", requirement.Risk)
+		}
+
+		switch requirement.VerifyMethod {
+		case VerifyNone, VerifyAnalysis, VerifyInspection, VerifyTest, VerifyDemonstration:
+		default:
+			v.Error(basediagram.CodeInvalidValue, path+".VerifyMethod", "unknown verification method %q", requirement.VerifyMethod)
+		}
+	}
+
+	for i, element := range d.Elements {
+		path := fmt.Sprintf("Elements[%d]", i)
+		if element == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing element")
+			continue
+		}
+
+		v.UniqueID(path+".Name", element.Name)
+		nodes[element] = true
+	}
+
+	for i, relationship := range d.Relationships {
+		path := fmt.Sprintf("Relationships[%d]", i)
+		if relationship == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing relationship")
+			continue
+		}
+
+		switch relationship.Type {
+		case RelationshipContains, RelationshipCopies, RelationshipDerives, RelationshipSatisfies,
+			RelationshipVerifies, RelationshipRefines, RelationshipTraces:
+		default:
+			v.Error(basediagram.CodeInvalidValue, path+".Type", "unknown relationship type %q", relationship.Type)
+		}
+
+		validateNode(&v, path+".Source", relationship.Source, nodes)
+		validateNode(&v, path+".Target", relationship.Target, nodes)
+	}
+
+	return v.Errors()
+}
+
+func validateNode(v *basediagram.Validator, path string, node Node, nodes map[Node]bool) {
+	switch {
+	case isNil(node):
+		v.Error(basediagram.CodeMissingReference, path, "missing node")
+	case !nodes[node]:
+		v.Error(basediagram.CodeUnknownReference, path, "node %q is not part of the diagram", node.nodeName())
+	}
+}
+
+// isNil reports whether node is nil or holds a nil requirement or element.
+func isNil(node Node) bool {
+	switch n := node.(type) {
+	case *Requirement:
+		return n == nil
+	case *Element:
+		return n == nil
+	}
+
+	return node == nil
+}
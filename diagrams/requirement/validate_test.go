@@ -0,0 +1,78 @@
+package requirement
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				req := d.AddRequirement(TypeRequirement, "test_req").SetRisk(RiskHigh).SetVerifyMethod(VerifyTest)
+				child := d.AddRequirement(TypeFunctionalRequirement, "child_req")
+				entity := d.AddElement("test_entity")
+				d.AddRelationship(entity, RelationshipSatisfies, req)
+				d.AddRelationship(req, RelationshipContains, child)
+			},
+		},
+		{
+			name: "Invalid blocks",
+			setup: func(d *Diagram) {
+				d.AddRequirement("wish", "").SetRisk("Extreme").SetVerifyMethod("Hope")
+				d.AddRequirement(TypeRequirement, "shared")
+				d.AddElement("shared")
+				d.Requirements = append(d.Requirements, nil)
+				d.Elements = append(d.Elements, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeEmptyID, Severity: basediagram.SeverityError, Path: "Requirements[0].Name", Message: "missing ID"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Requirements[0].Type", Message: `unknown requirement type "wish"`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Requirements[0].Risk", Message: `unknown risk "Extreme"`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Requirements[0].VerifyMethod", Message: `unknown verification method "Hope"`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Requirements[2]", Message: "missing requirement"},
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Elements[0].Name", Message: `ID "shared" is already used by Requirements[1].Name`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Elements[1]", Message: "missing element"},
+			},
+		},
+		{
+			name: "Invalid relationships",
+			setup: func(d *Diagram) {
+				req := d.AddRequirement(TypeRequirement, "test_req")
+				var element *Element
+				d.AddRelationship(NewElement("outsider"), "implements", req)
+				d.AddRelationship(element, RelationshipTraces, nil)
+				d.Relationships = append(d.Relationships, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Relationships[0].Type", Message: `unknown relationship type "implements"`},
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "Relationships[0].Source", Message: `node "outsider" is not part of the diagram`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Relationships[1].Source", Message: "missing node"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Relationships[1].Target", Message: "missing node"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Relationships[2]", Message: "missing relationship"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package sankey
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	sankeyConfigurationSection        string = "sankey"
+	baseSankeyConfigurationProperties string = basediagram.Indentation + sankeyConfigurationSection + ":\n"
+
+	sankeyPropertyWidth         string = "width"
+	sankeyPropertyHeight        string = "height"
+	sankeyPropertyLinkColor     string = "linkColor"
+	sankeyPropertyNodeAlignment string = "nodeAlignment"
+	sankeyPropertyShowValues    string = "showValues"
+	sankeyPropertyPrefix        string = "prefix"
+	sankeyPropertySuffix        string = "suffix"
+	sankeyPropertyUseMaxWidth   string = "useMaxWidth"
+)
+
+// SankeyConfigurationProperties holds sankey-specific configuration
+type SankeyConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewSankeyConfigurationProperties() SankeyConfigurationProperties {
+	return SankeyConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *SankeyConfigurationProperties) SetWidth(v int) *SankeyConfigurationProperties {
+	c.properties[sankeyPropertyWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: sankeyPropertyWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *SankeyConfigurationProperties) SetHeight(v int) *SankeyConfigurationProperties {
+	c.properties[sankeyPropertyHeight] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: sankeyPropertyHeight,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *SankeyConfigurationProperties) SetLinkColor(v string) *SankeyConfigurationProperties {
+	c.properties[sankeyPropertyLinkColor] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: sankeyPropertyLinkColor,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *SankeyConfigurationProperties) SetNodeAlignment(v string) *SankeyConfigurationProperties {
+	c.properties[sankeyPropertyNodeAlignment] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: sankeyPropertyNodeAlignment,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *SankeyConfigurationProperties) SetShowValues(v bool) *SankeyConfigurationProperties {
+	c.properties[sankeyPropertyShowValues] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: sankeyPropertyShowValues,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *SankeyConfigurationProperties) SetPrefix(v string) *SankeyConfigurationProperties {
+	c.properties[sankeyPropertyPrefix] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: sankeyPropertyPrefix,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *SankeyConfigurationProperties) SetSuffix(v string) *SankeyConfigurationProperties {
+	c.properties[sankeyPropertySuffix] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: sankeyPropertySuffix,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *SankeyConfigurationProperties) SetUseMaxWidth(v bool) *SankeyConfigurationProperties {
+	c.properties[sankeyPropertyUseMaxWidth] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: sankeyPropertyUseMaxWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c SankeyConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(baseSankeyConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
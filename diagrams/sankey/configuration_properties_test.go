@@ -0,0 +1,202 @@
+package sankey
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewSankeyConfigurationProperties(t *testing.T) {
+	got := NewSankeyConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewSankeyConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewSankeyConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestSankeyConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   SankeyConfigurationProperties
+		setup    func(*SankeyConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewSankeyConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewSankeyConfigurationProperties(),
+			setup: func(c *SankeyConfigurationProperties) {
+				c.SetWidth(800)
+			},
+			contains: []string{
+				"sankey:",
+				"width: 800",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewSankeyConfigurationProperties(),
+			setup: func(c *SankeyConfigurationProperties) {
+				c.SetHeight(400)
+				c.SetUseMaxWidth(true)
+			},
+			contains: []string{
+				"sankey:",
+				"height: 400",
+				"useMaxWidth: true",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewSankeyConfigurationProperties(),
+			setup: func(c *SankeyConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetWidth(800)
+			},
+			contains: []string{
+				"fontSize: 12",
+				"sankey:",
+				"width: 800",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestSankeyConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*SankeyConfigurationProperties) *SankeyConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set width",
+			setup: func(c *SankeyConfigurationProperties) *SankeyConfigurationProperties {
+				return c.SetWidth(800)
+			},
+			property: sankeyPropertyWidth,
+			value:    800,
+		},
+		{
+			name: "Set height",
+			setup: func(c *SankeyConfigurationProperties) *SankeyConfigurationProperties {
+				return c.SetHeight(400)
+			},
+			property: sankeyPropertyHeight,
+			value:    400,
+		},
+		{
+			name: "Set link color",
+			setup: func(c *SankeyConfigurationProperties) *SankeyConfigurationProperties {
+				return c.SetLinkColor("gradient")
+			},
+			property: sankeyPropertyLinkColor,
+			value:    "gradient",
+		},
+		{
+			name: "Set node alignment",
+			setup: func(c *SankeyConfigurationProperties) *SankeyConfigurationProperties {
+				return c.SetNodeAlignment("justify")
+			},
+			property: sankeyPropertyNodeAlignment,
+			value:    "justify",
+		},
+		{
+			name: "Set show values",
+			setup: func(c *SankeyConfigurationProperties) *SankeyConfigurationProperties {
+				return c.SetShowValues(false)
+			},
+			property: sankeyPropertyShowValues,
+			value:    false,
+		},
+		{
+			name: "Set prefix",
+			setup: func(c *SankeyConfigurationProperties) *SankeyConfigurationProperties {
+				return c.SetPrefix("$")
+			},
+			property: sankeyPropertyPrefix,
+			value:    "$",
+		},
+		{
+			name: "Set suffix",
+			setup: func(c *SankeyConfigurationProperties) *SankeyConfigurationProperties {
+				return c.SetSuffix("req/s")
+			},
+			property: sankeyPropertySuffix,
+			value:    "req/s",
+		},
+		{
+			name: "Set use max width",
+			setup: func(c *SankeyConfigurationProperties) *SankeyConfigurationProperties {
+				return c.SetUseMaxWidth(true)
+			},
+			property: sankeyPropertyUseMaxWidth,
+			value:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewSankeyConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Package sankey provides functionality for creating Mermaid sankey diagrams
+package sankey
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for sankey diagrams
+const (
+	diagramType     string = "sankey-beta"
+	baseDiagramType string = diagramType + "\n"
+)
+
+// Diagram represents a Mermaid sankey diagram, a list of weighted flows
+// between named nodes written as CSV rows.
+// Reference: https://mermaid.js.org/syntax/sankey.html
+type Diagram struct {
+	basediagram.BaseDiagram[SankeyConfigurationProperties]
+	Links []*Link
+}
+
+// NewDiagram creates a new sankey diagram
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram: basediagram.NewBaseDiagram(NewSankeyConfigurationProperties()),
+		Links:       make([]*Link, 0),
+	}
+}
+
+// AddLink creates and adds a new flow from source to target
+func (d *Diagram) AddLink(source string, target string, value float64) *Link {
+	link := NewLink(source, target, value)
+	d.Links = append(d.Links, link)
+	return link
+}
+
+// Nodes returns the names of the nodes in the order of their first use.
+func (d *Diagram) Nodes() []string {
+	var nodes []string
+	seen := make(map[string]bool)
+
+	for _, link := range d.Links {
+		if link == nil {
+			continue
+		}
+
+		for _, name := range []string{link.Source, link.Target} {
+			if !seen[name] {
+				seen[name] = true
+				nodes = append(nodes, name)
+			}
+		}
+	}
+
+	return nodes
+}
+
+// String generates the Mermaid syntax for the sankey diagram
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces a sankey diagram.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one element at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		for _, link := range d.Links {
+			if link != nil {
+				w.WriteString(link.String())
+			}
+		}
+	})
+}
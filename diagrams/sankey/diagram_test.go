@@ -0,0 +1,80 @@
+package sankey
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if len(diagram.Links) != 0 {
+		t.Error("NewDiagram() should create empty links slice")
+	}
+}
+
+func TestDiagram_AddLink(t *testing.T) {
+	diagram := NewDiagram()
+	link := diagram.AddLink("api", "auth", 120)
+
+	if len(diagram.Links) != 1 || diagram.Links[0] != link {
+		t.Fatalf("AddLink() links = %v, want [%v]", diagram.Links, link)
+	}
+
+	if link.Source != "api" || link.Target != "auth" || link.Value != 120 {
+		t.Errorf("AddLink() = %+v, want api to auth with 120", link)
+	}
+}
+
+func TestDiagram_Nodes(t *testing.T) {
+	diagram := NewDiagram()
+	diagram.AddLink("gateway", "api", 100)
+	diagram.AddLink("api", "auth", 40)
+	diagram.Links = append(diagram.Links, nil)
+	diagram.AddLink("gateway", "static", 60)
+
+	want := []string{"gateway", "api", "auth", "static"}
+	if got := diagram.Nodes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Nodes() = %v, want %v", got, want)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func() *Diagram
+		want  string
+	}{
+		{
+			name: "Empty diagram",
+			setup: func() *Diagram {
+				return NewDiagram()
+			},
+			want: "sankey-beta\n",
+		},
+		{
+			name: "Complete diagram",
+			setup: func() *Diagram {
+				d := NewDiagram()
+				d.SetTitle("Request routing")
+				d.AddLink("gateway", "api", 100)
+				d.AddLink("api", "auth, v2", 40.5)
+				d.Links = append(d.Links, nil)
+				return d
+			},
+			want: "sankey-beta\n" +
+				"    gateway,api,100\n" +
+				"    api,\"auth, v2\",40.5\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.setup().String()
+			if !strings.HasSuffix(got, "---\n"+tt.want) {
+				t.Errorf("String() = %q, want suffix %q", got, tt.want)
+			}
+		})
+	}
+}
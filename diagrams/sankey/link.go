@@ -0,0 +1,51 @@
+package sankey
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for sankey links
+const (
+	baseLink string = basediagram.Indentation + "%s,%s,%s\n"
+)
+
+// Link represents a flow of Value from the Source node to the Target node.
+// Nodes are identified by name and created by the first link that uses them.
+type Link struct {
+	Source string
+	Target string
+	Value  float64
+}
+
+// NewLink creates a new Link between the two nodes
+func NewLink(source string, target string, value float64) *Link {
+	return &Link{
+		Source: source,
+		Target: target,
+		Value:  value,
+	}
+}
+
+// String generates the CSV row for the link, quoting the node names
+// when needed and writing the value with as few digits as needed.
+func (l *Link) String() string {
+	return fmt.Sprintf(baseLink, quoteField(l.Source), quoteField(l.Target), strconv.FormatFloat(l.Value, 'f', -1, 64))
+}
+
+// quoteField returns name as a CSV field. Names holding a comma or a quote,
+// or whose surrounding spaces would be trimmed, are wrapped in double quotes
+// with the inner quotes doubled, as in RFC 4180.
+func quoteField(name string) string {
+	needsQuotes := strings.ContainsAny(name, `,"`) ||
+		strings.TrimSpace(name) != name ||
+		strings.HasPrefix(name, "%%")
+	if !needsQuotes {
+		return name
+	}
+
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
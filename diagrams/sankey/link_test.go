@@ -0,0 +1,55 @@
+package sankey
+
+import (
+	"testing"
+)
+
+func TestNewLink(t *testing.T) {
+	got := NewLink("api", "auth", 120)
+
+	if got.Source != "api" || got.Target != "auth" || got.Value != 120 {
+		t.Errorf("NewLink() = %+v, want api to auth with 120", got)
+	}
+}
+
+func TestLink_String(t *testing.T) {
+	tests := []struct {
+		name string
+		link *Link
+		want string
+	}{
+		{
+			name: "Plain names",
+			link: NewLink("Agricultural 'waste'", "Bio-conversion", 124.729),
+			want: "    Agricultural 'waste',Bio-conversion,124.729\n",
+		},
+		{
+			name: "Large value without exponent",
+			link: NewLink("a", "b", 12500000),
+			want: "    a,b,12500000\n",
+		},
+		{
+			name: "Name with a comma",
+			link: NewLink("Solar, rooftop", "Grid", 1),
+			want: "    \"Solar, rooftop\",Grid,1\n",
+		},
+		{
+			name: "Name with quotes",
+			link: NewLink(`The "edge"`, "api", 2.5),
+			want: "    \"The \"\"edge\"\"\",api,2.5\n",
+		},
+		{
+			name: "Name with surrounding spaces",
+			link: NewLink(" padded", "%%comment", 3),
+			want: "    \" padded\",\"%%comment\",3\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.link.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package sankey
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Parse reads Mermaid sankey syntax and returns the corresponding Diagram.
+// It understands the syntax generated by Diagram.String as well as the
+// `sankey` keyword, and reads each row as `source,target,value` where a
+// name may be double quoted with inner quotes doubled.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType, "sankey")
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	d := NewDiagram()
+	d.Title = doc.Title
+	if err := doc.Config.Apply(&d.Config.ConfigurationProperties, sankeyConfigurationSection, d.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := parseLine(d, line); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+// parseLine reads a `source,target,value` row.
+func parseLine(d *Diagram, line parser.Line) error {
+	s := parser.NewScanner(line)
+
+	source, err := readField(s)
+	if err != nil {
+		return err
+	}
+	if !s.Consume(",") {
+		return s.Errorf("expected ','")
+	}
+
+	target, err := readField(s)
+	if err != nil {
+		return err
+	}
+	if !s.Consume(",") {
+		return s.Errorf("expected ','")
+	}
+
+	s.SkipSpaces()
+	valuePos := s.Pos()
+	text := strings.TrimSpace(s.Rest())
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return s.ErrorAt(valuePos, "invalid value %q", text)
+	}
+
+	d.AddLink(source, target, value)
+
+	return nil
+}
+
+// readField reads a node name up to the next comma. A quoted name ends at
+// the closing quote and may hold commas and doubled quotes.
+func readField(s *parser.Scanner) (string, error) {
+	s.SkipSpaces()
+	if !s.HasPrefix(`"`) {
+		return strings.TrimSpace(s.ReadWhile(func(r rune) bool { return r != ',' })), nil
+	}
+
+	start := s.Pos()
+	s.Advance(1)
+
+	var sb strings.Builder
+	for {
+		text, ok := s.ReadUntil(`"`)
+		if !ok {
+			return "", s.ErrorAt(start, "unterminated string")
+		}
+		sb.WriteString(text)
+
+		if !s.Consume(`"`) {
+			break
+		}
+		sb.WriteString(`"`)
+	}
+	s.SkipSpaces()
+
+	return sb.String(), nil
+}
@@ -0,0 +1,159 @@
+package sankey
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Diagram with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Request routing"
+				d.Config.SetWidth(900).SetLinkColor("source").SetShowValues(true)
+				d.EnableMarkdownFence()
+				d.AddLink("gateway", "api", 100)
+			},
+		},
+		{
+			name: "Diagram with names that need quoting",
+			setup: func(d *Diagram) {
+				d.AddLink("Solar, rooftop", `The "edge"`, 12.5)
+				d.AddLink(`The "edge"`, " padded ", 0.001)
+				d.AddLink("#1; done", "%%not a comment", 3)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `sankey
+
+Agricultural 'waste',Bio-conversion,124.729
+Bio-conversion,Liquid,0.597
+"Bio-conversion","Losses, heat",26.862
+"Gas ""mains""" , Industry , 5
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	want := []*Link{
+		NewLink("Agricultural 'waste'", "Bio-conversion", 124.729),
+		NewLink("Bio-conversion", "Liquid", 0.597),
+		NewLink("Bio-conversion", "Losses, heat", 26.862),
+		NewLink(`Gas "mains"`, "Industry", 5),
+	}
+	if !reflect.DeepEqual(d.Links, want) {
+		t.Errorf("Parse() links = %+v, want %+v", d.Links, want)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "a,b,1\n",
+			line:    1,
+			column:  1,
+			message: "expected sankey-beta declaration",
+		},
+		{
+			name:    "Header with arguments",
+			input:   "sankey-beta LR\n",
+			line:    1,
+			column:  13,
+			message: `unexpected "LR"`,
+		},
+		{
+			name:    "Missing target",
+			input:   "sankey-beta\n    a\n",
+			line:    2,
+			column:  6,
+			message: "expected ','",
+		},
+		{
+			name:    "Missing value",
+			input:   "sankey-beta\n    a,b\n",
+			line:    2,
+			column:  8,
+			message: "expected ','",
+		},
+		{
+			name:    "Invalid value",
+			input:   "sankey-beta\n    a,b,many\n",
+			line:    2,
+			column:  9,
+			message: `invalid value "many"`,
+		},
+		{
+			name:    "Unterminated quoted name",
+			input:   "sankey-beta\n    a,\"b,1\n",
+			line:    2,
+			column:  7,
+			message: "unterminated string",
+		},
+		{
+			name:    "Text after a quoted name",
+			input:   "sankey-beta\n    \"a\"b,c,1\n",
+			line:    2,
+			column:  8,
+			message: "expected ','",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
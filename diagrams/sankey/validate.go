@@ -0,0 +1,77 @@
+package sankey
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// nil links, empty node names, names spanning several lines, negative or
+// non-finite values, and links that close a cycle, which Mermaid cannot lay out.
+// A cycle is reported on the first link, in order, that completes it.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	flows := make(map[string][]string)
+	for i, link := range d.Links {
+		path := fmt.Sprintf("Links[%d]", i)
+		if link == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing link")
+			continue
+		}
+
+		validateName(&v, path+".Source", link.Source)
+		validateName(&v, path+".Target", link.Target)
+
+		switch {
+		case math.IsNaN(link.Value) || math.IsInf(link.Value, 0):
+			v.Error(basediagram.CodeInvalidValue, path+".Value", "value %v is not a number", link.Value)
+		case link.Value < 0:
+			v.Error(basediagram.CodeOutOfRange, path+".Value", "value %v is negative", link.Value)
+		}
+
+		if reaches(flows, link.Target, link.Source) {
+			v.Error(basediagram.CodeInvalidValue, path, "link from %q to %q closes a cycle", link.Source, link.Target)
+			continue
+		}
+		flows[link.Source] = append(flows[link.Source], link.Target)
+	}
+
+	return v.Errors()
+}
+
+// validateName checks that a node name can be written as a single CSV field.
+func validateName(v *basediagram.Validator, path string, name string) {
+	switch {
+	case strings.TrimSpace(name) == "":
+		v.Error(basediagram.CodeEmptyID, path, "missing node name")
+	case strings.ContainsAny(name, "\r\n"):
+		v.Error(basediagram.CodeInvalidValue, path, "node name %q spans several lines", name)
+	}
+}
+
+// reaches reports whether the node to can be reached from the node from
+// by following flows.
+func reaches(flows map[string][]string, from string, to string) bool {
+	visited := make(map[string]bool)
+	pending := []string{from}
+
+	for len(pending) > 0 {
+		node := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		if node == to {
+			return true
+		}
+		if visited[node] {
+			continue
+		}
+		visited[node] = true
+		pending = append(pending, flows[node]...)
+	}
+
+	return false
+}
@@ -0,0 +1,74 @@
+package sankey
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				d.AddLink("gateway", "api", 100)
+				d.AddLink("gateway", "static", 0)
+				d.AddLink("api", "auth", 40)
+				d.AddLink("static", "auth", 1)
+			},
+		},
+		{
+			name: "Invalid links",
+			setup: func(d *Diagram) {
+				d.AddLink("", "api", 1)
+				d.AddLink("api", "two\nlines", -1)
+				d.AddLink("api", "auth", math.NaN())
+				d.Links = append(d.Links, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeEmptyID, Severity: basediagram.SeverityError, Path: "Links[0].Source", Message: "missing node name"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Links[1].Target", Message: `node name "two\nlines" spans several lines`},
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityError, Path: "Links[1].Value", Message: "value -1 is negative"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Links[2].Value", Message: "value NaN is not a number"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Links[3]", Message: "missing link"},
+			},
+		},
+		{
+			name: "Cycles",
+			setup: func(d *Diagram) {
+				d.AddLink("a", "a", 1)
+				d.AddLink("a", "b", 1)
+				d.AddLink("b", "c", 1)
+				d.AddLink("c", "a", 1)
+				d.AddLink("c", "b", 1)
+				d.AddLink("a", "c", 1)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Links[0]", Message: `link from "a" to "a" closes a cycle`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Links[3]", Message: `link from "c" to "a" closes a cycle`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Links[4]", Message: `link from "c" to "b" closes a cycle`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
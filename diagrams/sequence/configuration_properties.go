@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	baseSequenceConfigurationProperties    string = basediagram.Indentation + "sequence:\n"
+	sequenceConfigurationSection           string = "sequence"
+	baseSequenceConfigurationProperties    string = basediagram.Indentation + sequenceConfigurationSection + ":\n"
 	sequencePropertyArrowMarkerAbsolute    string = "arrowMarkerAbsolute"
 	sequencePropertyHideUnusedParticipants string = "hideUnusedParticipants"
 	sequencePropertyActivationWidth        string = "activationWidth"
@@ -382,9 +383,7 @@ func (c SequenceConfigurationProperties) String() string {
 
 	if len(c.properties) > 0 {
 		sb.WriteString(baseSequenceConfigurationProperties)
-		for _, prop := range c.properties {
-			sb.WriteString(prop.Format())
-		}
+		sb.WriteString(basediagram.FormatProperties(c.properties))
 	}
 
 	return sb.String()
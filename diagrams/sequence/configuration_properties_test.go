@@ -57,6 +57,7 @@ func TestSequenceConfigurationProperties_String(t *testing.T) {
 				"activationWidth: 10",
 				"diagramMarginX: 20",
 				"hideUnusedParticipants: true",
+				"activationWidth: 10\n        diagramMarginX: 20\n        hideUnusedParticipants: true\n",
 			},
 		},
 		{
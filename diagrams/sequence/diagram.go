@@ -2,7 +2,7 @@
 package sequence
 
 import (
-	"fmt"
+	"io"
 	"strings"
 
 	"github.com/TyphonHill/go-mermaid/diagrams/utils"
@@ -11,7 +11,8 @@ import (
 
 // Base string formats for sequence diagrams
 const (
-	baseDiagramType string = "sequenceDiagram\n"
+	diagramType     string = "sequenceDiagram"
+	baseDiagramType string = diagramType + "\n"
 )
 
 // Diagram represents a sequence diagram with actors, messages, and rendering options.
@@ -77,28 +78,55 @@ func (d *Diagram) AddMessage(from, to *Actor, msgType MessageType, text string)
 // String generates a Mermaid-formatted string representation of the sequence diagram.
 func (d *Diagram) String() string {
 	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
 
-	sb.WriteString(baseDiagramType)
-
-	if d.autonumber {
-		sb.WriteString("autonumber\n")
-	}
-
-	for _, actor := range d.Actors {
-		sb.WriteString(fmt.Sprintf(basediagram.Indentation+"%s %s as %s\n",
-			actor.Type, actor.ID, actor.Name))
-	}
-
-	for _, message := range d.Messages {
-		sb.WriteString(message.String(""))
-	}
-
-	return d.BaseDiagram.String(sb.String())
+// DiagramType returns the Mermaid keyword that introduces a sequence diagram.
+func (d *Diagram) DiagramType() string {
+	return diagramType
 }
 
 // RenderToFile saves the diagram to a file at the specified path.
 func (d *Diagram) RenderToFile(path string) error {
-	return utils.RenderToFile(path, d.String())
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one actor and message at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		if d.autonumber {
+			w.WriteString("autonumber\n")
+		}
+
+		// Created actors are declared by their creation message instead.
+		created := make(map[*Actor]bool)
+		collectCreated(d.Messages, created)
+
+		for _, actor := range d.Actors {
+			if created[actor] {
+				continue
+			}
+			w.Printf(basediagram.Indentation+"%s %s as %s\n",
+				actor.Type, actor.ID, basediagram.Escape(actor.Name))
+		}
+
+		for _, message := range d.Messages {
+			message.WriteTo(w)
+		}
+	})
+}
+
+// collectCreated marks the actors created by the messages and their nested messages.
+func collectCreated(messages []*Message, created map[*Actor]bool) {
+	for _, message := range messages {
+		if message.Type == MessageCreate && message.To != nil {
+			created[message.To] = true
+		}
+		collectCreated(message.Nested, created)
+	}
 }
 
 func (d *Diagram) AddNote(position NotePosition, text string, actors ...*Actor) *Note {
@@ -2,7 +2,10 @@ package sequence
 
 import (
 	"fmt"
+	"io"
 	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
 )
 
 // MessageType represents the different types of messages in a sequence diagram.
@@ -31,7 +34,7 @@ const (
 const (
 	baseMessage       string = "%s%s%s%s: %s\n" // indent, from, arrow, to, text
 	baseMessageNoDesc string = "%s%s%s%s\n"     // indent, from, arrow, to
-	baseCreate        string = "create "
+	baseCreate        string = "create %s %s as %s\n"
 	baseDestroy       string = "destroy %s\n"
 	baseActivate      string = "activate %s\n"
 	baseDeactivate    string = "deactivate %s\n"
@@ -80,51 +83,66 @@ func (m *Message) SetText(text string) *Message {
 // String generates a Mermaid-formatted string representation of the message with custom indentation.
 func (m *Message) String(curIndentation string) string {
 	var sb strings.Builder
+	m.writeTo(basediagram.NewWriter(&sb), curIndentation)
+	return sb.String()
+}
 
+// WriteTo writes the Mermaid representation of the message and its nested
+// messages to w, indented as a top-level message of a sequence diagram.
+func (m *Message) WriteTo(w io.Writer) (int64, error) {
+	cw := basediagram.NewWriter(w)
+	m.writeTo(cw, "")
+	return cw.Result()
+}
+
+// writeTo writes the message to w with the specified indentation,
+// streaming nested messages one by one.
+func (m *Message) writeTo(w *basediagram.Writer, curIndentation string) {
 	if m.Note != nil {
-		return m.Note.String(curIndentation)
+		w.WriteString(m.Note.String(curIndentation))
+		return
 	}
 
 	switch m.Type {
 	case MessageCreate:
+		w.Printf("%s\t%s", curIndentation,
+			fmt.Sprintf(baseCreate, m.To.Type, m.To.ID, basediagram.Escape(m.To.Name)))
 		if m.Text != "" {
-			sb.WriteString(fmt.Sprintf("%s\t%s", curIndentation,
-				fmt.Sprintf(baseMessage, baseCreate, m.From.ID, MessageSolid, m.To.ID, m.Text)))
+			w.Printf("%s\t%s", curIndentation,
+				fmt.Sprintf(baseMessage, "", m.From.ID, MessageSolid, m.To.ID, basediagram.Escape(m.Text)))
 		}
 	case MessageDestroy:
-		sb.WriteString(fmt.Sprintf("%s\t%s", curIndentation,
-			fmt.Sprintf(baseDestroy, m.To.ID)))
+		w.Printf("%s\t%s", curIndentation,
+			fmt.Sprintf(baseDestroy, m.To.ID))
 	case MessageActivate:
 		if m.Text != "" {
-			sb.WriteString(fmt.Sprintf("%s\t%s", curIndentation,
-				fmt.Sprintf(baseMessage, "", m.From.ID, "-->", m.To.ID, m.Text)))
+			w.Printf("%s\t%s", curIndentation,
+				fmt.Sprintf(baseMessage, "", m.From.ID, "-->", m.To.ID, basediagram.Escape(m.Text)))
 		}
-		sb.WriteString(fmt.Sprintf("%s\t%s", curIndentation,
-			fmt.Sprintf(baseActivate, m.To.ID)))
+		w.Printf("%s\t%s", curIndentation,
+			fmt.Sprintf(baseActivate, m.To.ID))
 	case MessageDeactivate:
 		if m.Text != "" {
-			sb.WriteString(fmt.Sprintf("%s\t%s", curIndentation,
-				fmt.Sprintf(baseMessage, "", m.From.ID, MessageSolid, m.To.ID, m.Text)))
+			w.Printf("%s\t%s", curIndentation,
+				fmt.Sprintf(baseMessage, "", m.From.ID, MessageSolid, m.To.ID, basediagram.Escape(m.Text)))
 		}
-		sb.WriteString(fmt.Sprintf("%s\t%s", curIndentation,
-			fmt.Sprintf(baseDeactivate, m.To.ID))) // Use To instead of From
+		w.Printf("%s\t%s", curIndentation,
+			fmt.Sprintf(baseDeactivate, m.To.ID)) // Use To instead of From
 	default:
 		arrow := string(m.Type)
 		if m.Text != "" {
-			sb.WriteString(fmt.Sprintf("%s\t%s", curIndentation,
-				fmt.Sprintf(baseMessage, "", m.From.ID, arrow, m.To.ID, m.Text)))
+			w.Printf("%s\t%s", curIndentation,
+				fmt.Sprintf(baseMessage, "", m.From.ID, arrow, m.To.ID, basediagram.Escape(m.Text)))
 		} else {
-			sb.WriteString(fmt.Sprintf("%s\t%s", curIndentation,
-				fmt.Sprintf(baseMessageNoDesc, "", m.From.ID, arrow, m.To.ID)))
+			w.Printf("%s\t%s", curIndentation,
+				fmt.Sprintf(baseMessageNoDesc, "", m.From.ID, arrow, m.To.ID))
 		}
 	}
 
 	if len(m.Nested) > 0 {
 		nextIndentation := fmt.Sprintf("%s\t", curIndentation)
 		for _, nested := range m.Nested {
-			sb.WriteString(nested.String(nextIndentation))
+			nested.writeTo(w, nextIndentation)
 		}
 	}
-
-	return sb.String()
 }
@@ -76,6 +76,14 @@ func TestMessage_String(t *testing.T) {
 				"Note over A,B: This is a note",
 			},
 		},
+		{
+			name:    "Message with text that needs escaping",
+			message: NewMessage(NewActor("A", "A", ActorParticipant), NewActor("B", "B", ActorParticipant), MessageAsync, "Fix #1; then #2"),
+			indent:  "",
+			contains: []string{
+				"A->>B: Fix #35;1#59; then #35;2",
+			},
+		},
 		{
 			name: "Message without text",
 			message: NewMessage(
@@ -89,17 +97,31 @@ func TestMessage_String(t *testing.T) {
 				"A-->B",
 			},
 		},
+		{
+			name: "Create message",
+			message: NewMessage(
+				&Actor{ID: "A"},
+				NewActor("B", "Bob", ActorParticipant),
+				MessageCreate,
+				"",
+			),
+			indent: "",
+			contains: []string{
+				"create participant B as Bob\n",
+			},
+		},
 		{
 			name: "Create message with text",
 			message: NewMessage(
 				&Actor{ID: "A"},
-				&Actor{ID: "B"},
+				NewActor("B", "Bob", ActorActor),
 				MessageCreate,
 				"Creates B",
 			),
 			indent: "",
 			contains: []string{
-				"create A-->B: Creates B",
+				"create actor B as Bob\n",
+				"A-->B: Creates B",
 			},
 		},
 		{
@@ -295,3 +317,26 @@ func TestMessage_AddNestedMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestMessage_WriteTo(t *testing.T) {
+	alice := NewActor("A", "Alice", ActorParticipant)
+	bob := NewActor("B", "Bob", ActorParticipant)
+
+	message := NewMessage(alice, bob, MessageSolidArrow, "Hello")
+	nested := message.AddNestedMessage(bob, alice, MessageResponse, "Hi")
+	nested.AddNestedMessage(alice, bob, MessageActivate, "")
+
+	var sb strings.Builder
+	n, err := message.WriteTo(&sb)
+	if err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+
+	want := message.String("")
+	if sb.String() != want {
+		t.Errorf("WriteTo() = %q, want %q", sb.String(), want)
+	}
+	if n != int64(len(want)) {
+		t.Errorf("WriteTo() wrote %d bytes, want %d", n, len(want))
+	}
+}
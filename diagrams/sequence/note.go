@@ -3,6 +3,8 @@ package sequence
 import (
 	"fmt"
 	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
 )
 
 // NotePosition represents the positioning of a note in a sequence diagram.
@@ -51,20 +53,20 @@ func (n *Note) String(curIndentation string) string {
 	case NoteLeft:
 		if len(n.Actors) == 1 {
 			sb.WriteString(fmt.Sprintf("%s\t%s", curIndentation,
-				fmt.Sprintf(baseNoteLeft, n.Actors[0].ID, n.Text)))
+				fmt.Sprintf(baseNoteLeft, n.Actors[0].ID, basediagram.Escape(n.Text))))
 		}
 	case NoteRight:
 		if len(n.Actors) == 1 {
 			sb.WriteString(fmt.Sprintf("%s\t%s", curIndentation,
-				fmt.Sprintf(baseNoteRight, n.Actors[0].ID, n.Text)))
+				fmt.Sprintf(baseNoteRight, n.Actors[0].ID, basediagram.Escape(n.Text))))
 		}
 	case NoteOver:
 		if len(n.Actors) == 1 {
 			sb.WriteString(fmt.Sprintf("%s\t%s", curIndentation,
-				fmt.Sprintf(baseNoteOver, n.Actors[0].ID, n.Text)))
+				fmt.Sprintf(baseNoteOver, n.Actors[0].ID, basediagram.Escape(n.Text))))
 		} else if len(n.Actors) == 2 {
 			sb.WriteString(fmt.Sprintf("%s\t%s", curIndentation,
-				fmt.Sprintf(baseNoteOverMulti, n.Actors[0].ID, n.Actors[1].ID, n.Text)))
+				fmt.Sprintf(baseNoteOverMulti, n.Actors[0].ID, n.Actors[1].ID, basediagram.Escape(n.Text))))
 		}
 	}
 
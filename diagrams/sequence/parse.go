@@ -0,0 +1,299 @@
+package sequence
+
+import (
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// messageArrowPattern matches message arrows such as `->>`, `-->`, `-x` or `<<->>`,
+// followed by an optional activation marker.
+var messageArrowPattern = regexp.MustCompile(`^((?:<<)?--?(?:>>>|>>|>|x|\)))([+-]?)`)
+
+type pendingCreate struct {
+	actor *Actor
+	line  parser.Line
+}
+
+type sequenceParser struct {
+	diagram *Diagram
+	actors  map[string]*Actor
+	parents []*Message
+	created *pendingCreate
+}
+
+// Parse reads Mermaid sequence diagram syntax and returns the corresponding Diagram.
+// It understands the syntax generated by Diagram.String as well as the standard
+// `create participant` form and the `+`/`-` activation shorthands, which are
+// turned into separate activate and deactivate messages. Messages indented with
+// additional tabs are nested under the previous message, as Message.String renders them.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &sequenceParser{
+		diagram: NewDiagram(),
+		actors:  make(map[string]*Actor),
+	}
+	p.diagram.Title = doc.Title
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, sequenceConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.created != nil {
+		return nil, p.created.line.Errorf(0, "created participant %q never receives a message", p.created.actor.ID)
+	}
+
+	return p.diagram, nil
+}
+
+func (p *sequenceParser) parseLine(line parser.Line) error {
+	s := parser.NewScanner(line)
+	keyword := s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) })
+	s.SkipSpaces()
+
+	switch keyword {
+	case "autonumber":
+		if !s.EOF() {
+			return s.Errorf("unsupported autonumber arguments %q", s.Rest())
+		}
+		p.diagram.EnableAutoNumber()
+		return nil
+	case string(ActorParticipant), string(ActorActor):
+		_, err := p.parseActor(s, ActorType(keyword))
+		return err
+	case "create":
+		return p.parseCreate(s)
+	case string(MessageDestroy):
+		return p.parseActorStatement(s, MessageDestroy)
+	case "activate":
+		return p.parseActorStatement(s, MessageActivate)
+	case "deactivate":
+		return p.parseActorStatement(s, MessageDeactivate)
+	case "Note", "note":
+		return p.parseNote(s)
+	case "loop", "alt", "else", "opt", "par", "and", "critical", "option", "break", "rect", "end",
+		"box", "link", "links", "properties", "details":
+		return line.Errorf(0, "unsupported statement %q", keyword)
+	}
+
+	return p.parseMessage(parser.NewScanner(line))
+}
+
+// parseActor reads `participant A` or `actor A as Name`.
+func (p *sequenceParser) parseActor(s *parser.Scanner, actorType ActorType) (*Actor, error) {
+	idPos := s.Pos()
+	id := s.ReadWhile(parser.IsIdentifier)
+	if id == "" {
+		return nil, s.Errorf("expected participant identifier")
+	}
+	if _, ok := p.actors[id]; ok {
+		return nil, s.ErrorAt(idPos, "duplicate participant %q", id)
+	}
+	s.SkipSpaces()
+
+	name := id
+	if s.Consume("as ") {
+		s.SkipSpaces()
+		name = basediagram.Unescape(s.Rest())
+		if name == "" {
+			return nil, s.Errorf("expected participant name")
+		}
+	} else if !s.EOF() {
+		return nil, s.Errorf("unexpected %q", s.Rest())
+	}
+
+	actor := p.diagram.AddActor(id, name, actorType)
+	p.actors[id] = actor
+
+	return actor, nil
+}
+
+// parseCreate reads `create participant A`, whose creation message is added
+// once the participant receives its first message.
+func (p *sequenceParser) parseCreate(s *parser.Scanner) error {
+	for _, actorType := range []ActorType{ActorParticipant, ActorActor} {
+		if !s.Consume(string(actorType) + " ") {
+			continue
+		}
+		s.SkipSpaces()
+
+		actor, err := p.parseActor(s, actorType)
+		if err != nil {
+			return err
+		}
+		p.created = &pendingCreate{actor: actor, line: s.Line()}
+		return nil
+	}
+
+	return s.Errorf("expected participant or actor")
+}
+
+// parseActorStatement reads `destroy A`, `activate A` or `deactivate A`.
+func (p *sequenceParser) parseActorStatement(s *parser.Scanner, msgType MessageType) error {
+	id := s.ReadWhile(parser.IsIdentifier)
+	if id == "" {
+		return s.Errorf("expected participant identifier")
+	}
+	if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	p.add(s.Line(), &Message{
+		To:     p.actor(id),
+		Type:   msgType,
+		Nested: make([]*Message, 0),
+	})
+
+	return nil
+}
+
+// parseNote reads `Note left of A: text` or `Note over A,B: text`.
+func (p *sequenceParser) parseNote(s *parser.Scanner) error {
+	var position NotePosition
+	for _, candidate := range []NotePosition{NoteLeft, NoteRight, NoteOver} {
+		if s.Consume(string(candidate)) {
+			position = candidate
+			break
+		}
+	}
+	if position == "" {
+		return s.Errorf("expected note position")
+	}
+	s.SkipSpaces()
+
+	actorsPos := s.Pos()
+	ids, ok := s.ReadUntil(":")
+	if !ok {
+		return s.Errorf("expected ':'")
+	}
+
+	var actors []*Actor
+	for _, id := range strings.Split(ids, ",") {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			return s.ErrorAt(actorsPos, "expected participant identifier")
+		}
+		actors = append(actors, p.actor(id))
+	}
+
+	if len(actors) > 2 || (len(actors) > 1 && position != NoteOver) {
+		return s.ErrorAt(actorsPos, "note %s cannot span %d participants", position, len(actors))
+	}
+
+	p.add(s.Line(), &Message{
+		Note: newNote(position, basediagram.Unescape(strings.TrimSpace(s.Rest())), actors...),
+	})
+
+	return nil
+}
+
+// parseMessage reads `A->>B: text`, including the `+`/`-` activation shorthands.
+func (p *sequenceParser) parseMessage(s *parser.Scanner) error {
+	message, activation, err := p.readMessage(s)
+	if err != nil {
+		return err
+	}
+
+	if p.created != nil && message.To == p.created.actor {
+		p.add(s.Line(), &Message{
+			From:   message.From,
+			To:     message.To,
+			Type:   MessageCreate,
+			Nested: make([]*Message, 0),
+		})
+		p.created = nil
+	}
+
+	p.add(s.Line(), message)
+
+	switch activation {
+	case string(MessageActivate):
+		p.add(s.Line(), &Message{To: message.To, Type: MessageActivate, Nested: make([]*Message, 0)})
+	case string(MessageDeactivate):
+		p.add(s.Line(), &Message{To: message.From, Type: MessageDeactivate, Nested: make([]*Message, 0)})
+	}
+
+	return nil
+}
+
+// readMessage reads a message between two participants, returning the
+// activation marker that follows its arrow, if any.
+func (p *sequenceParser) readMessage(s *parser.Scanner) (*Message, string, error) {
+	from := s.ReadWhile(parser.IsIdentifier)
+	if from == "" {
+		return nil, "", s.Errorf("expected participant identifier")
+	}
+	s.SkipSpaces()
+
+	m := messageArrowPattern.FindStringSubmatch(s.Rest())
+	if m == nil {
+		return nil, "", s.Errorf("expected message arrow")
+	}
+	s.Advance(len(m[0]))
+	s.SkipSpaces()
+
+	to := s.ReadWhile(parser.IsIdentifier)
+	if to == "" {
+		return nil, "", s.Errorf("expected participant identifier")
+	}
+	s.SkipSpaces()
+
+	text := ""
+	if s.Consume(":") {
+		text = basediagram.Unescape(strings.TrimSpace(s.Rest()))
+	} else if !s.EOF() {
+		return nil, "", s.Errorf("expected ':'")
+	}
+
+	return NewMessage(p.actor(from), p.actor(to), MessageType(m[1]), text), m[2], nil
+}
+
+// add appends a message to the diagram, nesting it under the previous message
+// when it is indented with more tabs.
+func (p *sequenceParser) add(line parser.Line, message *Message) {
+	depth := strings.Count(line.Raw[:line.Offset], "\t")
+
+	if depth > 1 && depth-2 < len(p.parents) {
+		parent := p.parents[depth-2]
+		parent.Nested = append(parent.Nested, message)
+		p.parents = append(p.parents[:depth-1], message)
+		return
+	}
+
+	p.diagram.Messages = append(p.diagram.Messages, message)
+	p.parents = append(p.parents[:0], message)
+}
+
+// actor returns the participant with the given ID, declaring it on first use.
+func (p *sequenceParser) actor(id string) *Actor {
+	if actor, ok := p.actors[id]; ok {
+		return actor
+	}
+
+	actor := p.diagram.AddActor(id, id, ActorParticipant)
+	p.actors[id] = actor
+
+	return actor
+}
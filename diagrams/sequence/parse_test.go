@@ -0,0 +1,268 @@
+package sequence
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Diagram with title, autonumber and every message type",
+			setup: func(d *Diagram) {
+				d.SetTitle("Login")
+				d.EnableAutoNumber()
+				alice := d.AddActor("A", "Alice", ActorActor)
+				bob := d.AddActor("B", "Bob Builder", ActorParticipant)
+				for _, msgType := range []MessageType{MessageSolid, MessageSolidArrow, MessageAsync, MessageDotted} {
+					d.AddMessage(alice, bob, msgType, "Hello "+string(msgType))
+				}
+				d.AddMessage(bob, alice, MessageAsync, "")
+			},
+		},
+		{
+			name: "Diagram with activation, creation, destruction and notes",
+			setup: func(d *Diagram) {
+				alice := d.AddActor("A", "Alice", ActorParticipant)
+				bob := d.AddActor("B", "Bob", ActorParticipant)
+				d.AddMessage(alice, bob, MessageActivate, "Start work")
+				d.AddMessage(bob, alice, MessageDeactivate, "Done")
+				carl := d.CreateActor(alice, "C", "Carl", ActorActor)
+				d.Messages[len(d.Messages)-1].Text = "Welcome"
+				d.AddNote(NoteLeft, "left note", alice)
+				d.AddNote(NoteRight, "right note", bob)
+				d.AddNote(NoteOver, "spanning note", alice, carl)
+				d.DestroyActor(carl)
+			},
+		},
+		{
+			name: "Diagram with a created participant and its first message",
+			setup: func(d *Diagram) {
+				alice := d.AddActor("A", "Alice", ActorParticipant)
+				bob := d.CreateActor(alice, "B", "Bob", ActorParticipant)
+				d.AddMessage(alice, bob, MessageAsync, "hi")
+			},
+		},
+		{
+			name: "Diagram with text that needs escaping",
+			setup: func(d *Diagram) {
+				a := d.AddActor("A", "Alice #1", ActorParticipant)
+				b := d.AddActor("B", "Bob; the builder", ActorActor)
+				d.AddMessage(a, b, MessageSolidArrow, "Issue #42; see \"notes\"")
+				d.AddNote(NoteOver, "a: b; c", a, b)
+			},
+		},
+		{
+			name: "Diagram with nested messages",
+			setup: func(d *Diagram) {
+				alice := d.AddActor("A", "Alice", ActorParticipant)
+				bob := d.AddActor("B", "Bob", ActorParticipant)
+				request := d.AddMessage(alice, bob, MessageSolidArrow, "Request")
+				inner := request.AddNestedMessage(bob, alice, MessageAsync, "Inner")
+				inner.AddNestedMessage(alice, bob, MessageSolid, "Innermost")
+				request.AddNestedMessage(bob, alice, MessageDotted, "Second inner")
+				d.AddMessage(bob, alice, MessageSolid, "Response")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_CreateParticipant(t *testing.T) {
+	input := "sequenceDiagram\n    participant A\n    create participant B\n    A->>B: hi\n"
+	want := "sequenceDiagram\n    participant A as A\n\tcreate participant B as B\n\tA->>B: hi\n"
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if got := d.String(); !strings.HasSuffix(got, want) {
+		t.Errorf("String() = %q, want it to end with %q", got, want)
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `sequenceDiagram
+    participant Alice
+    actor Bob as Bob the Builder
+    Alice->>+Bob: Hello Bob
+    Bob-->>-Alice: Hi Alice
+    create participant Carl
+    Alice->>Carl: Welcome
+    Alice-xDave: Lost
+    note over Alice,Bob: A note
+    destroy Carl
+    Carl-)Alice: Bye
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	wantActors := []Actor{
+		{ID: "Alice", Name: "Alice", Type: ActorParticipant},
+		{ID: "Bob", Name: "Bob the Builder", Type: ActorActor},
+		{ID: "Carl", Name: "Carl", Type: ActorParticipant},
+		{ID: "Dave", Name: "Dave", Type: ActorParticipant},
+	}
+
+	if len(d.Actors) != len(wantActors) {
+		t.Fatalf("Parse() got %d actors, want %d", len(d.Actors), len(wantActors))
+	}
+
+	for i, want := range wantActors {
+		if *d.Actors[i] != want {
+			t.Errorf("Parse() actor %d = %+v, want %+v", i, *d.Actors[i], want)
+		}
+	}
+
+	wantMessages := []struct {
+		msgType MessageType
+		from    string
+		to      string
+		text    string
+	}{
+		{MessageAsync, "Alice", "Bob", "Hello Bob"},
+		{MessageActivate, "", "Bob", ""},
+		{MessageSolidArrow, "Bob", "Alice", "Hi Alice"},
+		{MessageDeactivate, "", "Bob", ""},
+		{MessageCreate, "Alice", "Carl", ""},
+		{MessageAsync, "Alice", "Carl", "Welcome"},
+		{MessageType("-x"), "Alice", "Dave", "Lost"},
+		{"", "", "", ""},
+		{MessageDestroy, "", "Carl", ""},
+		{MessageType("-)"), "Carl", "Alice", "Bye"},
+	}
+
+	if len(d.Messages) != len(wantMessages) {
+		t.Fatalf("Parse() got %d messages, want %d", len(d.Messages), len(wantMessages))
+	}
+
+	for i, want := range wantMessages {
+		msg := d.Messages[i]
+		from, to := "", ""
+		if msg.From != nil {
+			from = msg.From.ID
+		}
+		if msg.To != nil {
+			to = msg.To.ID
+		}
+		if msg.Type != want.msgType || from != want.from || to != want.to || msg.Text != want.text {
+			t.Errorf("Parse() message %d = {%s %s %s %q}, want %+v", i, msg.Type, from, to, msg.Text, want)
+		}
+	}
+
+	note := d.Messages[7].Note
+	if note == nil || note.Position != NoteOver || note.Text != "A note" || len(note.Actors) != 2 {
+		t.Errorf("Parse() note = %+v, want note over Alice and Bob", note)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "Alice->>Bob: Hi\n",
+			line:    1,
+			column:  1,
+			message: "expected sequenceDiagram declaration",
+		},
+		{
+			name:    "Invalid arrow",
+			input:   "sequenceDiagram\n    Alice=>Bob: Hi\n",
+			line:    2,
+			column:  10,
+			message: "expected message arrow",
+		},
+		{
+			name:    "Note over three participants",
+			input:   "sequenceDiagram\n    Note over A,B,C: Hi\n",
+			line:    2,
+			column:  15,
+			message: "note over cannot span 3 participants",
+		},
+		{
+			name:    "Note without position",
+			input:   "sequenceDiagram\n    Note above A: Hi\n",
+			line:    2,
+			column:  10,
+			message: "expected note position",
+		},
+		{
+			name:    "Duplicate participant",
+			input:   "sequenceDiagram\n    participant A\n    actor A\n",
+			line:    3,
+			column:  11,
+			message: `duplicate participant "A"`,
+		},
+		{
+			name:    "Create without participant",
+			input:   "sequenceDiagram\n    create A->>B: hi\n",
+			line:    2,
+			column:  12,
+			message: "expected participant or actor",
+		},
+		{
+			name:    "Created participant without message",
+			input:   "sequenceDiagram\n    create actor A\n",
+			line:    2,
+			column:  5,
+			message: `created participant "A" never receives a message`,
+		},
+		{
+			name:    "Unsupported block",
+			input:   "sequenceDiagram\n    loop Every minute\n",
+			line:    2,
+			column:  5,
+			message: `unsupported statement "loop"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
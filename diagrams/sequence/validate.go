@@ -0,0 +1,87 @@
+package sequence
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently
+// or panic on: missing actors and messages, duplicate actor IDs, messages and
+// notes referring to actors that are missing or were never added, and notes
+// whose actor count does not match their position.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	actors := make(map[*Actor]bool, len(d.Actors))
+	for i, actor := range d.Actors {
+		path := fmt.Sprintf("Actors[%d]", i)
+		if actor == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing actor")
+			continue
+		}
+		v.UniqueID(path, actor.ID)
+		actors[actor] = true
+	}
+
+	for i, message := range d.Messages {
+		validateMessage(&v, fmt.Sprintf("Messages[%d]", i), message, actors)
+	}
+
+	return v.Errors()
+}
+
+func validateMessage(v *basediagram.Validator, path string, message *Message, actors map[*Actor]bool) {
+	if message == nil {
+		v.Error(basediagram.CodeMissingReference, path, "missing message")
+		return
+	}
+
+	if message.Note != nil {
+		validateNote(v, path+".Note", message.Note, actors)
+	} else {
+		if message.needsFrom() {
+			validateActor(v, path+".From", message.From, actors)
+		}
+		validateActor(v, path+".To", message.To, actors)
+	}
+
+	for i, nested := range message.Nested {
+		validateMessage(v, fmt.Sprintf("%s.Nested[%d]", path, i), nested, actors)
+	}
+}
+
+// needsFrom reports whether the sender of the message is rendered.
+func (m *Message) needsFrom() bool {
+	switch m.Type {
+	case MessageDestroy:
+		return false
+	case MessageCreate, MessageActivate, MessageDeactivate:
+		return m.Text != ""
+	}
+	return true
+}
+
+func validateNote(v *basediagram.Validator, path string, note *Note, actors map[*Actor]bool) {
+	switch {
+	case note.Position == NoteOver && (len(note.Actors) < 1 || len(note.Actors) > 2):
+		v.Error(basediagram.CodeInvalidValue, path+".Actors", "note over takes 1 or 2 actors, got %d", len(note.Actors))
+		return
+	case note.Position != NoteOver && len(note.Actors) != 1:
+		v.Error(basediagram.CodeInvalidValue, path+".Actors", "note %s takes 1 actor, got %d", note.Position, len(note.Actors))
+		return
+	}
+
+	for i, actor := range note.Actors {
+		validateActor(v, fmt.Sprintf("%s.Actors[%d]", path, i), actor, actors)
+	}
+}
+
+func validateActor(v *basediagram.Validator, path string, actor *Actor, actors map[*Actor]bool) {
+	switch {
+	case actor == nil:
+		v.Error(basediagram.CodeMissingReference, path, "missing actor")
+	case !actors[actor]:
+		v.Error(basediagram.CodeUnknownReference, path, "actor %q is not part of the diagram", actor.ID)
+	}
+}
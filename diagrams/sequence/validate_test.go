@@ -0,0 +1,110 @@
+package sequence
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				alice := d.AddActor("A", "Alice", ActorParticipant)
+				bob := d.CreateActor(alice, "B", "Bob", ActorActor)
+				d.AddMessage(alice, bob, MessageSolidArrow, "Hello").AddNestedMessage(bob, alice, MessageResponse, "Hi")
+				d.AddNote(NoteOver, "Both", alice, bob)
+				d.AddNote(NoteLeft, "Alice", alice)
+				d.DestroyActor(bob)
+			},
+		},
+		{
+			name: "Note with three actors",
+			setup: func(d *Diagram) {
+				a := d.AddActor("A", "Alice", ActorParticipant)
+				b := d.AddActor("B", "Bob", ActorParticipant)
+				c := d.AddActor("C", "Carol", ActorParticipant)
+				d.AddNote(NoteOver, "Everyone", a, b, c)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Messages[0].Note.Actors", Message: "note over takes 1 or 2 actors, got 3"},
+			},
+		},
+		{
+			name: "Side note with two actors",
+			setup: func(d *Diagram) {
+				a := d.AddActor("A", "Alice", ActorParticipant)
+				b := d.AddActor("B", "Bob", ActorParticipant)
+				d.AddNote(NoteRight, "Both", a, b)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Messages[0].Note.Actors", Message: "note right of takes 1 actor, got 2"},
+			},
+		},
+		{
+			name: "Nested message to an actor that was never added",
+			setup: func(d *Diagram) {
+				a := d.AddActor("A", "Alice", ActorParticipant)
+				d.AddMessage(a, a, MessageAsync, "Think").AddNestedMessage(a, NewActor("X", "Ghost", ActorActor), MessageAsync, "Boo")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "Messages[0].Nested[0].To", Message: `actor "X" is not part of the diagram`},
+			},
+		},
+		{
+			name: "Message without sender",
+			setup: func(d *Diagram) {
+				d.AddMessage(nil, d.AddActor("A", "Alice", ActorParticipant), MessageSolid, "Hello")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Messages[0].From", Message: "missing actor"},
+			},
+		},
+		{
+			name: "Duplicate actor IDs",
+			setup: func(d *Diagram) {
+				d.AddActor("A", "Alice", ActorParticipant)
+				d.AddActor("A", "Another Alice", ActorActor)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Actors[1]", Message: `ID "A" is already used by Actors[0]`},
+			},
+		},
+		{
+			name: "Missing actor and messages",
+			setup: func(d *Diagram) {
+				alice := d.AddActor("A", "Alice", ActorParticipant)
+				message := d.AddMessage(alice, alice, MessageSolid, "Think")
+				message.Nested = append(message.Nested, nil)
+				d.Actors = append(d.Actors, nil)
+				d.Messages = append(d.Messages, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Actors[1]", Message: "missing actor"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Messages[0].Nested[0]", Message: "missing message"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Messages[1]", Message: "missing message"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
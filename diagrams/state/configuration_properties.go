@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	baseStateConfigurationProperties string = basediagram.Indentation + "state:\n"
+	stateConfigurationSection        string = "state"
+	baseStateConfigurationProperties string = basediagram.Indentation + stateConfigurationSection + ":\n"
 	statePropertyTitleTopMargin      string = "titleTopMargin"
 	statePropertyArrowMarkerAbsolute string = "arrowMarkerAbsolute"
 	statePropertyDividerMargin       string = "dividerMargin"
@@ -249,9 +250,7 @@ func (c StateConfigurationProperties) String() string {
 
 	if len(c.properties) > 0 {
 		sb.WriteString(baseStateConfigurationProperties)
-		for _, prop := range c.properties {
-			sb.WriteString(prop.Format())
-		}
+		sb.WriteString(basediagram.FormatProperties(c.properties))
 	}
 
 	return sb.String()
@@ -2,6 +2,7 @@
 package state
 
 import (
+	"io"
 	"strings"
 
 	"github.com/TyphonHill/go-mermaid/diagrams/utils"
@@ -10,7 +11,8 @@ import (
 
 // Base string formats for state diagrams
 const (
-	baseDiagramType string = "stateDiagram-v2\n"
+	diagramType     string = "stateDiagram-v2"
+	baseDiagramType string = diagramType + "\n"
 )
 
 // Diagram represents a state diagram with states, transitions, and rendering options.
@@ -46,21 +48,31 @@ func (d *Diagram) AddTransition(from, to *State, description string) *Transition
 // String generates a Mermaid-formatted string representation of the state diagram.
 func (d *Diagram) String() string {
 	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
 
-	sb.WriteString(baseDiagramType)
-
-	for _, state := range d.States {
-		sb.WriteString(state.String(""))
-	}
-
-	for _, transition := range d.Transitions {
-		sb.WriteString(transition.String(""))
-	}
-
-	return d.BaseDiagram.String(sb.String())
+// DiagramType returns the Mermaid keyword that introduces a state diagram.
+func (d *Diagram) DiagramType() string {
+	return diagramType
 }
 
 // RenderToFile saves the diagram to a file at the specified path.
 func (d *Diagram) RenderToFile(path string) error {
-	return utils.RenderToFile(path, d.String())
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one element at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		for _, state := range d.States {
+			w.WriteString(state.String(""))
+		}
+
+		for _, transition := range d.Transitions {
+			w.WriteString(transition.String(""))
+		}
+	})
 }
@@ -0,0 +1,313 @@
+package state
+
+import (
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+const transitionArrow = "-->"
+
+// pseudoStateTypes maps the `<<...>>` markers to their state types.
+var pseudoStateTypes = map[string]StateType{
+	"<<choice>>": StateChoice,
+	"<<fork>>":   StateFork,
+	"<<join>>":   StateJoin,
+}
+
+type openComposite struct {
+	state *State
+	line  parser.Line
+}
+
+// openNote is a multi-line note waiting for its `end note`.
+type openNote struct {
+	state    *State
+	position NotePosition
+	lines    []string
+	line     parser.Line
+}
+
+type stateParser struct {
+	diagram    *Diagram
+	states     map[string]*State
+	composites []openComposite
+	note       *openNote
+}
+
+// Parse reads Mermaid state diagram syntax and returns the corresponding Diagram.
+// It understands the syntax generated by Diagram.String as well as the
+// `id : description` form. Transitions from `[*]` or to `[*]` without a
+// description become start and end states of the enclosing scope, while every
+// other transition is added to the diagram, since the model keeps a single
+// list of transitions. States are declared on first use.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &stateParser{
+		diagram: NewDiagram(),
+		states:  make(map[string]*State),
+	}
+	p.diagram.Title = doc.Title
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, stateConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.note != nil {
+		return nil, p.note.line.Errorf(0, "note of state %q is missing its 'end note'", p.note.state.ID)
+	}
+
+	if len(p.composites) > 0 {
+		open := p.composites[len(p.composites)-1]
+		return nil, open.line.Errorf(0, "state %q is missing its '}'", open.state.ID)
+	}
+
+	return p.diagram, nil
+}
+
+func (p *stateParser) parseLine(line parser.Line) error {
+	if p.note != nil {
+		if line.Text == "end note" {
+			p.note.state.AddNote(strings.Join(p.note.lines, "\n"), p.note.position)
+			p.note = nil
+		} else {
+			p.note.lines = append(p.note.lines, basediagram.Unescape(line.Text))
+		}
+		return nil
+	}
+
+	if line.Text == "}" {
+		if len(p.composites) == 0 {
+			return line.Errorf(0, "unexpected '}'")
+		}
+		p.composites = p.composites[:len(p.composites)-1]
+		return nil
+	}
+
+	s := parser.NewScanner(line)
+	keyword := s.ReadWhile(func(r rune) bool { return !unicode.IsSpace(r) })
+	s.SkipSpaces()
+
+	switch keyword {
+	case "state":
+		return p.parseState(s)
+	case "note":
+		return p.parseNote(s)
+	case "direction", "classDef", "class", "style", "click", "--":
+		return line.Errorf(0, "unsupported statement %q", keyword)
+	}
+
+	return p.parseStatement(parser.NewScanner(line))
+}
+
+// parseState reads `state id`, `state "description" as id`, `state id <<choice>>`
+// or `state id {`.
+func (p *stateParser) parseState(s *parser.Scanner) error {
+	description := ""
+	if s.HasPrefix(`"`) {
+		text, err := s.ReadQuoted()
+		if err != nil {
+			return err
+		}
+		description = basediagram.Unescape(text)
+		s.SkipSpaces()
+		if !s.Consume("as ") {
+			return s.Errorf("expected 'as'")
+		}
+		s.SkipSpaces()
+	}
+
+	id := s.ReadWhile(parser.IsIdentifier)
+	if id == "" {
+		return s.Errorf("expected state identifier")
+	}
+	s.SkipSpaces()
+
+	state := p.state(id)
+	if description != "" {
+		state.Description = description
+	}
+
+	switch {
+	case s.HasPrefix("<<"):
+		marker := s.Rest()
+		stateType, ok := pseudoStateTypes[marker]
+		if !ok {
+			return s.Errorf("unknown state type %q", marker)
+		}
+		state.Type = stateType
+	case s.Consume("{"):
+		if !s.EOF() {
+			return s.Errorf("unexpected %q", s.Rest())
+		}
+		if state.Type == StateNormal {
+			state.Type = StateComposite
+		}
+		p.composites = append(p.composites, openComposite{state: state, line: s.Line()})
+	case !s.EOF():
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	return nil
+}
+
+// parseNote reads `note left of id: text` or `note right of id: text`, or
+// starts a multi-line note whose lines run up to `end note`.
+func (p *stateParser) parseNote(s *parser.Scanner) error {
+	var position NotePosition
+	for _, candidate := range []NotePosition{NoteLeft, NoteRight} {
+		if s.Consume(string(candidate)) {
+			position = candidate
+			break
+		}
+	}
+	if position == "" {
+		return s.Errorf("expected note position")
+	}
+	s.SkipSpaces()
+
+	if !s.Consume("of ") {
+		return s.Errorf("expected 'of'")
+	}
+	s.SkipSpaces()
+
+	id := s.ReadWhile(parser.IsIdentifier)
+	if id == "" {
+		return s.Errorf("expected state identifier")
+	}
+	s.SkipSpaces()
+
+	if s.EOF() {
+		p.note = &openNote{state: p.state(id), position: position, line: s.Line()}
+		return nil
+	}
+	if !s.Consume(":") {
+		return s.Errorf("expected ':'")
+	}
+
+	p.state(id).AddNote(basediagram.Unescape(strings.TrimSpace(s.Rest())), position)
+
+	return nil
+}
+
+// parseStatement reads a transition such as `A --> B: text`, a state
+// declaration `A` or a state description `A : text`.
+func (p *stateParser) parseStatement(s *parser.Scanner) error {
+	fromPos := s.Pos()
+	from, err := readEndpoint(s)
+	if err != nil {
+		return err
+	}
+	s.SkipSpaces()
+
+	if !s.Consume(transitionArrow) {
+		if from == terminalState {
+			return s.Errorf("expected '%s'", transitionArrow)
+		}
+		if s.Consume(":") {
+			p.state(from).Description = basediagram.Unescape(strings.TrimSpace(s.Rest()))
+			return nil
+		}
+		if !s.EOF() {
+			return s.Errorf("expected '%s'", transitionArrow)
+		}
+		p.state(from)
+		return nil
+	}
+	s.SkipSpaces()
+
+	to, err := readEndpoint(s)
+	if err != nil {
+		return err
+	}
+	if from == terminalState && to == terminalState {
+		return s.ErrorAt(fromPos, "transition cannot both start and end at %s", terminalState)
+	}
+	s.SkipSpaces()
+
+	description := ""
+	if s.Consume(":") {
+		description = basediagram.Unescape(strings.TrimSpace(s.Rest()))
+	} else if !s.EOF() {
+		return s.Errorf("expected ':'")
+	}
+
+	if description == "" && from == terminalState {
+		p.add(NewState(to, "", StateStart))
+		return nil
+	}
+	if description == "" && to == terminalState {
+		p.add(NewState(from, "", StateEnd))
+		return nil
+	}
+
+	var fromState, toState *State
+	if from != terminalState {
+		fromState = p.state(from)
+	}
+	if to != terminalState {
+		toState = p.state(to)
+	}
+	p.diagram.AddTransition(fromState, toState, description)
+
+	return nil
+}
+
+// readEndpoint reads a state identifier or `[*]`.
+func readEndpoint(s *parser.Scanner) (string, error) {
+	if s.Consume(terminalState) {
+		return terminalState, nil
+	}
+
+	id := s.ReadWhile(parser.IsIdentifier)
+	if id == "" {
+		return "", s.Errorf("expected state identifier")
+	}
+
+	return id, nil
+}
+
+// add appends a state to the innermost open composite state, or to the diagram.
+func (p *stateParser) add(state *State) {
+	if len(p.composites) > 0 {
+		parent := p.composites[len(p.composites)-1].state
+		parent.Nested = append(parent.Nested, state)
+		return
+	}
+
+	p.diagram.States = append(p.diagram.States, state)
+}
+
+// state returns the state with the given ID, declaring it in the current scope on first use.
+func (p *stateParser) state(id string) *State {
+	if state, ok := p.states[id]; ok {
+		return state
+	}
+
+	state := NewState(id, "", StateNormal)
+	p.add(state)
+	p.states[id] = state
+
+	return state
+}
@@ -0,0 +1,279 @@
+package state
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Diagram with title and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Lifecycle"
+				d.EnableMarkdownFence()
+				d.AddState("Idle", "Waiting", StateNormal)
+			},
+		},
+		{
+			name: "Diagram with every state type, notes and transitions",
+			setup: func(d *Diagram) {
+				d.AddState("Idle", "", StateStart)
+				idle := d.AddState("Idle", "Waiting for input", StateNormal)
+				idle.AddNote("initial state", NoteLeft)
+				choice := d.AddState("Check", "", StateChoice)
+				fork := d.AddState("Split", "", StateFork)
+				join := d.AddState("Merge", "", StateJoin)
+				done := d.AddState("Done", "", StateEnd)
+				done.AddNote("final state", NoteRight)
+
+				d.AddTransition(idle, choice, "")
+				d.AddTransition(choice, fork, "valid")
+				d.AddTransition(fork, join, "")
+				d.AddTransition(nil, idle, "restart")
+				d.AddTransition(join, nil, "abort")
+			},
+		},
+		{
+			name: "Diagram with text that needs escaping",
+			setup: func(d *Diagram) {
+				idle := d.AddState("Idle", `The "idle" state`, StateNormal)
+				idle.AddNote("Waits; #1", NoteLeft)
+				d.AddTransition(idle, nil, "stop; #2")
+			},
+		},
+		{
+			name: "Diagram with a multi-line note",
+			setup: func(d *Diagram) {
+				d.AddState("Idle", "", StateNormal).AddNote("first line\nsecond; line", NoteRight)
+			},
+		},
+		{
+			name: "Diagram with nested composite states",
+			setup: func(d *Diagram) {
+				outer := d.AddState("Active", "Running", StateComposite)
+				outer.AddNestedState("Working", "", StateStart)
+				inner := outer.AddNestedState("Working", "", StateComposite)
+				inner.AddNestedState("Step", "First step", StateNormal)
+				inner.AddNestedState("Step", "", StateEnd)
+				d.AddTransition(outer, inner, "enter")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `stateDiagram-v2
+    %% a comment
+    [*] --> Still
+    Still : Not moving
+    Still --> Moving : push
+    state Moving {
+        [*] --> Slow
+        Slow --> Fast
+    }
+    note right of Moving : it moves
+    Moving --> [*]
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if len(d.States) != 4 {
+		t.Fatalf("Parse() got %d states, want 4", len(d.States))
+	}
+
+	if start := d.States[0]; start.ID != "Still" || start.Type != StateStart {
+		t.Errorf("Parse() state 0 = %+v, want start state Still", start)
+	}
+
+	still := d.States[1]
+	if still.ID != "Still" || still.Description != "Not moving" {
+		t.Errorf("Parse() state 1 = %+v, want Still described as Not moving", still)
+	}
+
+	moving := d.States[2]
+	if moving.Type != StateComposite || len(moving.Nested) != 3 {
+		t.Fatalf("Parse() state 2 = %+v, want composite with 3 nested states", moving)
+	}
+	if moving.Nested[0].Type != StateStart || moving.Nested[1].ID != "Slow" || moving.Nested[2].ID != "Fast" {
+		t.Errorf("Parse() nested states = %+v, want [*] --> Slow, Slow, Fast", moving.Nested)
+	}
+	if moving.Note == nil || moving.Note.Position != NoteRight || moving.Note.Text != "it moves" {
+		t.Errorf("Parse() note = %+v, want right note", moving.Note)
+	}
+
+	if end := d.States[3]; end.ID != "Moving" || end.Type != StateEnd {
+		t.Errorf("Parse() state 3 = %+v, want end state Moving", end)
+	}
+
+	if len(d.Transitions) != 2 {
+		t.Fatalf("Parse() got %d transitions, want 2", len(d.Transitions))
+	}
+	if tr := d.Transitions[0]; tr.From != still || tr.To != moving || tr.Description != "push" {
+		t.Errorf("Parse() transition 0 = %+v, want Still --> Moving: push", tr)
+	}
+	if tr := d.Transitions[1]; tr.From != moving.Nested[1] || tr.To != moving.Nested[2] {
+		t.Errorf("Parse() transition 1 = %+v, want Slow --> Fast", tr)
+	}
+}
+
+func TestParse_MultiLineNote(t *testing.T) {
+	input := `stateDiagram-v2
+    Idle --> Busy
+    note right of Busy
+        first line
+        second #59; line
+    end note
+    Busy --> Idle
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	busy := d.States[1]
+	if busy.Note == nil || busy.Note.Position != NoteRight || busy.Note.Text != "first line\nsecond ; line" {
+		t.Fatalf("Parse() note = %+v, want a right note on two lines", busy.Note)
+	}
+	if len(d.Transitions) != 2 {
+		t.Errorf("Parse() got %d transitions, want 2", len(d.Transitions))
+	}
+
+	got, err := Parse(strings.NewReader(d.String()))
+	if err != nil {
+		t.Fatalf("Parse() of String() error = %v", err)
+	}
+	if got.String() != d.String() {
+		t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", d.String(), got.String())
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "stateDiagram\n    A --> B\n",
+			line:    1,
+			column:  1,
+			message: "expected stateDiagram-v2 declaration",
+		},
+		{
+			name:    "Invalid arrow",
+			input:   "stateDiagram-v2\n    A -> B\n",
+			line:    2,
+			column:  7,
+			message: "expected '-->'",
+		},
+		{
+			name:    "Missing target",
+			input:   "stateDiagram-v2\n    A -->\n",
+			line:    2,
+			column:  10,
+			message: "expected state identifier",
+		},
+		{
+			name:    "Terminal to terminal",
+			input:   "stateDiagram-v2\n    [*] --> [*]\n",
+			line:    2,
+			column:  5,
+			message: "transition cannot both start and end at [*]",
+		},
+		{
+			name:    "Unknown pseudo state",
+			input:   "stateDiagram-v2\n    state A <<history>>\n",
+			line:    2,
+			column:  13,
+			message: `unknown state type "<<history>>"`,
+		},
+		{
+			name:    "Missing as",
+			input:   "stateDiagram-v2\n    state \"Desc\" A\n",
+			line:    2,
+			column:  18,
+			message: "expected 'as'",
+		},
+		{
+			name:    "Multi-line note without end",
+			input:   "stateDiagram-v2\n    note left of A\n        text\n",
+			line:    2,
+			column:  5,
+			message: `note of state "A" is missing its 'end note'`,
+		},
+		{
+			name:    "Unbalanced brace",
+			input:   "stateDiagram-v2\n    }\n",
+			line:    2,
+			column:  5,
+			message: "unexpected '}'",
+		},
+		{
+			name:    "Unterminated composite",
+			input:   "stateDiagram-v2\n    state A {\n    B --> C\n",
+			line:    2,
+			column:  5,
+			message: `state "A" is missing its '}'`,
+		},
+		{
+			name:    "Unsupported statement",
+			input:   "stateDiagram-v2\n    direction LR\n",
+			line:    2,
+			column:  5,
+			message: `unsupported statement "direction"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
@@ -28,7 +28,7 @@ const (
 	baseChoiceState    string = basediagram.Indentation + "state %s <<choice>>\n"
 	baseForkState      string = basediagram.Indentation + "state %s <<fork>>\n"
 	baseJoinState      string = basediagram.Indentation + "state %s <<join>>\n"
-	baseNormalState    string = basediagram.Indentation + "state %q as %s\n"
+	baseNormalState    string = basediagram.Indentation + "state \"%s\" as %s\n"
 	baseCompositeStart string = basediagram.Indentation + "state %s {\n"
 	baseCompositeEnd   string = basediagram.Indentation + "}\n"
 	baseNote           string = basediagram.Indentation + "note %s of %s: %s\n"
@@ -101,7 +101,7 @@ func (s *State) String(curIndentation string) string {
 		sb.WriteString(fmt.Sprintf("%s%s", curIndentation, fmt.Sprintf(baseJoinState, s.ID)))
 	default:
 		if s.Description != "" {
-			sb.WriteString(fmt.Sprintf("%s%s", curIndentation, fmt.Sprintf(baseNormalState, s.Description, s.ID)))
+			sb.WriteString(fmt.Sprintf("%s%s", curIndentation, fmt.Sprintf(baseNormalState, basediagram.Escape(s.Description), s.ID)))
 		}
 	}
 
@@ -116,7 +116,7 @@ func (s *State) String(curIndentation string) string {
 
 	if s.Note != nil {
 		sb.WriteString(fmt.Sprintf("%s%s", curIndentation,
-			fmt.Sprintf(baseNote, s.Note.Position, s.ID, s.Note.Text)))
+			fmt.Sprintf(baseNote, s.Note.Position, s.ID, basediagram.Escape(s.Note.Text))))
 	}
 
 	return sb.String()
@@ -114,6 +114,14 @@ func TestState_String(t *testing.T) {
 				`state "State 1" as S1`,
 			},
 		},
+		{
+			name:        "Normal state with quotes in description",
+			state:       NewState("S1", `The "first" state`, StateNormal),
+			indentation: "",
+			contains: []string{
+				`state "The #quot;first#quot; state" as S1`,
+			},
+		},
 		{
 			name:        "Choice state",
 			state:       NewState("C1", "", StateChoice),
@@ -2,6 +2,8 @@ package state
 
 import (
 	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
 )
 
 // TransitionType represents the different types of transitions in a state diagram.
@@ -63,5 +65,5 @@ func (t *Transition) String(indentation string) string {
 	if t.Description == "" {
 		return fmt.Sprintf(baseTransition, indentation, fromID, toID)
 	}
-	return fmt.Sprintf(baseTransitionWithDesc, indentation, fromID, toID, t.Description)
+	return fmt.Sprintf(baseTransitionWithDesc, indentation, fromID, toID, basediagram.Escape(t.Description))
 }
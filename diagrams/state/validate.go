@@ -0,0 +1,53 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// missing states and transitions, duplicate state IDs and transitions to
+// states that were never added to the diagram, directly or nested. Start and
+// end states only mark an existing state as initial or final, so they may
+// reuse its ID. A nil transition endpoint stands for [*] and is valid.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	states := make(map[*State]bool)
+	for i, state := range d.States {
+		validateState(&v, fmt.Sprintf("States[%d]", i), state, states)
+	}
+
+	for i, transition := range d.Transitions {
+		path := fmt.Sprintf("Transitions[%d]", i)
+		if transition == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing transition")
+			continue
+		}
+		if transition.From != nil && !states[transition.From] {
+			v.Error(basediagram.CodeUnknownReference, path+".From", "state %q is not part of the diagram", transition.From.ID)
+		}
+		if transition.To != nil && !states[transition.To] {
+			v.Error(basediagram.CodeUnknownReference, path+".To", "state %q is not part of the diagram", transition.To.ID)
+		}
+	}
+
+	return v.Errors()
+}
+
+func validateState(v *basediagram.Validator, path string, state *State, states map[*State]bool) {
+	if state == nil {
+		v.Error(basediagram.CodeMissingReference, path, "missing state")
+		return
+	}
+	states[state] = true
+
+	if state.Type != StateStart && state.Type != StateEnd {
+		v.UniqueID(path, state.ID)
+	}
+
+	for i, nested := range state.Nested {
+		validateState(v, fmt.Sprintf("%s.Nested[%d]", path, i), nested, states)
+	}
+}
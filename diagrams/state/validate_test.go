@@ -0,0 +1,77 @@
+package state
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				idle := d.AddState("Idle", "Waiting", StateNormal)
+				d.AddState("Idle", "", StateStart)
+				busy := d.AddState("Busy", "", StateComposite)
+				working := busy.AddNestedState("Working", "Working hard", StateNormal)
+				d.AddTransition(nil, idle, "")
+				d.AddTransition(idle, working, "start")
+				d.AddTransition(working, nil, "")
+			},
+		},
+		{
+			name: "Transition to a state that was never added",
+			setup: func(d *Diagram) {
+				d.AddTransition(d.AddState("Idle", "", StateNormal), NewState("Lost", "", StateNormal), "")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityError, Path: "Transitions[0].To", Message: `state "Lost" is not part of the diagram`},
+			},
+		},
+		{
+			name: "Duplicate nested state ID",
+			setup: func(d *Diagram) {
+				d.AddState("Idle", "", StateNormal)
+				d.AddState("Busy", "", StateComposite).AddNestedState("Idle", "", StateNormal)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "States[1].Nested[0]", Message: `ID "Idle" is already used by States[0]`},
+			},
+		},
+		{
+			name: "Missing states and transition",
+			setup: func(d *Diagram) {
+				busy := d.AddState("Busy", "", StateComposite)
+				busy.Nested = append(busy.Nested, nil)
+				d.States = append(d.States, nil)
+				d.Transitions = append(d.Transitions, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "States[0].Nested[0]", Message: "missing state"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "States[1]", Message: "missing state"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Transitions[0]", Message: "missing transition"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	baseTimelineConfigurationProperties string = basediagram.Indentation + "timeline\n"
+	timelineConfigurationSection        string = "timeline"
+	baseTimelineConfigurationProperties string = basediagram.Indentation + timelineConfigurationSection + ":\n"
 	timelinePropertyDisableMulticolor   string = "disableMulticolor"
 	timelinePropertyDiagramMarginX      string = "diagramMarginX"
 	timelinePropertyDiagramMarginY      string = "diagramMarginY"
@@ -238,9 +239,7 @@ func (c TimelineConfigurationProperties) String() string {
 
 	if len(c.properties) > 0 {
 		sb.WriteString(baseTimelineConfigurationProperties)
-		for _, prop := range c.properties {
-			sb.WriteString(prop.Format())
-		}
+		sb.WriteString(basediagram.FormatProperties(c.properties))
 	}
 
 	return sb.String()
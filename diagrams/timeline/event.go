@@ -39,11 +39,11 @@ func (e *Event) String() string {
 	var sb strings.Builder
 
 	if e.Title != "" {
-		sb.WriteString(fmt.Sprintf(eventTitle, e.Title))
+		sb.WriteString(fmt.Sprintf(eventTitle, basediagram.EscapeColons(e.Title)))
 	}
 
 	if e.Text != "" {
-		sb.WriteString(fmt.Sprintf(eventText, e.Text))
+		sb.WriteString(fmt.Sprintf(eventText, basediagram.EscapeColons(e.Text)))
 	}
 
 	for _, subEvent := range e.SubEvents {
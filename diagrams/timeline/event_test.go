@@ -64,6 +64,15 @@ func TestEvent_String(t *testing.T) {
 				"Sub Event 2",
 			},
 		},
+		{
+			name:       "Event with colons",
+			timePeriod: "10:00",
+			text:       "Launch: v1",
+			contains: []string{
+				"10#58;00\n",
+				": Launch#58; v1\n",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,95 @@
+package timeline
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// eventSeparatorPattern matches the colons separating a time period from its events.
+var eventSeparatorPattern = regexp.MustCompile(`\s*:(?:\s+|$)`)
+
+type timelineParser struct {
+	diagram *Diagram
+	section *Section
+	event   *Event
+}
+
+// Parse reads Mermaid timeline syntax and returns the corresponding Diagram.
+// It understands the syntax generated by Diagram.String as well as the
+// `period : event : event` form and the `title` statement. The first event of a
+// period becomes its text and the following ones its sub-events. Periods
+// declared before any section are kept in a section without title.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &timelineParser{diagram: NewDiagram()}
+	p.diagram.Title = doc.Title
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, timelineConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.diagram, nil
+}
+
+func (p *timelineParser) parseLine(line parser.Line) error {
+	keyword, rest, _ := strings.Cut(line.Text, " ")
+
+	switch keyword {
+	case "title":
+		p.diagram.Title = strings.TrimSpace(rest)
+		return nil
+	case "section":
+		p.section = p.diagram.AddSection(basediagram.Unescape(strings.TrimSpace(rest)))
+		p.event = nil
+		return nil
+	case "accTitle", "accDescr":
+		return line.Errorf(0, "unsupported statement %q", keyword)
+	}
+
+	parts := eventSeparatorPattern.Split(line.Text, -1)
+	for i := range parts {
+		parts[i] = basediagram.Unescape(strings.TrimSpace(parts[i]))
+	}
+
+	if parts[0] != "" || p.event == nil {
+		if p.section == nil {
+			p.section = p.diagram.AddSection("")
+		}
+		p.event = p.section.AddEvent(parts[0], "")
+	}
+
+	for _, text := range parts[1:] {
+		if text == "" {
+			return line.Errorf(0, "expected event text")
+		}
+		if p.event.Text == "" && len(p.event.SubEvents) == 0 {
+			p.event.Text = text
+		} else {
+			p.event.AddSubEvent(text)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package timeline
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty timeline",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Timeline with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "History"
+				d.Config.SetDisableMulticolor(true)
+				d.EnableMarkdownFence()
+				d.AddSection("").AddEvent("2002", "LinkedIn")
+			},
+		},
+		{
+			name: "Timeline with text that needs escaping",
+			setup: func(d *Diagram) {
+				d.AddSection("Q1; #1").AddEvent("2024", `Launch "v1"`).AddSubEvent("Fix #2")
+			},
+		},
+		{
+			name: "Timeline with colons in periods and events",
+			setup: func(d *Diagram) {
+				d.AddSection("Releases").AddEvent("10:00", "Launch: v1").AddSubEvent("Fix: crash")
+			},
+		},
+		{
+			name: "Timeline with sections, events and sub-events",
+			setup: func(d *Diagram) {
+				early := d.AddSection("Early days")
+				early.AddEvent("2004", "Facebook").AddSubEvent("Google IPO")
+				early.AddEvent("2005", "YouTube")
+				later := d.AddSection("Later")
+				later.AddEvent("2006", "")
+				later.AddEvent("2007", "iPhone").AddSubEvent("Kindle").AddSubEvent("Tumblr")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `timeline
+    title Social media
+    2002 : LinkedIn
+    2004 : Facebook : Google
+         : Twitter
+    section Video
+    2005 : YouTube
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if d.Title != "Social media" {
+		t.Errorf("Parse() title = %v, want %v", d.Title, "Social media")
+	}
+
+	if len(d.Sections) != 2 || d.Sections[0].Title != "" || d.Sections[1].Title != "Video" {
+		t.Fatalf("Parse() sections = %+v, want untitled and Video", d.Sections)
+	}
+
+	events := d.Sections[0].Events
+	if len(events) != 2 {
+		t.Fatalf("Parse() got %d events, want 2", len(events))
+	}
+	if events[0].Title != "2002" || events[0].Text != "LinkedIn" {
+		t.Errorf("Parse() event 0 = %+v, want 2002 : LinkedIn", events[0])
+	}
+	if events[1].Text != "Facebook" || len(events[1].SubEvents) != 2 || events[1].SubEvents[1].Text != "Twitter" {
+		t.Errorf("Parse() event 1 = %+v, want Facebook with Google and Twitter", events[1])
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "2002 : LinkedIn\n",
+			line:    1,
+			column:  1,
+			message: "expected timeline declaration",
+		},
+		{
+			name:    "Empty event",
+			input:   "timeline\n    2002 : : LinkedIn\n",
+			line:    2,
+			column:  5,
+			message: "expected event text",
+		},
+		{
+			name:    "Invalid config",
+			input:   "---\nconfig:\n    maxEdges: many\n---\ntimeline\n",
+			line:    3,
+			column:  5,
+			message: `invalid maxEdges "many"`,
+		},
+		{
+			name:    "Unsupported statement",
+			input:   "timeline\n    accTitle History\n",
+			line:    2,
+			column:  5,
+			message: `unsupported statement "accTitle"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
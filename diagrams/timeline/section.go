@@ -38,7 +38,7 @@ func (s *Section) String() string {
 	var sb strings.Builder
 
 	if s.Title != "" {
-		sb.WriteString(fmt.Sprintf(baseSectionTitle, s.Title))
+		sb.WriteString(fmt.Sprintf(baseSectionTitle, basediagram.Escape(s.Title)))
 	}
 
 	for _, event := range s.Events {
@@ -0,0 +1,44 @@
+package timeline
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// missing sections and events, events without a time period, which Mermaid attaches to the previous period,
+// and sub-events with a title, which Mermaid reads as a new period.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	for i, section := range d.Sections {
+		if section == nil {
+			v.Error(basediagram.CodeMissingReference, fmt.Sprintf("Sections[%d]", i), "missing section")
+			continue
+		}
+
+		for j, event := range section.Events {
+			path := fmt.Sprintf("Sections[%d].Events[%d]", i, j)
+			if event == nil {
+				v.Error(basediagram.CodeMissingReference, path, "missing event")
+				continue
+			}
+			if event.Title == "" {
+				v.Error(basediagram.CodeInvalidValue, path+".Title", "event has no time period")
+			}
+
+			for k, subEvent := range event.SubEvents {
+				subPath := fmt.Sprintf("%s.SubEvents[%d]", path, k)
+				switch {
+				case subEvent == nil:
+					v.Error(basediagram.CodeMissingReference, subPath, "missing event")
+				case subEvent.Title != "":
+					v.Error(basediagram.CodeInvalidValue, subPath+".Title", "sub-event title %q starts a new time period", subEvent.Title)
+				}
+			}
+		}
+	}
+
+	return v.Errors()
+}
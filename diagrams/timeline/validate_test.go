@@ -0,0 +1,72 @@
+package timeline
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				d.AddSection("2000s").AddEvent("2004", "Facebook").AddSubEvent("Google")
+			},
+		},
+		{
+			name: "Event without time period",
+			setup: func(d *Diagram) {
+				d.AddSection("2000s").AddEvent("", "Facebook")
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Sections[0].Events[0].Title", Message: "event has no time period"},
+			},
+		},
+		{
+			name: "Sub-event with title",
+			setup: func(d *Diagram) {
+				event := d.AddSection("2000s").AddEvent("2004", "Facebook")
+				event.SubEvents = append(event.SubEvents, NewEvent("2005", "YouTube"))
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Sections[0].Events[0].SubEvents[0].Title", Message: `sub-event title "2005" starts a new time period`},
+			},
+		},
+		{
+			name: "Missing section and events",
+			setup: func(d *Diagram) {
+				section := d.AddSection("2024")
+				event := section.AddEvent("Q1", "Launch")
+				event.SubEvents = append(event.SubEvents, nil)
+				section.Events = append(section.Events, nil)
+				d.Sections = append(d.Sections, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Sections[0].Events[0].SubEvents[0]", Message: "missing event"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Sections[0].Events[1]", Message: "missing event"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Sections[1]", Message: "missing section"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
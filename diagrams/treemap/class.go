@@ -0,0 +1,82 @@
+package treemap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for classes
+const (
+	baseClass            string = basediagram.Indentation + "classDef %s %s;\n"
+	baseClassFill        string = "fill:%s"
+	baseClassStroke      string = "stroke:%s"
+	baseClassStrokeWidth string = "stroke-width:%dpx"
+	baseClassColor       string = "color:%s"
+)
+
+// Class is a named style that nodes refer to with SetClass.
+// Reference: https://mermaid.js.org/syntax/treemap.html#styling-and-classes
+type Class struct {
+	Name        string
+	Fill        string
+	Stroke      string
+	StrokeWidth int
+	Color       string
+}
+
+// NewClass creates a new class with the given name and no style
+func NewClass(name string) *Class {
+	return &Class{
+		Name: name,
+	}
+}
+
+// SetFill sets the background color and returns the class for chaining
+func (c *Class) SetFill(fill string) *Class {
+	c.Fill = fill
+	return c
+}
+
+// SetStroke sets the border color and returns the class for chaining
+func (c *Class) SetStroke(stroke string) *Class {
+	c.Stroke = stroke
+	return c
+}
+
+// SetStrokeWidth sets the border width in pixels and returns the class for chaining
+func (c *Class) SetStrokeWidth(width int) *Class {
+	c.StrokeWidth = width
+	return c
+}
+
+// SetColor sets the text color and returns the class for chaining
+func (c *Class) SetColor(color string) *Class {
+	c.Color = color
+	return c
+}
+
+// String generates the Mermaid syntax for the class definition, or nothing
+// when the class has no style.
+func (c *Class) String() string {
+	var styles []string
+	if c.Fill != "" {
+		styles = append(styles, fmt.Sprintf(baseClassFill, c.Fill))
+	}
+	if c.Stroke != "" {
+		styles = append(styles, fmt.Sprintf(baseClassStroke, c.Stroke))
+	}
+	if c.StrokeWidth > 0 {
+		styles = append(styles, fmt.Sprintf(baseClassStrokeWidth, c.StrokeWidth))
+	}
+	if c.Color != "" {
+		styles = append(styles, fmt.Sprintf(baseClassColor, c.Color))
+	}
+
+	if len(styles) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(baseClass, c.Name, strings.Join(styles, ","))
+}
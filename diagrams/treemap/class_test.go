@@ -0,0 +1,57 @@
+package treemap
+
+import (
+	"testing"
+)
+
+func TestNewClass(t *testing.T) {
+	got := NewClass("important")
+
+	if got.Name != "important" || got.Fill != "" || got.Stroke != "" || got.StrokeWidth != 0 || got.Color != "" {
+		t.Errorf("NewClass() = %+v, want class important without style", got)
+	}
+}
+
+func TestClass_Setters(t *testing.T) {
+	class := NewClass("important")
+
+	if got := class.SetFill("#f96").SetStroke("#333").SetStrokeWidth(2).SetColor("#fff"); got != class {
+		t.Fatal("Setters should return the class for chaining")
+	}
+
+	if class.Fill != "#f96" || class.Stroke != "#333" || class.StrokeWidth != 2 || class.Color != "#fff" {
+		t.Errorf("Setters = %+v, want every style set", class)
+	}
+}
+
+func TestClass_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		class *Class
+		want  string
+	}{
+		{
+			name:  "Class without style",
+			class: NewClass("plain"),
+			want:  "",
+		},
+		{
+			name:  "Class with fill",
+			class: NewClass("large").SetFill("red"),
+			want:  "    classDef large fill:red;\n",
+		},
+		{
+			name:  "Class with every style",
+			class: NewClass("important").SetFill("#f96").SetStroke("#333").SetStrokeWidth(2).SetColor("#fff"),
+			want:  "    classDef important fill:#f96,stroke:#333,stroke-width:2px,color:#fff;\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.class.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
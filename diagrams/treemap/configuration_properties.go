@@ -0,0 +1,148 @@
+package treemap
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	treemapConfigurationSection        string = "treemap"
+	baseTreemapConfigurationProperties string = basediagram.Indentation + treemapConfigurationSection + ":\n"
+
+	treemapPropertyPadding        string = "padding"
+	treemapPropertyDiagramPadding string = "diagramPadding"
+	treemapPropertyShowValues     string = "showValues"
+	treemapPropertyNodeWidth      string = "nodeWidth"
+	treemapPropertyNodeHeight     string = "nodeHeight"
+	treemapPropertyBorderWidth    string = "borderWidth"
+	treemapPropertyValueFontSize  string = "valueFontSize"
+	treemapPropertyLabelFontSize  string = "labelFontSize"
+	treemapPropertyValueFormat    string = "valueFormat"
+	treemapPropertyUseMaxWidth    string = "useMaxWidth"
+)
+
+// TreemapConfigurationProperties holds treemap-specific configuration
+type TreemapConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewTreemapConfigurationProperties() TreemapConfigurationProperties {
+	return TreemapConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *TreemapConfigurationProperties) SetPadding(v int) *TreemapConfigurationProperties {
+	c.properties[treemapPropertyPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: treemapPropertyPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *TreemapConfigurationProperties) SetDiagramPadding(v int) *TreemapConfigurationProperties {
+	c.properties[treemapPropertyDiagramPadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: treemapPropertyDiagramPadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *TreemapConfigurationProperties) SetShowValues(v bool) *TreemapConfigurationProperties {
+	c.properties[treemapPropertyShowValues] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: treemapPropertyShowValues,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *TreemapConfigurationProperties) SetNodeWidth(v int) *TreemapConfigurationProperties {
+	c.properties[treemapPropertyNodeWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: treemapPropertyNodeWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *TreemapConfigurationProperties) SetNodeHeight(v int) *TreemapConfigurationProperties {
+	c.properties[treemapPropertyNodeHeight] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: treemapPropertyNodeHeight,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *TreemapConfigurationProperties) SetBorderWidth(v int) *TreemapConfigurationProperties {
+	c.properties[treemapPropertyBorderWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: treemapPropertyBorderWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *TreemapConfigurationProperties) SetValueFontSize(v int) *TreemapConfigurationProperties {
+	c.properties[treemapPropertyValueFontSize] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: treemapPropertyValueFontSize,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *TreemapConfigurationProperties) SetLabelFontSize(v int) *TreemapConfigurationProperties {
+	c.properties[treemapPropertyLabelFontSize] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: treemapPropertyLabelFontSize,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *TreemapConfigurationProperties) SetValueFormat(v string) *TreemapConfigurationProperties {
+	c.properties[treemapPropertyValueFormat] = &basediagram.StringProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: treemapPropertyValueFormat,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *TreemapConfigurationProperties) SetUseMaxWidth(v bool) *TreemapConfigurationProperties {
+	c.properties[treemapPropertyUseMaxWidth] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: treemapPropertyUseMaxWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c TreemapConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(baseTreemapConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,218 @@
+package treemap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewTreemapConfigurationProperties(t *testing.T) {
+	got := NewTreemapConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewTreemapConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewTreemapConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestTreemapConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   TreemapConfigurationProperties
+		setup    func(*TreemapConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewTreemapConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewTreemapConfigurationProperties(),
+			setup: func(c *TreemapConfigurationProperties) {
+				c.SetPadding(10)
+			},
+			contains: []string{
+				"treemap:",
+				"padding: 10",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewTreemapConfigurationProperties(),
+			setup: func(c *TreemapConfigurationProperties) {
+				c.SetDiagramPadding(8)
+				c.SetUseMaxWidth(true)
+			},
+			contains: []string{
+				"treemap:",
+				"diagramPadding: 8",
+				"useMaxWidth: true",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewTreemapConfigurationProperties(),
+			setup: func(c *TreemapConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetPadding(10)
+			},
+			contains: []string{
+				"fontSize: 12",
+				"treemap:",
+				"padding: 10",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestTreemapConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*TreemapConfigurationProperties) *TreemapConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set padding",
+			setup: func(c *TreemapConfigurationProperties) *TreemapConfigurationProperties {
+				return c.SetPadding(10)
+			},
+			property: treemapPropertyPadding,
+			value:    10,
+		},
+		{
+			name: "Set diagram padding",
+			setup: func(c *TreemapConfigurationProperties) *TreemapConfigurationProperties {
+				return c.SetDiagramPadding(8)
+			},
+			property: treemapPropertyDiagramPadding,
+			value:    8,
+		},
+		{
+			name: "Set show values",
+			setup: func(c *TreemapConfigurationProperties) *TreemapConfigurationProperties {
+				return c.SetShowValues(true)
+			},
+			property: treemapPropertyShowValues,
+			value:    true,
+		},
+		{
+			name: "Set node width",
+			setup: func(c *TreemapConfigurationProperties) *TreemapConfigurationProperties {
+				return c.SetNodeWidth(100)
+			},
+			property: treemapPropertyNodeWidth,
+			value:    100,
+		},
+		{
+			name: "Set node height",
+			setup: func(c *TreemapConfigurationProperties) *TreemapConfigurationProperties {
+				return c.SetNodeHeight(40)
+			},
+			property: treemapPropertyNodeHeight,
+			value:    40,
+		},
+		{
+			name: "Set border width",
+			setup: func(c *TreemapConfigurationProperties) *TreemapConfigurationProperties {
+				return c.SetBorderWidth(1)
+			},
+			property: treemapPropertyBorderWidth,
+			value:    1,
+		},
+		{
+			name: "Set value font size",
+			setup: func(c *TreemapConfigurationProperties) *TreemapConfigurationProperties {
+				return c.SetValueFontSize(12)
+			},
+			property: treemapPropertyValueFontSize,
+			value:    12,
+		},
+		{
+			name: "Set label font size",
+			setup: func(c *TreemapConfigurationProperties) *TreemapConfigurationProperties {
+				return c.SetLabelFontSize(14)
+			},
+			property: treemapPropertyLabelFontSize,
+			value:    14,
+		},
+		{
+			name: "Set value format",
+			setup: func(c *TreemapConfigurationProperties) *TreemapConfigurationProperties {
+				return c.SetValueFormat("$0,0")
+			},
+			property: treemapPropertyValueFormat,
+			value:    "$0,0",
+		},
+		{
+			name: "Set use max width",
+			setup: func(c *TreemapConfigurationProperties) *TreemapConfigurationProperties {
+				return c.SetUseMaxWidth(true)
+			},
+			property: treemapPropertyUseMaxWidth,
+			value:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewTreemapConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
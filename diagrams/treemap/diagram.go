@@ -0,0 +1,101 @@
+// Package treemap provides functionality for creating Mermaid treemap diagrams
+package treemap
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for treemap diagrams
+const (
+	diagramType     string = "treemap-beta"
+	baseDiagramType string = diagramType + "\n"
+)
+
+// Diagram represents a Mermaid treemap: nested rectangles sized by the
+// values of their leaves, read from the indentation of the nodes.
+// Reference: https://mermaid.js.org/syntax/treemap.html
+type Diagram struct {
+	basediagram.BaseDiagram[TreemapConfigurationProperties]
+	Nodes   []*Node
+	Classes []*Class
+}
+
+// NewDiagram creates a new empty treemap
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram: basediagram.NewBaseDiagram(NewTreemapConfigurationProperties()),
+		Nodes:       make([]*Node, 0),
+		Classes:     make([]*Class, 0),
+	}
+}
+
+// AddSection creates and adds a new top-level section and returns it
+func (d *Diagram) AddSection(name string) *Node {
+	section := NewNode(name, 0)
+	d.Nodes = append(d.Nodes, section)
+	return section
+}
+
+// AddLeaf creates and adds a new top-level leaf and returns it
+func (d *Diagram) AddLeaf(name string, value float64) *Node {
+	leaf := NewNode(name, value)
+	d.Nodes = append(d.Nodes, leaf)
+	return leaf
+}
+
+// AddPaths adds the tree described by sizes, a map from slash separated
+// paths such as "net/http/server.go" to their size, and returns the diagram
+// for chaining. Each directory becomes a section and each path a leaf,
+// sorted by name. A path that also has paths below it becomes a section
+// holding a leaf of the same name for its own size.
+func (d *Diagram) AddPaths(sizes map[string]float64) *Diagram {
+	d.Nodes = append(d.Nodes, buildPaths(sizes)...)
+	return d
+}
+
+// AddClass creates and adds a new class and returns it
+func (d *Diagram) AddClass(name string) *Class {
+	class := NewClass(name)
+	d.Classes = append(d.Classes, class)
+	return class
+}
+
+// String generates the Mermaid syntax for the treemap
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces a treemap.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one element at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		for _, node := range d.Nodes {
+			if node != nil {
+				node.writeTo(w, basediagram.Indentation)
+			}
+		}
+
+		for _, class := range d.Classes {
+			if class != nil {
+				w.WriteString(class.String())
+			}
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package treemap
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if len(diagram.Nodes) != 0 || len(diagram.Classes) != 0 {
+		t.Error("NewDiagram() should create empty nodes and classes")
+	}
+}
+
+func TestDiagram_AddSection(t *testing.T) {
+	diagram := NewDiagram()
+	section := diagram.AddSection("net")
+
+	if len(diagram.Nodes) != 1 || diagram.Nodes[0] != section {
+		t.Fatalf("AddSection() nodes = %v, want [%v]", diagram.Nodes, section)
+	}
+
+	if section.Name != "net" || section.Value != 0 {
+		t.Errorf("AddSection() = %+v, want section net", section)
+	}
+}
+
+func TestDiagram_AddLeaf(t *testing.T) {
+	diagram := NewDiagram()
+	leaf := diagram.AddLeaf("main.go", 12)
+
+	if len(diagram.Nodes) != 1 || diagram.Nodes[0] != leaf {
+		t.Fatalf("AddLeaf() nodes = %v, want [%v]", diagram.Nodes, leaf)
+	}
+
+	if leaf.Name != "main.go" || leaf.Value != 12 {
+		t.Errorf("AddLeaf() = %+v, want leaf main.go of 12", leaf)
+	}
+}
+
+func TestDiagram_AddPaths(t *testing.T) {
+	diagram := NewDiagram()
+	diagram.AddLeaf("first", 1)
+
+	if got := diagram.AddPaths(map[string]float64{"b/c": 2, "a": 3}); got != diagram {
+		t.Fatal("AddPaths() should return the diagram for chaining")
+	}
+
+	if len(diagram.Nodes) != 3 || diagram.Nodes[1].Name != "a" || diagram.Nodes[2].Name != "b" || diagram.Nodes[2].Total() != 2 {
+		t.Errorf("AddPaths() nodes = %v, want first, a and b", diagram.Nodes)
+	}
+}
+
+func TestDiagram_AddClass(t *testing.T) {
+	diagram := NewDiagram()
+	class := diagram.AddClass("large")
+
+	if len(diagram.Classes) != 1 || diagram.Classes[0] != class || class.Name != "large" {
+		t.Fatalf("AddClass() classes = %v, want [%v]", diagram.Classes, class)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func() *Diagram
+		want  string
+	}{
+		{
+			name: "Empty diagram",
+			setup: func() *Diagram {
+				return NewDiagram()
+			},
+			want: "treemap-beta\n",
+		},
+		{
+			name: "Complete diagram",
+			setup: func() *Diagram {
+				d := NewDiagram()
+				d.SetTitle("Binary size")
+				large := d.AddClass("large").SetFill("#f96")
+				d.AddClass("unused")
+				net := d.AddSection("net")
+				net.AddLeaf("http", 300).SetClass(large)
+				net.AddLeaf("ip", 10)
+				d.Nodes = append(d.Nodes, nil)
+				d.AddLeaf("fmt", 42.5)
+				d.Classes = append(d.Classes, nil)
+				return d
+			},
+			want: "treemap-beta\n" +
+				"    \"net\"\n" +
+				"        \"http\": 300:::large\n" +
+				"        \"ip\": 10\n" +
+				"    \"fmt\": 42.5\n" +
+				"    classDef large fill:#f96;\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.setup().String()
+			if !strings.HasSuffix(got, "---\n"+tt.want) {
+				t.Errorf("String() = %q, want suffix %q", got, tt.want)
+			}
+		})
+	}
+}
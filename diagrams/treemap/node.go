@@ -0,0 +1,138 @@
+package treemap
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for treemap nodes
+const (
+	baseSection   string = "%s%s"
+	baseLeaf      string = "%s%s: %s"
+	baseNodeClass string = ":::%s"
+)
+
+// Node represents a rectangle of the treemap. A node with children is a
+// section, sized by the sum of its descendants; a node without children is
+// a leaf, sized by its value.
+type Node struct {
+	Name     string
+	Value    float64
+	Class    *Class
+	Children []*Node
+}
+
+// NewNode creates a new node with the given name and value
+func NewNode(name string, value float64) *Node {
+	return &Node{
+		Name:     name,
+		Value:    value,
+		Children: make([]*Node, 0),
+	}
+}
+
+// AddSection creates and adds a new child section and returns it
+func (n *Node) AddSection(name string) *Node {
+	section := NewNode(name, 0)
+	n.Children = append(n.Children, section)
+	return section
+}
+
+// AddLeaf creates and adds a new child leaf and returns it
+func (n *Node) AddLeaf(name string, value float64) *Node {
+	leaf := NewNode(name, value)
+	n.Children = append(n.Children, leaf)
+	return leaf
+}
+
+// AddPaths adds the tree of sections and leaves described by sizes, see
+// Diagram.AddPaths, below the node and returns the node for chaining
+func (n *Node) AddPaths(sizes map[string]float64) *Node {
+	n.Children = append(n.Children, buildPaths(sizes)...)
+	return n
+}
+
+// SetName sets the node name and returns the node for chaining
+func (n *Node) SetName(name string) *Node {
+	n.Name = name
+	return n
+}
+
+// SetValue sets the value of a leaf and returns the node for chaining
+func (n *Node) SetValue(value float64) *Node {
+	n.Value = value
+	return n
+}
+
+// SetClass sets the node class and returns the node for chaining
+func (n *Node) SetClass(class *Class) *Node {
+	n.Class = class
+	return n
+}
+
+// IsSection reports whether the node has children.
+func (n *Node) IsSection() bool {
+	return len(n.Children) > 0
+}
+
+// Total returns the value of a leaf, or the sum of the values of the leaves
+// below a section.
+func (n *Node) Total() float64 {
+	if !n.IsSection() {
+		return n.Value
+	}
+
+	var total float64
+	for _, child := range n.Children {
+		if child != nil {
+			total += child.Total()
+		}
+	}
+
+	return total
+}
+
+// String generates a Mermaid-formatted string representation of the node
+// and its descendants with custom indentation.
+func (n *Node) String(curIndentation string) string {
+	var sb strings.Builder
+	n.writeTo(basediagram.NewWriter(&sb), curIndentation)
+	return sb.String()
+}
+
+// WriteTo writes the Mermaid representation of the node and its descendants
+// to w, indented as a top-level node of a treemap.
+func (n *Node) WriteTo(w io.Writer) (int64, error) {
+	cw := basediagram.NewWriter(w)
+	n.writeTo(cw, basediagram.Indentation)
+	return cw.Result()
+}
+
+// writeTo writes the node to w with the specified indentation, followed
+// one level deeper by its children.
+func (n *Node) writeTo(w *basediagram.Writer, curIndentation string) {
+	if n.IsSection() {
+		w.Printf(baseSection, curIndentation, basediagram.Quote(n.Name))
+	} else {
+		w.Printf(baseLeaf, curIndentation, basediagram.Quote(n.Name), formatNumber(n.Value))
+	}
+
+	if n.Class != nil {
+		w.Printf(baseNodeClass, n.Class.Name)
+	}
+	w.WriteString("\n")
+
+	nextIndentation := curIndentation + basediagram.Indentation
+	for _, child := range n.Children {
+		if child != nil {
+			child.writeTo(w, nextIndentation)
+		}
+	}
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
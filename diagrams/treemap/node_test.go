@@ -0,0 +1,129 @@
+package treemap
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewNode(t *testing.T) {
+	got := NewNode("main.go", 12)
+
+	if got.Name != "main.go" || got.Value != 12 || got.Class != nil || len(got.Children) != 0 {
+		t.Errorf("NewNode() = %+v, want leaf main.go of 12", got)
+	}
+}
+
+func TestNode_AddChildren(t *testing.T) {
+	root := NewNode("cmd", 0)
+	section := root.AddSection("server")
+	leaf := root.AddLeaf("main.go", 12)
+
+	if len(root.Children) != 2 || root.Children[0] != section || root.Children[1] != leaf {
+		t.Fatalf("AddSection() and AddLeaf() children = %v, want [%v %v]", root.Children, section, leaf)
+	}
+
+	if section.Name != "server" || section.IsSection() {
+		t.Errorf("AddSection() = %+v, want empty section server", section)
+	}
+
+	if !root.IsSection() || leaf.IsSection() {
+		t.Error("IsSection() should report whether the node has children")
+	}
+}
+
+func TestNode_Setters(t *testing.T) {
+	class := NewClass("large")
+	node := NewNode("", 0)
+
+	if got := node.SetName("main.go").SetValue(3.5).SetClass(class); got != node {
+		t.Fatal("Setters should return the node for chaining")
+	}
+
+	if node.Name != "main.go" || node.Value != 3.5 || node.Class != class {
+		t.Errorf("Setters = %+v, want name, value and class set", node)
+	}
+}
+
+func TestNode_Total(t *testing.T) {
+	root := NewNode("root", 100)
+	root.AddLeaf("a", 1.5)
+	section := root.AddSection("b")
+	section.AddLeaf("c", 2)
+	section.AddLeaf("d", 3)
+	root.Children = append(root.Children, nil)
+
+	if got := root.Total(); got != 6.5 {
+		t.Errorf("Total() = %v, want 6.5", got)
+	}
+
+	if got := NewNode("leaf", 4).Total(); got != 4 {
+		t.Errorf("Total() = %v, want 4", got)
+	}
+}
+
+func TestNode_String(t *testing.T) {
+	important := NewClass("important")
+
+	tests := []struct {
+		name  string
+		setup func() *Node
+		want  string
+	}{
+		{
+			name: "Leaf",
+			setup: func() *Node {
+				return NewNode("main.go", 1250.5)
+			},
+			want: "\"main.go\": 1250.5\n",
+		},
+		{
+			name: "Leaf with class and special characters",
+			setup: func() *Node {
+				return NewNode(`"quoted" #1`, 3).SetClass(important)
+			},
+			want: "\"#quot;quoted#quot; #35;1\": 3:::important\n",
+		},
+		{
+			name: "Nested sections",
+			setup: func() *Node {
+				root := NewNode("net", 0).SetClass(important)
+				http := root.AddSection("http")
+				http.AddLeaf("server.go", 40)
+				http.Children = append(http.Children, nil)
+				root.AddLeaf("ip.go", 10)
+				return root
+			},
+			want: "\"net\":::important\n" +
+				"    \"http\"\n" +
+				"        \"server.go\": 40\n" +
+				"    \"ip.go\": 10\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.setup().String(""); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNode_WriteTo(t *testing.T) {
+	node := NewNode("net", 0)
+	node.AddLeaf("ip.go", 10)
+
+	var sb strings.Builder
+	n, err := node.WriteTo(&sb)
+	if err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+
+	want := node.String("    ")
+	if sb.String() != want {
+		t.Errorf("WriteTo() = %q, want %q", sb.String(), want)
+	}
+	if n != int64(len(want)) {
+		t.Errorf("WriteTo() wrote %d bytes, want %d", n, len(want))
+	}
+}
@@ -0,0 +1,208 @@
+package treemap
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// level is a node on the path from a top-level node to the last parsed
+// node, with the indentation it was declared at.
+type level struct {
+	node   *Node
+	offset int
+}
+
+// classReference is a class name whose class is looked up once the whole
+// diagram is read, since classes are usually defined after their use.
+type classReference struct {
+	node *Node
+	name string
+	line parser.Line
+	pos  int
+}
+
+type treemapParser struct {
+	diagram    *Diagram
+	path       []level
+	classes    map[string]*Class
+	references []classReference
+}
+
+// Parse reads Mermaid treemap syntax and returns the corresponding Diagram.
+// The hierarchy is read from the indentation of the nodes: a node is a child
+// of the closest node above it that is less indented. It understands the
+// syntax generated by Diagram.String as well as the `treemap` keyword, the
+// `title` statement and names in single quotes. A node without value nor
+// children is read as a leaf of value 0.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType, "treemap")
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &treemapParser{
+		diagram: NewDiagram(),
+		classes: make(map[string]*Class),
+	}
+	p.diagram.Title = doc.Title
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, treemapConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, ref := range p.references {
+		class, ok := p.classes[ref.name]
+		if !ok {
+			return nil, ref.line.Errorf(ref.pos, "unknown class %q", ref.name)
+		}
+		ref.node.Class = class
+	}
+
+	return p.diagram, nil
+}
+
+func (p *treemapParser) parseLine(line parser.Line) error {
+	if strings.HasPrefix(line.Text, `"`) || strings.HasPrefix(line.Text, "'") {
+		return p.parseNode(line)
+	}
+
+	keyword, rest, _ := strings.Cut(line.Text, " ")
+	switch keyword {
+	case "title":
+		p.diagram.Title = strings.TrimSpace(rest)
+		return nil
+	case "classDef":
+		return p.parseClass(line)
+	case "accTitle", "accDescr", "accTitle:", "accDescr:":
+		return line.Errorf(0, "unsupported statement %q", strings.TrimSuffix(keyword, ":"))
+	}
+
+	return line.Errorf(0, "unknown statement %q", keyword)
+}
+
+// parseNode reads `"name"` or `"name": value`, followed by an optional
+// `:::class`, and places the node below the closest less indented node.
+func (p *treemapParser) parseNode(line parser.Line) error {
+	s := parser.NewScanner(line)
+
+	quote := line.Text[:1]
+	s.Advance(1)
+	name, ok := s.ReadUntil(quote)
+	if !ok {
+		return s.ErrorAt(0, "unterminated string")
+	}
+	node := NewNode(basediagram.Unescape(name), 0)
+	s.SkipSpaces()
+
+	if !s.HasPrefix(":::") && s.Consume(":") {
+		s.SkipSpaces()
+		valuePos := s.Pos()
+		text := s.ReadWhile(func(r rune) bool { return r != ':' && r != ' ' })
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return s.ErrorAt(valuePos, "invalid value %q", text)
+		}
+		node.Value = value
+		s.SkipSpaces()
+	}
+
+	if s.Consume(":::") {
+		classPos := s.Pos()
+		class := s.ReadWhile(parser.IsIdentifier)
+		if class == "" {
+			return s.Errorf("expected class name")
+		}
+		p.references = append(p.references, classReference{node: node, name: class, line: line, pos: classPos})
+	}
+
+	s.SkipSpaces()
+	if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	for len(p.path) > 0 && p.path[len(p.path)-1].offset >= line.Offset {
+		p.path = p.path[:len(p.path)-1]
+	}
+
+	if len(p.path) == 0 {
+		p.diagram.Nodes = append(p.diagram.Nodes, node)
+	} else {
+		parent := p.path[len(p.path)-1].node
+		parent.Children = append(parent.Children, node)
+	}
+
+	p.path = append(p.path, level{node: node, offset: line.Offset})
+
+	return nil
+}
+
+// parseClass reads `classDef name fill:#f96,stroke:#333,stroke-width:2px,color:#fff;`.
+func (p *treemapParser) parseClass(line parser.Line) error {
+	s := parser.NewScanner(line)
+	s.Advance(len("classDef"))
+	s.SkipSpaces()
+
+	namePos := s.Pos()
+	name := s.ReadWhile(parser.IsIdentifier)
+	if name == "" {
+		return s.Errorf("expected class name")
+	}
+	if _, ok := p.classes[name]; ok {
+		return s.ErrorAt(namePos, "class %q is already defined", name)
+	}
+	class := p.diagram.AddClass(name)
+	p.classes[name] = class
+
+	s.SkipSpaces()
+	for !s.EOF() && !s.HasPrefix(";") {
+		stylePos := s.Pos()
+		style := s.ReadWhile(func(r rune) bool { return r != ',' && r != ';' })
+		key, value, _ := strings.Cut(style, ":")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+		switch key {
+		case "fill":
+			class.Fill = value
+		case "stroke":
+			class.Stroke = value
+		case "stroke-width":
+			width, err := strconv.Atoi(strings.TrimSuffix(value, "px"))
+			if err != nil {
+				return s.ErrorAt(stylePos, "invalid stroke width %q", value)
+			}
+			class.StrokeWidth = width
+		case "color":
+			class.Color = value
+		default:
+			return s.ErrorAt(stylePos, "unsupported style %q", strings.TrimSpace(style))
+		}
+
+		s.Consume(",")
+		s.SkipSpaces()
+	}
+
+	s.Consume(";")
+	if !s.EOF() {
+		return s.Errorf("unexpected %q", s.Rest())
+	}
+
+	return nil
+}
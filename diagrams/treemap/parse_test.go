@@ -0,0 +1,206 @@
+package treemap
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Diagram with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Binary size"
+				d.Config.SetShowValues(false).SetValueFormat(",.2f")
+				d.EnableMarkdownFence()
+				d.AddLeaf("main", 1)
+			},
+		},
+		{
+			name: "Diagram with nested sections and classes",
+			setup: func(d *Diagram) {
+				large := d.AddClass("large").SetFill("#f96").SetStroke("#333").SetStrokeWidth(2).SetColor("#fff")
+				d.AddClass("small").SetColor("blue")
+				d.AddPaths(map[string]float64{
+					"net/http/server.go": 40.25,
+					"net/ip.go":          10,
+					`"quoted" #1`:        3,
+				})
+				d.Nodes[1].SetClass(large)
+				d.Nodes[1].Children[0].Children[0].SetClass(d.Classes[1])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `treemap
+title Disk usage
+"Section 1"
+    "Leaf 1.1": 12
+    "Section 1.2":::class1
+      'Leaf 1.2.1': 12
+"Section 2"
+    "Leaf 2.1" : 20 :::class1
+    "Empty"
+classDef class1 fill:red,color:blue,stroke:#FFD600
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if d.Title != "Disk usage" {
+		t.Errorf("Parse() title = %q, want %q", d.Title, "Disk usage")
+	}
+
+	if len(d.Nodes) != 2 || d.Nodes[0].Total() != 24 || d.Nodes[1].Total() != 20 {
+		t.Fatalf("Parse() nodes = %v, want two sections of 24 and 20", d.Nodes)
+	}
+
+	class := d.Classes[0]
+	if class.Name != "class1" || class.Fill != "red" || class.Color != "blue" || class.Stroke != "#FFD600" {
+		t.Errorf("Parse() class = %+v, want class1 with fill, color and stroke", class)
+	}
+
+	section := d.Nodes[0].Children[1]
+	if section.Name != "Section 1.2" || section.Class != class || section.Children[0].Name != "Leaf 1.2.1" {
+		t.Errorf("Parse() section = %+v, want Section 1.2 with class1", section)
+	}
+
+	leaf := d.Nodes[1].Children[0]
+	if leaf.Name != "Leaf 2.1" || leaf.Value != 20 || leaf.Class != class {
+		t.Errorf("Parse() leaf = %+v, want Leaf 2.1 of 20 with class1", leaf)
+	}
+
+	if empty := d.Nodes[1].Children[1]; empty.Name != "Empty" || empty.IsSection() || empty.Value != 0 {
+		t.Errorf("Parse() node = %+v, want leaf Empty of 0", empty)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "\"root\"\n",
+			line:    1,
+			column:  1,
+			message: "expected treemap-beta declaration",
+		},
+		{
+			name:    "Header with arguments",
+			input:   "treemap-beta LR\n",
+			line:    1,
+			column:  14,
+			message: `unexpected "LR"`,
+		},
+		{
+			name:    "Unquoted name",
+			input:   "treemap-beta\n    root: 1\n",
+			line:    2,
+			column:  5,
+			message: `unknown statement "root:"`,
+		},
+		{
+			name:    "Unterminated name",
+			input:   "treemap-beta\n    \"root: 1\n",
+			line:    2,
+			column:  5,
+			message: "unterminated string",
+		},
+		{
+			name:    "Invalid value",
+			input:   "treemap-beta\n    \"root\": big\n",
+			line:    2,
+			column:  13,
+			message: `invalid value "big"`,
+		},
+		{
+			name:    "Text after the value",
+			input:   "treemap-beta\n    \"root\": 1 2\n",
+			line:    2,
+			column:  15,
+			message: `unexpected "2"`,
+		},
+		{
+			name:    "Unknown class",
+			input:   "treemap-beta\n    \"root\": 1:::large\n",
+			line:    2,
+			column:  17,
+			message: `unknown class "large"`,
+		},
+		{
+			name:    "Duplicate class",
+			input:   "treemap-beta\n    classDef a fill:red\n    classDef a color:red\n",
+			line:    3,
+			column:  14,
+			message: `class "a" is already defined`,
+		},
+		{
+			name:    "Unsupported style",
+			input:   "treemap-beta\n    classDef a fill:red, font-size:12px;\n",
+			line:    2,
+			column:  26,
+			message: `unsupported style "font-size:12px"`,
+		},
+		{
+			name:    "Invalid stroke width",
+			input:   "treemap-beta\n    classDef a stroke-width:thick\n",
+			line:    2,
+			column:  16,
+			message: `invalid stroke width "thick"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
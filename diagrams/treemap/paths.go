@@ -0,0 +1,77 @@
+package treemap
+
+import (
+	"sort"
+	"strings"
+)
+
+// pathSeparator separates the sections of the paths given to AddPaths.
+const pathSeparator string = "/"
+
+// pathTree is a section of the paths being added, with the size recorded
+// for the path of the section itself, if any.
+type pathTree struct {
+	children map[string]*pathTree
+	size     float64
+	hasSize  bool
+}
+
+// buildPaths returns the nodes described by sizes, see Diagram.AddPaths.
+func buildPaths(sizes map[string]float64) []*Node {
+	root := &pathTree{children: make(map[string]*pathTree)}
+
+	for path, size := range sizes {
+		var parts []string
+		for _, part := range strings.Split(path, pathSeparator) {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		if len(parts) == 0 {
+			parts = []string{path}
+		}
+
+		tree := root
+		for _, part := range parts {
+			child, ok := tree.children[part]
+			if !ok {
+				child = &pathTree{children: make(map[string]*pathTree)}
+				tree.children[part] = child
+			}
+			tree = child
+		}
+		tree.size += size
+		tree.hasSize = true
+	}
+
+	return root.nodes()
+}
+
+// nodes converts the children of the tree into nodes sorted by name. A
+// path that is both sized and the parent of other paths becomes a section
+// holding a leaf of the same name for its own size.
+func (t *pathTree) nodes() []*Node {
+	names := make([]string, 0, len(t.children))
+	for name := range t.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	nodes := make([]*Node, 0, len(names))
+	for _, name := range names {
+		child := t.children[name]
+		if len(child.children) == 0 {
+			nodes = append(nodes, NewNode(name, child.size))
+			continue
+		}
+
+		section := NewNode(name, 0)
+		if child.hasSize {
+			section.AddLeaf(name, child.size)
+		}
+		section.Children = append(section.Children, child.nodes()...)
+		nodes = append(nodes, section)
+	}
+
+	return nodes
+}
@@ -0,0 +1,83 @@
+package treemap
+
+import (
+	"testing"
+)
+
+func TestBuildPaths(t *testing.T) {
+	tests := []struct {
+		name  string
+		sizes map[string]float64
+		want  string
+	}{
+		{
+			name:  "No paths",
+			sizes: map[string]float64{},
+			want:  "",
+		},
+		{
+			name: "Files in directories sorted by name",
+			sizes: map[string]float64{
+				"net/http/server.go": 40,
+				"net/ip.go":          10,
+				"fmt/print.go":       5,
+				"net/http/client.go": 20,
+			},
+			want: "\"fmt\"\n" +
+				"    \"print.go\": 5\n" +
+				"\"net\"\n" +
+				"    \"http\"\n" +
+				"        \"client.go\": 20\n" +
+				"        \"server.go\": 40\n" +
+				"    \"ip.go\": 10\n",
+		},
+		{
+			name: "Sized paths with paths below them",
+			sizes: map[string]float64{
+				"net/http":          300,
+				"net/http/internal": 25,
+				"net":               120,
+			},
+			want: "\"net\"\n" +
+				"    \"net\": 120\n" +
+				"    \"http\"\n" +
+				"        \"http\": 300\n" +
+				"        \"internal\": 25\n",
+		},
+		{
+			name: "Extra and surrounding separators",
+			sizes: map[string]float64{
+				"/usr//lib/": 7,
+				"/":          1,
+			},
+			want: "\"/\": 1\n" +
+				"\"usr\"\n" +
+				"    \"lib\": 7\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			for _, node := range buildPaths(tt.sizes) {
+				got += node.String("")
+			}
+
+			if got != tt.want {
+				t.Errorf("buildPaths() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNode_AddPaths(t *testing.T) {
+	node := NewNode("root", 0)
+
+	if got := node.AddPaths(map[string]float64{"a/b": 1, "c": 2}); got != node {
+		t.Fatal("AddPaths() should return the node for chaining")
+	}
+
+	if len(node.Children) != 2 || node.Children[0].Name != "a" || node.Children[1].Name != "c" || node.Total() != 3 {
+		t.Errorf("AddPaths() children = %v, want sections a and c totalling 3", node.Children)
+	}
+}
@@ -0,0 +1,76 @@
+package treemap
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// nil nodes and classes, empty node names, negative or non-finite leaf values,
+// values set on sections, which are ignored, missing, duplicate or multi-word
+// class names, classes without style, and nodes using a class that is not
+// part of the treemap.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	classes := make(map[*Class]bool, len(d.Classes))
+	for i, class := range d.Classes {
+		path := fmt.Sprintf("Classes[%d]", i)
+		if class == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing class")
+			continue
+		}
+
+		v.UniqueID(path+".Name", class.Name)
+		if strings.IndexFunc(class.Name, isNotIdentifier) >= 0 {
+			v.Error(basediagram.CodeInvalidValue, path+".Name", "class name %q may only hold letters, digits and underscores", class.Name)
+		}
+		if class.String() == "" {
+			v.Warning(basediagram.CodeIgnoredValue, path, "class %q without style is not rendered", class.Name)
+		}
+		classes[class] = true
+	}
+
+	for i, node := range d.Nodes {
+		validateNode(&v, fmt.Sprintf("Nodes[%d]", i), node, classes)
+	}
+
+	return v.Errors()
+}
+
+func validateNode(v *basediagram.Validator, path string, node *Node, classes map[*Class]bool) {
+	if node == nil {
+		v.Error(basediagram.CodeMissingReference, path, "missing node")
+		return
+	}
+
+	if node.Name == "" {
+		v.Error(basediagram.CodeEmptyID, path+".Name", "missing name")
+	}
+
+	switch {
+	case node.IsSection() && node.Value != 0:
+		v.Warning(basediagram.CodeIgnoredValue, path+".Value", "value %v is ignored for a section", node.Value)
+	case node.IsSection():
+	case math.IsNaN(node.Value) || math.IsInf(node.Value, 0):
+		v.Error(basediagram.CodeInvalidValue, path+".Value", "value %v is not a number", node.Value)
+	case node.Value < 0:
+		v.Error(basediagram.CodeOutOfRange, path+".Value", "value %v is negative", node.Value)
+	}
+
+	if node.Class != nil && !classes[node.Class] {
+		v.Warning(basediagram.CodeUnknownReference, path+".Class", "class %q is not part of the treemap", node.Class.Name)
+	}
+
+	for i, child := range node.Children {
+		validateNode(v, fmt.Sprintf("%s.Children[%d]", path, i), child, classes)
+	}
+}
+
+func isNotIdentifier(r rune) bool {
+	return !parser.IsIdentifier(r)
+}
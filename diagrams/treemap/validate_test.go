@@ -0,0 +1,79 @@
+package treemap
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				large := d.AddClass("large").SetFill("#f96")
+				net := d.AddSection("net").SetClass(large)
+				net.AddLeaf("http", 300)
+				net.AddLeaf("empty", 0)
+				d.AddLeaf("fmt", 42)
+			},
+		},
+		{
+			name: "Invalid nodes",
+			setup: func(d *Diagram) {
+				net := d.AddSection("net").SetValue(12)
+				net.AddLeaf("", -1)
+				net.AddLeaf("nan", math.NaN()).SetClass(NewClass("other"))
+				net.Children = append(net.Children, nil)
+				d.Nodes = append(d.Nodes, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Nodes[0].Value", Message: "value 12 is ignored for a section"},
+				{Code: basediagram.CodeEmptyID, Severity: basediagram.SeverityError, Path: "Nodes[0].Children[0].Name", Message: "missing name"},
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityError, Path: "Nodes[0].Children[0].Value", Message: "value -1 is negative"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Nodes[0].Children[1].Value", Message: "value NaN is not a number"},
+				{Code: basediagram.CodeUnknownReference, Severity: basediagram.SeverityWarning, Path: "Nodes[0].Children[1].Class", Message: `class "other" is not part of the treemap`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Nodes[0].Children[2]", Message: "missing node"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Nodes[1]", Message: "missing node"},
+			},
+		},
+		{
+			name: "Invalid classes",
+			setup: func(d *Diagram) {
+				d.AddClass("").SetFill("red")
+				d.AddClass("very large").SetFill("red")
+				d.AddClass("very large")
+				d.Classes = append(d.Classes, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeEmptyID, Severity: basediagram.SeverityError, Path: "Classes[0].Name", Message: "missing ID"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Classes[1].Name", Message: `class name "very large" may only hold letters, digits and underscores`},
+				{Code: basediagram.CodeDuplicateID, Severity: basediagram.SeverityError, Path: "Classes[2].Name", Message: `ID "very large" is already used by Classes[1].Name`},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Classes[2].Name", Message: `class name "very large" may only hold letters, digits and underscores`},
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "Classes[2]", Message: `class "very large" without style is not rendered`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Classes[3]", Message: "missing class"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
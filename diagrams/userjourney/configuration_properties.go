@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	baseJourneyConfigurationProperties string = basediagram.Indentation + "journey:\n"
+	journeyConfigurationSection        string = "journey"
+	baseJourneyConfigurationProperties string = basediagram.Indentation + journeyConfigurationSection + ":\n"
 
 	journeyPropertyDiagramMarginX  string = "diagramMarginX"
 	journeyPropertyDiagramMarginY  string = "diagramMarginY"
@@ -250,9 +251,7 @@ func (c JourneyConfigurationProperties) String() string {
 
 	if len(c.properties) > 0 {
 		sb.WriteString(baseJourneyConfigurationProperties)
-		for _, prop := range c.properties {
-			sb.WriteString(prop.Format())
-		}
+		sb.WriteString(basediagram.FormatProperties(c.properties))
 	}
 
 	return sb.String()
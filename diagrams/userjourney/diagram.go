@@ -2,6 +2,7 @@
 package userjourney
 
 import (
+	"io"
 	"strings"
 
 	"github.com/TyphonHill/go-mermaid/diagrams/utils"
@@ -10,7 +11,8 @@ import (
 
 // Base string formats for user journey diagrams
 const (
-	baseDiagramType string = "journey\n"
+	diagramType     string = "journey"
+	baseDiagramType string = diagramType + "\n"
 )
 
 // Diagram represents a Mermaid User Journey diagram
@@ -37,17 +39,27 @@ func (d *Diagram) AddSection(title string) *Section {
 // String generates the Mermaid syntax for the diagram
 func (d *Diagram) String() string {
 	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
 
-	sb.WriteString(baseDiagramType)
-
-	for _, section := range d.Sections {
-		sb.WriteString(section.String())
-	}
-
-	return d.BaseDiagram.String(sb.String())
+// DiagramType returns the Mermaid keyword that introduces a user journey diagram.
+func (d *Diagram) DiagramType() string {
+	return diagramType
 }
 
 // RenderToFile renders the diagram to a file
 func (d *Diagram) RenderToFile(path string) error {
-	return utils.RenderToFile(path, d.String())
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one element at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		w.WriteString(baseDiagramType)
+
+		for _, section := range d.Sections {
+			w.WriteString(section.String())
+		}
+	})
 }
@@ -0,0 +1,107 @@
+package userjourney
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+type journeyParser struct {
+	diagram *Diagram
+	section *Section
+}
+
+// Parse reads Mermaid user journey syntax and returns the corresponding Diagram.
+// It understands the syntax generated by Diagram.String as well as the `title`
+// statement. Scores are kept as written, without the clamping applied by
+// Section.AddTask, and tasks declared before any section are kept in a
+// section without title.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, header.Errorf(len(header.Text)-len(rest), "unexpected %q", rest)
+	}
+
+	p := &journeyParser{diagram: NewDiagram()}
+	p.diagram.Title = doc.Title
+	if err := doc.Config.Apply(&p.diagram.Config.ConfigurationProperties, journeyConfigurationSection, p.diagram.Config.properties); err != nil {
+		return nil, err
+	}
+
+	for _, line := range doc.Body() {
+		if err := p.parseLine(line); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.diagram, nil
+}
+
+func (p *journeyParser) parseLine(line parser.Line) error {
+	keyword, rest, _ := strings.Cut(line.Text, " ")
+
+	switch keyword {
+	case "title":
+		p.diagram.Title = strings.TrimSpace(rest)
+		return nil
+	case "section":
+		p.section = p.diagram.AddSection(basediagram.Unescape(strings.TrimSpace(rest)))
+		return nil
+	case "accTitle", "accDescr":
+		return line.Errorf(0, "unsupported statement %q", keyword)
+	}
+
+	return p.parseTask(parser.NewScanner(line))
+}
+
+// parseTask reads `Task: score` or `Task: score: Actor, Actor`.
+func (p *journeyParser) parseTask(s *parser.Scanner) error {
+	title, ok := s.ReadUntil(":")
+	if !ok {
+		return s.Errorf("expected ':'")
+	}
+	s.SkipSpaces()
+
+	scorePos := s.Pos()
+	scoreText, found := s.ReadUntil(":")
+	if !found {
+		scoreText = s.Rest()
+		s.Advance(len(scoreText))
+	}
+	score, err := strconv.Atoi(strings.TrimSpace(scoreText))
+	if err != nil {
+		return s.ErrorAt(scorePos, "invalid score %q", strings.TrimSpace(scoreText))
+	}
+
+	var participants []string
+	if found {
+		for _, participant := range strings.Split(s.Rest(), ",") {
+			if participant = strings.TrimSpace(participant); participant != "" {
+				participants = append(participants, basediagram.Unescape(participant))
+			}
+		}
+	}
+
+	if p.section == nil {
+		p.section = p.diagram.AddSection("")
+	}
+	p.section.Tasks = append(p.section.Tasks, &Task{
+		Title:        basediagram.Unescape(strings.TrimSpace(title)),
+		Score:        score,
+		Participants: participants,
+	})
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package userjourney
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty journey",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Journey with title, config and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "My working day"
+				d.Config.SetTheme("forest")
+				d.Config.SetFontSize(14)
+				d.EnableMarkdownFence()
+				d.AddSection("Go to work").AddTask("Make tea", 5, "Me")
+			},
+		},
+		{
+			name: "Journey with text that needs escaping",
+			setup: func(d *Diagram) {
+				d.AddSection("Work; #1").AddTask(`Make "tea"`, 4, "Me #1", "Cat")
+			},
+		},
+		{
+			name: "Journey with colons in task titles and participants",
+			setup: func(d *Diagram) {
+				section := d.AddSection("Morning")
+				section.AddTask("Step 1: login", 4, "Me")
+				section.AddTask("Time: 9am", 3, "Team: ops")
+			},
+		},
+		{
+			name: "Journey with sections, scores and participants",
+			setup: func(d *Diagram) {
+				work := d.AddSection("Go to work")
+				work.AddTask("Make tea", 5, "Me")
+				work.AddTask("Go upstairs", 3, "Me", "Cat")
+				home := d.AddSection("Go home")
+				home.AddTask("Go downstairs", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `journey
+    title My working day
+    section Go to work
+      Make tea: 5: Me
+      Go upstairs: 3: Me, Cat
+      Do work: 7
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if d.Title != "My working day" {
+		t.Errorf("Parse() title = %v, want %v", d.Title, "My working day")
+	}
+
+	if len(d.Sections) != 1 || d.Sections[0].Title != "Go to work" {
+		t.Fatalf("Parse() sections = %+v, want Go to work", d.Sections)
+	}
+
+	want := []*Task{
+		{Title: "Make tea", Score: 5, Participants: []string{"Me"}},
+		{Title: "Go upstairs", Score: 3, Participants: []string{"Me", "Cat"}},
+		{Title: "Do work", Score: 7},
+	}
+	if got := d.Sections[0].Tasks; !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() tasks = %+v, want %+v", got, want)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "section Work\n",
+			line:    1,
+			column:  1,
+			message: "expected journey declaration",
+		},
+		{
+			name:    "Missing score",
+			input:   "journey\n    Make tea\n",
+			line:    2,
+			column:  5,
+			message: "expected ':'",
+		},
+		{
+			name:    "Invalid score",
+			input:   "journey\n    Make tea: high: Me\n",
+			line:    2,
+			column:  15,
+			message: `invalid score "high"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
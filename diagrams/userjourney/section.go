@@ -57,15 +57,19 @@ func (s *Section) AddTask(title string, score int, participants ...string) *Task
 func (s *Section) String() string {
 	var sb strings.Builder
 
-	sb.WriteString(fmt.Sprintf(baseSectionTitle, s.Title))
+	sb.WriteString(fmt.Sprintf(baseSectionTitle, basediagram.Escape(s.Title)))
 	for _, task := range s.Tasks {
 		if len(task.Participants) > 0 {
+			participants := make([]string, len(task.Participants))
+			for i, participant := range task.Participants {
+				participants[i] = basediagram.EscapeColons(participant)
+			}
 			sb.WriteString(fmt.Sprintf(baseTaskWithPartic,
-				task.Title,
+				basediagram.EscapeColons(task.Title),
 				task.Score,
-				strings.Join(task.Participants, ",")))
+				strings.Join(participants, ",")))
 		} else {
-			sb.WriteString(fmt.Sprintf(baseTaskNoPartic, task.Title, task.Score))
+			sb.WriteString(fmt.Sprintf(baseTaskNoPartic, basediagram.EscapeColons(task.Title), task.Score))
 		}
 	}
 
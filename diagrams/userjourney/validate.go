@@ -0,0 +1,39 @@
+package userjourney
+
+import (
+	"fmt"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// missing sections and tasks, scores outside of 1-5, which Mermaid cannot
+// place, and empty task titles.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	for i, section := range d.Sections {
+		if section == nil {
+			v.Error(basediagram.CodeMissingReference, fmt.Sprintf("Sections[%d]", i), "missing section")
+			continue
+		}
+
+		for j, task := range section.Tasks {
+			path := fmt.Sprintf("Sections[%d].Tasks[%d]", i, j)
+			if task == nil {
+				v.Error(basediagram.CodeMissingReference, path, "missing task")
+				continue
+			}
+
+			if task.Title == "" {
+				v.Error(basediagram.CodeInvalidValue, path+".Title", "task has no title")
+			}
+
+			if task.Score < 1 || task.Score > 5 {
+				v.Error(basediagram.CodeOutOfRange, path+".Score", "score %d is outside of 1-5", task.Score)
+			}
+		}
+	}
+
+	return v.Errors()
+}
@@ -0,0 +1,71 @@
+package userjourney
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				d.AddSection("Go to work").AddTask("Make tea", 5, "Me")
+			},
+		},
+		{
+			name: "Score outside of 1-5",
+			setup: func(d *Diagram) {
+				section := d.AddSection("Go to work")
+				section.AddTask("Make tea", 5)
+				section.Tasks = append(section.Tasks, &Task{Title: "Do work", Score: 7})
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityError, Path: "Sections[0].Tasks[1].Score", Message: "score 7 is outside of 1-5"},
+			},
+		},
+		{
+			name: "Empty task title",
+			setup: func(d *Diagram) {
+				section := d.AddSection("Go to work")
+				section.AddTask("", 3)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Sections[0].Tasks[0].Title", Message: "task has no title"},
+			},
+		},
+		{
+			name: "Missing section and task",
+			setup: func(d *Diagram) {
+				section := d.AddSection("Go to work")
+				section.Tasks = append(section.Tasks, nil)
+				d.Sections = append(d.Sections, nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Sections[0].Tasks[0]", Message: "missing task"},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Sections[1]", Message: "missing section"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
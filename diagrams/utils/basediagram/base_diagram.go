@@ -1,7 +1,7 @@
 package basediagram
 
 import (
-	"fmt"
+	"io"
 	"strings"
 )
 
@@ -31,19 +31,51 @@ func (d *BaseDiagram[T]) SetTitle(title string) *BaseDiagram[T] {
 	return d
 }
 
+// GetTitle returns the diagram title.
+func (d *BaseDiagram[T]) GetTitle() string {
+	return d.Title
+}
+
+// GetConfig returns the diagram configuration properties.
+func (d *BaseDiagram[T]) GetConfig() DiagramProperties {
+	return d.Config
+}
+
 func (d *BaseDiagram[T]) String(content string) string {
 	var sb strings.Builder
 
-	sb.WriteString(baseDiagramSeparator)
+	d.Render(&sb, func(w *Writer) {
+		w.WriteString(content)
+	})
+
+	return sb.String()
+}
+
+// Render streams the diagram to w: the markdown fence when enabled, the
+// frontmatter, the content written by body and the closing fence.
+// It returns the number of bytes written and the first write error.
+func (d *BaseDiagram[T]) Render(w io.Writer, body func(w *Writer)) (int64, error) {
+	cw := NewWriter(w)
+
+	if d.markdownFence {
+		cw.WriteString(markdownFenceStart)
+	}
+
+	cw.WriteString(baseDiagramSeparator)
 
 	if d.Title != "" {
-		sb.WriteString(fmt.Sprintf(baseDiagramTitle, d.Title))
+		cw.Printf(baseDiagramTitle, QuoteYAML(d.Title))
 	}
 
-	sb.WriteString(d.Config.String())
+	cw.WriteString(d.Config.String())
 
-	sb.WriteString(baseDiagramSeparator)
+	cw.WriteString(baseDiagramSeparator)
+
+	body(cw)
+
+	if d.markdownFence {
+		cw.WriteString(markdownFenceEnd)
+	}
 
-	sb.WriteString(content)
-	return d.MarkdownFencer.WrapWithFence(sb.String())
+	return cw.Result()
 }
@@ -129,6 +129,19 @@ func TestBaseDiagram_String(t *testing.T) {
 				"test content",
 			},
 		},
+		{
+			name: "Diagram with title that needs quoting",
+			diagram: &BaseDiagram[testConfig]{
+				Config: &ConfigurationProperties{},
+			},
+			content: "test content",
+			setup: func(d *BaseDiagram[testConfig]) {
+				d.SetTitle("Release #2: final")
+			},
+			contains: []string{
+				`title: "Release #2: final"`,
+			},
+		},
 		{
 			name: "Diagram with all features",
 			diagram: &BaseDiagram[testConfig]{
@@ -242,3 +255,84 @@ func TestBaseDiagram_SetTitle(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseDiagram_GetTitle(t *testing.T) {
+	diagram := &BaseDiagram[testConfig]{
+		Config: &ConfigurationProperties{},
+	}
+	diagram.SetTitle("Test Diagram")
+
+	if got := diagram.GetTitle(); got != "Test Diagram" {
+		t.Errorf("GetTitle() = %v, want %v", got, "Test Diagram")
+	}
+}
+
+func TestBaseDiagram_GetConfig(t *testing.T) {
+	config := &ConfigurationProperties{}
+	config.SetFontSize(14)
+
+	diagram := NewBaseDiagram(testConfig(config))
+
+	got := diagram.GetConfig()
+	if got != DiagramProperties(config) {
+		t.Errorf("GetConfig() = %v, want %v", got, config)
+	}
+
+	if !strings.Contains(got.String(), "fontSize: 14") {
+		t.Errorf("GetConfig() missing expected content %q in:\n%s", "fontSize: 14", got.String())
+	}
+}
+
+func TestBaseDiagram_Render(t *testing.T) {
+	tests := []struct {
+		name  string
+		fence bool
+	}{
+		{name: "Without markdown fence", fence: false},
+		{name: "With markdown fence", fence: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagram := NewBaseDiagram(testConfig(&ConfigurationProperties{}))
+			diagram.SetTitle("Streamed")
+			if tt.fence {
+				diagram.EnableMarkdownFence()
+			}
+
+			var sb strings.Builder
+			n, err := diagram.Render(&sb, func(w *Writer) {
+				w.WriteString("test ")
+				w.Printf("%s", "content")
+			})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			want := diagram.String("test content")
+			if sb.String() != want {
+				t.Errorf("Render() = %q, want %q", sb.String(), want)
+			}
+			if n != int64(len(want)) {
+				t.Errorf("Render() wrote %d bytes, want %d", n, len(want))
+			}
+			if tt.fence && !strings.HasPrefix(want, "```mermaid\n") {
+				t.Errorf("Render() missing markdown fence in:\n%s", want)
+			}
+		})
+	}
+}
+
+func TestBaseDiagram_Render_Error(t *testing.T) {
+	diagram := NewBaseDiagram(testConfig(&ConfigurationProperties{}))
+
+	n, err := diagram.Render(&failingWriter{limit: 2}, func(w *Writer) {
+		w.WriteString("test content")
+	})
+	if err != errWriteFailed {
+		t.Errorf("Render() error = %v, want %v", err, errWriteFailed)
+	}
+	if n != 2 {
+		t.Errorf("Render() wrote %d bytes, want 2", n)
+	}
+}
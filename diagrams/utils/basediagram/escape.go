@@ -0,0 +1,96 @@
+package basediagram
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// escaper replaces the characters that end or break a label in the Mermaid
+// grammars with Mermaid entity codes, which are decoded when the diagram is drawn.
+// Reference: https://mermaid.js.org/syntax/flowchart.html#entity-codes-to-escape-characters
+var escaper = strings.NewReplacer(escapes...)
+
+// colonEscaper also replaces the colons, for the grammars where a colon
+// separates the label from the fields after it.
+var colonEscaper = strings.NewReplacer(append([]string{":", "#58;"}, escapes...)...)
+
+// escapes lists the replacements shared by escaper and colonEscaper.
+var escapes = []string{
+	"#", "#35;",
+	`"`, "#quot;",
+	";", "#59;",
+	"|", "#124;",
+	"\n", "#10;",
+}
+
+var entityPattern = regexp.MustCompile(`#(\w+);`)
+
+// namedEntities lists the named entity codes decoded by Unescape.
+var namedEntities = map[string]string{
+	"quot": `"`,
+	"amp":  "&",
+	"lt":   "<",
+	"gt":   ">",
+	"apos": "'",
+	"nbsp": "\u00a0",
+}
+
+// Escape replaces the characters of text that cannot appear in a Mermaid
+// label with entity codes, e.g. `"` with "#quot;" and "#" with "#35;".
+func Escape(text string) string {
+	return escaper.Replace(text)
+}
+
+// EscapeColons escapes text like Escape and also replaces ":" with "#58;",
+// for labels followed by a colon separator such as gantt tasks, journey
+// tasks and timeline events.
+func EscapeColons(text string) string {
+	return colonEscaper.Replace(text)
+}
+
+// Unescape decodes the Mermaid entity codes of text, reversing Escape and
+// EscapeColons. Numeric codes such as "#35;" and the common named codes such
+// as "#quot;" are decoded, unknown codes are kept as written.
+func Unescape(text string) string {
+	if !strings.Contains(text, "#") {
+		return text
+	}
+
+	return entityPattern.ReplaceAllStringFunc(text, func(entity string) string {
+		name := entity[1 : len(entity)-1]
+		if decoded, ok := namedEntities[name]; ok {
+			return decoded
+		}
+		if code, err := strconv.Atoi(name); err == nil && code > 0 && code <= 0x10FFFF {
+			return string(rune(code))
+		}
+		return entity
+	})
+}
+
+// Quote escapes text and wraps it in double quotes, for the places where
+// the Mermaid grammar accepts a quoted string.
+func Quote(text string) string {
+	return `"` + Escape(text) + `"`
+}
+
+// yamlIndicators are the characters that cannot start a plain YAML scalar.
+const yamlIndicators = "!&*-?{}[],#|>@%'\"`:"
+
+// QuoteYAML returns text as a YAML scalar for the frontmatter, wrapping it in
+// double quotes when the plain form would be read differently.
+func QuoteYAML(text string) string {
+	plain := text != "" &&
+		!strings.ContainsAny(text[:1], yamlIndicators) &&
+		strings.TrimSpace(text) == text &&
+		!strings.Contains(text, ": ") &&
+		!strings.Contains(text, " #") &&
+		!strings.HasSuffix(text, ":") &&
+		!strings.ContainsAny(text, "\n\r\t")
+	if plain {
+		return text
+	}
+
+	return strconv.Quote(text)
+}
@@ -0,0 +1,156 @@
+package basediagram
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "Plain text",
+			text: "Hello world",
+			want: "Hello world",
+		},
+		{
+			name: "Double quotes",
+			text: `Say "hi"`,
+			want: "Say #quot;hi#quot;",
+		},
+		{
+			name: "Hash and semicolon",
+			text: "Issue #1; done",
+			want: "Issue #35;1#59; done",
+		},
+		{
+			name: "Pipe and newline",
+			text: "a|b\nc",
+			want: "a#124;b#10;c",
+		},
+		{
+			name: "Text that looks like an entity code",
+			text: "#quot;",
+			want: "#35;quot#59;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Escape(tt.text); got != tt.want {
+				t.Errorf("Escape() = %q, want %q", got, tt.want)
+			}
+
+			if got := Unescape(tt.want); got != tt.text {
+				t.Errorf("Unescape() = %q, want %q", got, tt.text)
+			}
+		})
+	}
+}
+
+func TestEscapeColons(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "Plain text",
+			text: "Hello world",
+			want: "Hello world",
+		},
+		{
+			name: "Colon",
+			text: "Step 1: login",
+			want: "Step 1#58; login",
+		},
+		{
+			name: "Colon with other escaped characters",
+			text: `Fix "bug": #1`,
+			want: "Fix #quot;bug#quot;#58; #35;1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeColons(tt.text); got != tt.want {
+				t.Errorf("EscapeColons() = %q, want %q", got, tt.want)
+			}
+
+			if got := Unescape(tt.want); got != tt.text {
+				t.Errorf("Unescape() = %q, want %q", got, tt.text)
+			}
+		})
+	}
+}
+
+func TestUnescape(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "Named entity codes",
+			text: "#lt;b#gt; #amp; #apos;",
+			want: "<b> & '",
+		},
+		{
+			name: "Numeric entity code",
+			text: "I #9829; Go",
+			want: "I ♥ Go",
+		},
+		{
+			name: "Unknown entity code",
+			text: "#unknown; #0;",
+			want: "#unknown; #0;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unescape(tt.text); got != tt.want {
+				t.Errorf("Unescape() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuote(t *testing.T) {
+	if got, want := Quote(`A "quoted" label`), `"A #quot;quoted#quot; label"`; got != want {
+		t.Errorf("Quote() = %q, want %q", got, want)
+	}
+}
+
+func TestQuoteYAML(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		quoted bool
+	}{
+		{name: "Plain title", text: "My diagram", quoted: false},
+		{name: "Colon inside a word", text: "Version 1:2", quoted: false},
+		{name: "Key value separator", text: "Step: one", quoted: true},
+		{name: "Comment", text: "Title #1", quoted: true},
+		{name: "Leading indicator", text: "- item", quoted: true},
+		{name: "Leading quote", text: `"quoted"`, quoted: true},
+		{name: "Surrounding spaces", text: " padded ", quoted: true},
+		{name: "Newline", text: "two\nlines", quoted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.text
+			if tt.quoted {
+				want = strconv.Quote(tt.text)
+			}
+
+			if got := QuoteYAML(tt.text); got != want {
+				t.Errorf("QuoteYAML() = %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package basediagram
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -43,6 +44,13 @@ type StringProperty struct {
 	BaseProperty
 }
 
+// Format quotes the value when YAML would not read it back as the same
+// text: a color such as "#333" would otherwise be read as a comment, and a
+// date format such as "%Y-%m-%d" starts with a reserved indicator.
+func (p *StringProperty) Format() string {
+	return fmt.Sprintf(Indentation+Indentation+"%s: %s\n", p.Name, QuoteYAML(fmt.Sprint(p.Val)))
+}
+
 type StringArrayProperty struct {
 	BaseProperty
 }
@@ -56,6 +64,23 @@ func (p *StringArrayProperty) Format() string {
 	return fmt.Sprintf(Indentation+Indentation+"%s: [%s]\n", p.Name, strings.Join(quotedVals, ", "))
 }
 
+// FormatProperties formats the properties sorted by name, so that the
+// output does not depend on the iteration order of the map.
+func FormatProperties(properties map[string]DiagramProperty) string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		sb.WriteString(properties[name].Format())
+	}
+
+	return sb.String()
+}
+
 type DiagramProperties interface {
 	String() string
 }
@@ -55,6 +55,78 @@ func TestBaseProperty_Format(t *testing.T) {
 	}
 }
 
+func TestStringProperty_Format(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "Plain string",
+			value: "basis",
+			want:  "        curve: basis\n",
+		},
+		{
+			name:  "Color that YAML would read as a comment",
+			value: "#333",
+			want:  "        curve: \"#333\"\n",
+		},
+		{
+			name:  "String starting with a YAML indicator",
+			value: "%Y-%m-%d",
+			want:  "        curve: \"%Y-%m-%d\"\n",
+		},
+		{
+			name:  "String that YAML would read as a mapping",
+			value: "a: b",
+			want:  "        curve: \"a: b\"\n",
+		},
+		{
+			name:  "String with surrounding spaces",
+			value: " padded ",
+			want:  "        curve: \" padded \"\n",
+		},
+		{
+			name:  "Empty string",
+			value: "",
+			want:  "        curve: \"\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			property := &StringProperty{BaseProperty{Name: "curve", Val: tt.value}}
+			if got := property.Format(); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatProperties(t *testing.T) {
+	properties := map[string]DiagramProperty{
+		"wrap":           &BoolProperty{BaseProperty{Name: "wrap", Val: true}},
+		"actorMargin":    &IntProperty{BaseProperty{Name: "actorMargin", Val: 50}},
+		"messageAlign":   &StringProperty{BaseProperty{Name: "messageAlign", Val: "center"}},
+		"diagramMarginX": &FloatProperty{BaseProperty{Name: "diagramMarginX", Val: 2.5}},
+	}
+
+	want := "        actorMargin: 50\n" +
+		"        diagramMarginX: 2.5\n" +
+		"        messageAlign: center\n" +
+		"        wrap: true\n"
+
+	for i := 0; i < 20; i++ {
+		if got := FormatProperties(properties); got != want {
+			t.Fatalf("FormatProperties() = %q, want %q", got, want)
+		}
+	}
+
+	if got := FormatProperties(nil); got != "" {
+		t.Errorf("FormatProperties(nil) = %q, want empty string", got)
+	}
+}
+
 func TestBaseProperty_Value(t *testing.T) {
 	tests := []struct {
 		name     string
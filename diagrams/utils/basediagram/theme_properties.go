@@ -2,6 +2,7 @@ package basediagram
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -202,8 +203,19 @@ func (t *Theme) String() string {
 	sb.WriteString(fmt.Sprintf(baseThemeString, t.Name))
 	sb.WriteString(Indentation + "themeVariables:\n")
 
-	for k, v := range t.Variables {
-		sb.WriteString(fmt.Sprintf(themeVariableString, k, v))
+	names := make([]string, 0, len(t.Variables))
+	for name := range t.Variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		// Colors such as "#f96" would be read as YAML comments if not quoted
+		value := t.Variables[name]
+		if text, ok := value.(string); ok {
+			value = QuoteYAML(text)
+		}
+		sb.WriteString(fmt.Sprintf(themeVariableString, name, value))
 	}
 
 	return sb.String()
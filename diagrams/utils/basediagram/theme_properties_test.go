@@ -65,7 +65,7 @@ func TestTheme_String(t *testing.T) {
 				"theme: dark",
 				"themeVariables:",
 				"darkMode: true",
-				"background: #000000",
+				`background: "#000000"`,
 				"fontFamily: Arial",
 			},
 		},
@@ -87,6 +87,27 @@ func TestTheme_String(t *testing.T) {
 	}
 }
 
+func TestTheme_String_SortedVariables(t *testing.T) {
+	theme := NewTheme()
+	theme.SetPrimaryColor("#f96")
+	theme.SetDarkMode(true)
+	theme.SetBackground("#000000")
+	theme.SetLineColor("#333")
+
+	want := "    theme: default\n" +
+		"    themeVariables:\n" +
+		"        background: \"#000000\"\n" +
+		"        darkMode: true\n" +
+		"        lineColor: \"#333\"\n" +
+		"        primaryColor: \"#f96\"\n"
+
+	for i := 0; i < 20; i++ {
+		if got := theme.String(); got != want {
+			t.Fatalf("String() = %q, want %q", got, want)
+		}
+	}
+}
+
 func TestTheme_Setters(t *testing.T) {
 	tests := []struct {
 		name      string
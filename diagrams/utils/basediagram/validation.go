@@ -0,0 +1,97 @@
+package basediagram
+
+import "fmt"
+
+// Severity tells how serious a validation problem is.
+type Severity string
+
+// List of possible validation severities.
+const (
+	SeverityError   Severity = "error"   // The diagram renders incorrectly or not at all
+	SeverityWarning Severity = "warning" // The diagram renders, but probably not as intended
+)
+
+// List of validation codes shared by the diagram types.
+const (
+	CodeMissingReference = "missing-reference" // A required element is nil
+	CodeUnknownReference = "unknown-reference" // A referenced element was never added to the diagram
+	CodeDuplicateID      = "duplicate-id"      // Two elements share the same ID
+	CodeEmptyID          = "empty-id"          // An element has no ID
+	CodeOutOfRange       = "out-of-range"      // A number is outside of its allowed range
+	CodeInvalidValue     = "invalid-value"     // A value cannot be rendered as Mermaid syntax
+	CodeIgnoredValue     = "ignored-value"     // A value is set but dropped from the output
+)
+
+// ValidationError describes a problem found in a diagram model.
+// Path locates the offending element from the diagram, e.g. "Links[2].From".
+type ValidationError struct {
+	Code     string
+	Severity Severity
+	Path     string
+	Message  string
+}
+
+// Error implements the error interface.
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s %s: %s (%s)", e.Severity, e.Path, e.Message, e.Code)
+}
+
+// HasErrors reports whether errs contains at least one problem of SeverityError.
+func HasErrors(errs []ValidationError) bool {
+	for _, err := range errs {
+		if err.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Validator collects the problems found while walking a diagram.
+type Validator struct {
+	errors []ValidationError
+	ids    map[string]string
+}
+
+// Error records a problem of SeverityError.
+func (v *Validator) Error(code string, path string, format string, args ...interface{}) {
+	v.add(code, SeverityError, path, format, args...)
+}
+
+// Warning records a problem of SeverityWarning.
+func (v *Validator) Warning(code string, path string, format string, args ...interface{}) {
+	v.add(code, SeverityWarning, path, format, args...)
+}
+
+// UniqueID records the ID of the element at path, reporting an error
+// when the ID is empty or was already recorded for another element.
+func (v *Validator) UniqueID(path string, id string) {
+	if id == "" {
+		v.Error(CodeEmptyID, path, "missing ID")
+		return
+	}
+
+	if v.ids == nil {
+		v.ids = make(map[string]string)
+	}
+
+	if first, ok := v.ids[id]; ok {
+		v.Error(CodeDuplicateID, path, "ID %q is already used by %s", id, first)
+		return
+	}
+
+	v.ids[id] = path
+}
+
+// Errors returns the recorded problems in the order they were found.
+func (v *Validator) Errors() []ValidationError {
+	return v.errors
+}
+
+func (v *Validator) add(code string, severity Severity, path string, format string, args ...interface{}) {
+	v.errors = append(v.errors, ValidationError{
+		Code:     code,
+		Severity: severity,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
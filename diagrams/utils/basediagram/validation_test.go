@@ -0,0 +1,76 @@
+package basediagram
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidator(t *testing.T) {
+	var v Validator
+
+	v.UniqueID("Nodes[0]", "A")
+	v.UniqueID("Nodes[1]", "B")
+	v.UniqueID("Nodes[2]", "A")
+	v.UniqueID("Nodes[3]", "")
+	v.Warning(CodeIgnoredValue, "Nodes[1].Text", "text %q is ignored", "hi")
+
+	want := []ValidationError{
+		{Code: CodeDuplicateID, Severity: SeverityError, Path: "Nodes[2]", Message: `ID "A" is already used by Nodes[0]`},
+		{Code: CodeEmptyID, Severity: SeverityError, Path: "Nodes[3]", Message: "missing ID"},
+		{Code: CodeIgnoredValue, Severity: SeverityWarning, Path: "Nodes[1].Text", Message: `text "hi" is ignored`},
+	}
+
+	if got := v.Errors(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Errors() = %+v, want %+v", got, want)
+	}
+}
+
+func TestValidator_NoErrors(t *testing.T) {
+	var v Validator
+	v.UniqueID("Nodes[0]", "A")
+
+	if got := v.Errors(); got != nil {
+		t.Errorf("Errors() = %+v, want nil", got)
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	err := ValidationError{Code: CodeOutOfRange, Severity: SeverityError, Path: "Sections[0].Tasks[1].Score", Message: "score 7 is outside of 1-5"}
+
+	want := "error Sections[0].Tasks[1].Score: score 7 is outside of 1-5 (out-of-range)"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestHasErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		errs []ValidationError
+		want bool
+	}{
+		{
+			name: "No problems",
+			errs: nil,
+			want: false,
+		},
+		{
+			name: "Only warnings",
+			errs: []ValidationError{{Severity: SeverityWarning}},
+			want: false,
+		},
+		{
+			name: "Warnings and errors",
+			errs: []ValidationError{{Severity: SeverityWarning}, {Severity: SeverityError}},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasErrors(tt.errs); got != tt.want {
+				t.Errorf("HasErrors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package basediagram
+
+import (
+	"fmt"
+	"io"
+)
+
+// Writer wraps an io.Writer, counting the bytes written and keeping the first
+// error, so a diagram can be written piece by piece and checked once at the end.
+// Once an error occurs every later write is skipped.
+type Writer struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+// NewWriter returns a Writer that writes to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes p to the underlying writer unless an earlier write failed.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	w.err = err
+	return n, err
+}
+
+// WriteString writes s to the underlying writer unless an earlier write failed.
+func (w *Writer) WriteString(s string) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	n, err := io.WriteString(w.w, s)
+	w.n += int64(n)
+	w.err = err
+	return n, err
+}
+
+// Printf formats according to a format specifier and writes the result.
+func (w *Writer) Printf(format string, args ...interface{}) {
+	if w.err != nil {
+		return
+	}
+
+	fmt.Fprintf(w, format, args...)
+}
+
+// Result returns the number of bytes written and the first error encountered,
+// in the form expected from an io.WriterTo.
+func (w *Writer) Result() (int64, error) {
+	return w.n, w.err
+}
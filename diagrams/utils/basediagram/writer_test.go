@@ -0,0 +1,66 @@
+package basediagram
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// failingWriter accepts limit bytes and then fails.
+type failingWriter struct {
+	limit int
+	sb    strings.Builder
+}
+
+var errWriteFailed = errors.New("write failed")
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	if f.sb.Len()+len(p) > f.limit {
+		n := f.limit - f.sb.Len()
+		f.sb.Write(p[:n])
+		return n, errWriteFailed
+	}
+	return f.sb.Write(p)
+}
+
+func TestWriter(t *testing.T) {
+	var sb strings.Builder
+	w := NewWriter(&sb)
+
+	w.WriteString("flowchart")
+	w.Printf(" %s\n", "TB")
+	w.Write([]byte("    A\n"))
+
+	n, err := w.Result()
+	if err != nil {
+		t.Fatalf("Result() error = %v", err)
+	}
+
+	want := "flowchart TB\n    A\n"
+	if sb.String() != want {
+		t.Errorf("Writer wrote %q, want %q", sb.String(), want)
+	}
+	if n != int64(len(want)) {
+		t.Errorf("Result() = %d bytes, want %d", n, len(want))
+	}
+}
+
+func TestWriter_Error(t *testing.T) {
+	fw := &failingWriter{limit: 12}
+	w := NewWriter(fw)
+
+	w.WriteString("flowchart TB\n")
+	w.Printf("    %s\n", "A")
+	w.WriteString("    B\n")
+
+	n, err := w.Result()
+	if !errors.Is(err, errWriteFailed) {
+		t.Errorf("Result() error = %v, want %v", err, errWriteFailed)
+	}
+	if n != 12 {
+		t.Errorf("Result() = %d bytes, want 12", n)
+	}
+	if got := fw.sb.String(); got != "flowchart TB" {
+		t.Errorf("Writer wrote %q after the error, want %q", got, "flowchart TB")
+	}
+}
@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 )
@@ -9,6 +11,48 @@ import (
 // RenderToFile writes content to a file, handling directory creation
 func RenderToFile(path string, content string) error {
 	// Create directory if needed
+	if err := createParentDir(path); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return nil
+}
+
+// WriteToFile streams src to a file, handling directory creation, without
+// building the whole content in memory first. The writes are buffered, as
+// diagrams write each element on its own.
+func WriteToFile(path string, src io.WriterTo) (err error) {
+	if err := createParentDir(path); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	defer func() {
+		if closeErr := file.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("failed to write file: %w", closeErr)
+		}
+	}()
+
+	w := bufio.NewWriter(file)
+	if _, err := src.WriteTo(w); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return nil
+}
+
+// createParentDir creates the directory that will hold path.
+func createParentDir(path string) error {
 	dir := filepath.Dir(path)
 	if dir != "" {
 		if err := os.MkdirAll(dir, 0755); err != nil {
@@ -16,9 +60,5 @@ func RenderToFile(path string, content string) error {
 		}
 	}
 
-	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
-		return fmt.Errorf("failed to write file: %w", err)
-	}
-
 	return nil
 }
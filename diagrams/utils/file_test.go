@@ -1,8 +1,11 @@
 package utils
 
 import (
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/TyphonHill/go-mermaid/diagrams/utils/testutils"
@@ -107,3 +110,71 @@ func TestRenderToFile(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteToFile(t *testing.T) {
+	tmpFile := testutils.CreateTempFile(t, "write_test")
+	defer tmpFile.Cleanup(t)
+
+	dir := filepath.Join(filepath.Dir(tmpFile.Path), "write_test_dir")
+	defer os.RemoveAll(dir)
+
+	testPath := filepath.Join(dir, "nested", "file.txt")
+	content := "line1\nline2\nline3"
+
+	if err := WriteToFile(testPath, strings.NewReader(content)); err != nil {
+		t.Fatalf("WriteToFile() error = %v", err)
+	}
+	testutils.AssertFileContent(t, testPath, content)
+
+	// Writing again replaces the previous content
+	if err := WriteToFile(testPath, strings.NewReader("short")); err != nil {
+		t.Fatalf("WriteToFile() error = %v", err)
+	}
+	testutils.AssertFileContent(t, testPath, "short")
+}
+
+// lineWriter writes count numbered lines one at a time, like a diagram
+// writing its elements.
+type lineWriter struct {
+	count int
+}
+
+func (l lineWriter) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	for i := 0; i < l.count; i++ {
+		written, err := fmt.Fprintf(w, "line%d\n", i)
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func TestWriteToFile_Buffered(t *testing.T) {
+	tmpFile := testutils.CreateTempFile(t, "buffered_test")
+	defer tmpFile.Cleanup(t)
+
+	testPath := filepath.Join(filepath.Dir(tmpFile.Path), "buffered_test.txt")
+	defer os.Remove(testPath)
+
+	src := lineWriter{count: 10000}
+	var want strings.Builder
+	src.WriteTo(&want)
+
+	if err := WriteToFile(testPath, src); err != nil {
+		t.Fatalf("WriteToFile() error = %v", err)
+	}
+	testutils.AssertFileContent(t, testPath, want.String())
+}
+
+func TestWriteToFile_FlushError(t *testing.T) {
+	if _, err := os.Stat("/dev/full"); err != nil {
+		t.Skip("/dev/full is not available")
+	}
+
+	// The content fits in the buffer, so only the flush reaches the device.
+	if err := WriteToFile("/dev/full", strings.NewReader("content")); err == nil {
+		t.Error("WriteToFile() error = nil, want the flush error")
+	}
+}
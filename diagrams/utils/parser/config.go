@@ -0,0 +1,212 @@
+package parser
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	frontmatterConfig   = "config"
+	configTheme         = "theme"
+	configMaxTextSize   = "maxTextSize"
+	configMaxEdges      = "maxEdges"
+	configFontSize      = "fontSize"
+	configThemeVariable = "themeVariables"
+)
+
+// ConfigValue is a single key: value pair read from the frontmatter config.
+type ConfigValue struct {
+	Line  Line
+	Key   string
+	Value string // Value as written, including any surrounding quotes
+}
+
+// ConfigSection groups the config values nested under a key, such as
+// themeVariables or a diagram specific section.
+type ConfigSection struct {
+	Line   Line
+	Name   string
+	Values []ConfigValue
+}
+
+// Config holds the config block of the frontmatter.
+type Config struct {
+	Values   []ConfigValue
+	Sections []ConfigSection
+}
+
+// Apply copies the config values into config, and the values of the given
+// diagram section into properties. The types of the section values are
+// inferred from their text, as the properties are only used for rendering.
+// The keys and sections that are not modeled, such as look, layout or the
+// section of another diagram, are valid Mermaid and are skipped.
+func (c Config) Apply(config *basediagram.ConfigurationProperties, section string, properties map[string]basediagram.DiagramProperty) error {
+	for _, value := range c.Values {
+		switch value.Key {
+		case configTheme:
+			config.SetTheme(basediagram.ThemeName(unquote(value.Value)))
+		case configMaxTextSize, configMaxEdges, configFontSize:
+			n, err := strconv.Atoi(unquote(value.Value))
+			if err != nil {
+				return value.Line.Errorf(0, "invalid %s %q", value.Key, value.Value)
+			}
+			switch value.Key {
+			case configMaxTextSize:
+				config.SetMaxTextSize(n)
+			case configMaxEdges:
+				config.SetMaxEdges(n)
+			default:
+				config.SetFontSize(n)
+			}
+		}
+	}
+
+	for _, s := range c.Sections {
+		switch s.Name {
+		case configThemeVariable:
+			if config.Variables == nil {
+				config.Variables = make(map[string]interface{})
+			}
+			for _, value := range s.Values {
+				config.Variables[value.Key] = parseValue(value.Value)
+			}
+		case section:
+			for _, value := range s.Values {
+				properties[value.Key] = newProperty(value.Key, value.Value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// readConfig reads the values nested under the config key of the frontmatter.
+// Sections written without indentation, as some diagrams render them, are
+// kept in the config block.
+func readConfig(lines []Line) (Config, error) {
+	var config Config
+
+	inConfig := false
+	indent := -1
+	section := -1
+
+	for _, line := range lines {
+		key, value, found := strings.Cut(line.Text, ":")
+		if !found {
+			if inConfig {
+				return config, line.Errorf(0, "expected key: value")
+			}
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		if line.Offset == 0 {
+			switch {
+			case key == frontmatterConfig && value == "":
+				inConfig = true
+			case inConfig && value == "":
+				config.Sections = append(config.Sections, ConfigSection{Line: line, Name: key})
+				section = len(config.Sections) - 1
+			default:
+				inConfig = false
+				section = -1
+			}
+			continue
+		}
+
+		if !inConfig {
+			continue
+		}
+
+		if indent < 0 {
+			indent = line.Offset
+		}
+
+		if line.Offset <= indent {
+			if value == "" {
+				config.Sections = append(config.Sections, ConfigSection{Line: line, Name: key})
+				section = len(config.Sections) - 1
+			} else {
+				config.Values = append(config.Values, ConfigValue{Line: line, Key: key, Value: value})
+				section = -1
+			}
+			continue
+		}
+
+		if section < 0 {
+			return config, line.Errorf(0, "unexpected indentation")
+		}
+		config.Sections[section].Values = append(config.Sections[section].Values, ConfigValue{Line: line, Key: key, Value: value})
+	}
+
+	return config, nil
+}
+
+// parseValue converts a config value to a bool, int, float64, []string or string.
+func parseValue(text string) interface{} {
+	if isQuoted(text) {
+		return unquote(text)
+	}
+
+	if text == "true" || text == "false" {
+		return text == "true"
+	}
+	if n, err := strconv.Atoi(text); err == nil {
+		return n
+	}
+	if f, err := strconv.ParseFloat(text, 64); err == nil {
+		return f
+	}
+	if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+		items := make([]string, 0)
+		for _, item := range strings.Split(text[1:len(text)-1], ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, unquote(item))
+			}
+		}
+		return items
+	}
+
+	return text
+}
+
+// newProperty returns a diagram property holding the parsed config value.
+func newProperty(name string, text string) basediagram.DiagramProperty {
+	base := basediagram.BaseProperty{Name: name, Val: parseValue(text)}
+
+	switch base.Val.(type) {
+	case bool:
+		return &basediagram.BoolProperty{BaseProperty: base}
+	case int:
+		return &basediagram.IntProperty{BaseProperty: base}
+	case float64:
+		return &basediagram.FloatProperty{BaseProperty: base}
+	case []string:
+		return &basediagram.StringArrayProperty{BaseProperty: base}
+	default:
+		return &basediagram.StringProperty{BaseProperty: base}
+	}
+}
+
+func isQuoted(text string) bool {
+	return len(text) >= 2 && (text[0] == '"' || text[0] == '\'') && text[len(text)-1] == text[0]
+}
+
+// unquote reads a YAML scalar, decoding the escapes of the double-quoted
+// values written by basediagram.QuoteYAML and the doubled quotes of the
+// single-quoted ones.
+func unquote(text string) string {
+	if !isQuoted(text) {
+		return text
+	}
+	if text[0] == '"' {
+		if unquoted, err := strconv.Unquote(text); err == nil {
+			return unquoted
+		}
+		return text[1 : len(text)-1]
+	}
+	return strings.ReplaceAll(text[1:len(text)-1], "''", "'")
+}
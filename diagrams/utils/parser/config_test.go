@@ -0,0 +1,163 @@
+package parser
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestRead_Config(t *testing.T) {
+	input := `---
+title: Configured
+config:
+  theme: "forest"
+  fontSize: 12
+  themeVariables:
+    primaryColor: #f96
+  flowchart:
+    curve: basis
+    htmlLabels: false
+block:
+        padding: 8
+---
+flowchart TB
+`
+
+	doc, err := Read(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	var values []string
+	for _, value := range doc.Config.Values {
+		values = append(values, value.Key+"="+value.Value)
+	}
+	if want := []string{`theme="forest"`, "fontSize=12"}; !reflect.DeepEqual(values, want) {
+		t.Errorf("Read() config values = %v, want %v", values, want)
+	}
+
+	var sections []string
+	for _, section := range doc.Config.Sections {
+		for _, value := range section.Values {
+			sections = append(sections, section.Name+"."+value.Key+"="+value.Value)
+		}
+	}
+	want := []string{"themeVariables.primaryColor=#f96", "flowchart.curve=basis", "flowchart.htmlLabels=false", "block.padding=8"}
+	if !reflect.DeepEqual(sections, want) {
+		t.Errorf("Read() config sections = %v, want %v", sections, want)
+	}
+}
+
+func TestConfig_Apply(t *testing.T) {
+	doc, err := Read(strings.NewReader("---\nconfig:\n    theme: dark\n    look: handDrawn\n    layout: elk\n    maxEdges: 100\n    themeVariables:\n        darkMode: true\n    flowchart:\n        curve: basis\n        nodeSpacing: 30\n        padding: 7.5\n        sides: [\"a\", \"b\"]\n    sequence:\n        wrap: true\n---\nflowchart TB\n"))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	config := basediagram.NewConfigurationProperties()
+	properties := make(map[string]basediagram.DiagramProperty)
+	if err := doc.Config.Apply(&config, "flowchart", properties); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	got := config.String()
+	for _, want := range []string{"theme: dark", "darkMode: true", "maxEdges: 100"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Apply() config missing %q in:\n%s", want, got)
+		}
+	}
+
+	wantProperties := map[string]interface{}{
+		"curve":       "basis",
+		"nodeSpacing": 30,
+		"padding":     7.5,
+		"sides":       []string{"a", "b"},
+	}
+	if len(properties) != len(wantProperties) {
+		t.Errorf("Apply() properties = %v, want %d properties", properties, len(wantProperties))
+	}
+	for name, want := range wantProperties {
+		property, ok := properties[name]
+		if !ok {
+			t.Errorf("Apply() missing property %q", name)
+			continue
+		}
+		if !reflect.DeepEqual(property.Value(), want) {
+			t.Errorf("Apply() property %q = %#v, want %#v", name, property.Value(), want)
+		}
+	}
+}
+
+func TestUnquote(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "Plain value", text: "basis", want: "basis"},
+		{name: "Double-quoted value", text: `"#333"`, want: "#333"},
+		{name: "Double-quoted escapes", text: `"say \"hi\" C:\\temp"`, want: `say "hi" C:\temp`},
+		{name: "Invalid double-quoted escape", text: `"a\qb"`, want: `a\qb`},
+		{name: "Single-quoted value", text: `'it''s'`, want: "it's"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unquote(tt.text); got != tt.want {
+				t.Errorf("unquote() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnquote_QuoteYAML(t *testing.T) {
+	for _, text := range []string{"plain", "#f96", `say "hi"`, `C:\temp`, " padded ", ""} {
+		if got := unquote(basediagram.QuoteYAML(text)); got != text {
+			t.Errorf("unquote(QuoteYAML(%q)) = %q", text, got)
+		}
+	}
+}
+
+func TestConfig_Apply_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		message string
+	}{
+		{
+			name:    "Invalid number",
+			input:   "---\nconfig:\n    maxTextSize: big\n---\n",
+			line:    3,
+			message: `invalid maxTextSize "big"`,
+		},
+		{
+			name:    "Malformed line",
+			input:   "---\nconfig:\n    theme\n---\n",
+			line:    3,
+			message: "expected key: value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Read(strings.NewReader(tt.input))
+			if err == nil {
+				config := basediagram.NewConfigurationProperties()
+				err = doc.Config.Apply(&config, "flowchart", make(map[string]basediagram.DiagramProperty))
+			}
+
+			var parseErr *Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Apply() error = %v, want *Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Message != tt.message {
+				t.Errorf("Apply() error = %v, want line %d: %s", parseErr, tt.line, tt.message)
+			}
+		})
+	}
+}
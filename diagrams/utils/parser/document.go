@@ -0,0 +1,150 @@
+// Package parser provides helpers shared by the Mermaid diagram parsers
+package parser
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode"
+)
+
+const (
+	frontmatterSeparator = "---"
+	frontmatterTitle     = "title:"
+	markdownFence        = "```"
+	commentPrefix        = "%%"
+)
+
+// Line is a single meaningful line of a Mermaid document.
+type Line struct {
+	Number int    // 1-based line number in the source
+	Raw    string // Line as read from the source
+	Text   string // Line with surrounding whitespace removed
+	Offset int    // Byte offset of Text within Raw
+}
+
+// Errorf returns an Error positioned at byte offset pos within l.Text.
+func (l Line) Errorf(pos int, format string, args ...interface{}) *Error {
+	return newError(l, l.Offset+pos, format, args...)
+}
+
+// Document is a Mermaid document split into its frontmatter and diagram body.
+type Document struct {
+	Title       string
+	Config      Config
+	Frontmatter []Line
+	Lines       []Line
+}
+
+// Read splits a Mermaid document into frontmatter and body lines.
+// An optional ```mermaid markdown fence is stripped, the title and config
+// are read from the frontmatter, and blank lines and %% comments are dropped
+// from the body.
+func Read(r io.Reader) (*Document, error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &Document{}
+	i := skipBlank(lines, 0)
+
+	fenced := i < len(lines) && strings.HasPrefix(lines[i].Text, markdownFence)
+	if fenced {
+		i++
+	}
+
+	i = skipBlank(lines, i)
+	if i < len(lines) && lines[i].Text == frontmatterSeparator {
+		start := lines[i]
+		for i++; i < len(lines) && lines[i].Text != frontmatterSeparator; i++ {
+			doc.Frontmatter = append(doc.Frontmatter, lines[i])
+		}
+		if i == len(lines) {
+			return nil, start.Errorf(0, "unterminated frontmatter")
+		}
+		i++
+	}
+
+	for _, line := range doc.Frontmatter {
+		if line.Offset == 0 && strings.HasPrefix(line.Text, frontmatterTitle) {
+			doc.Title = unquote(strings.TrimSpace(strings.TrimPrefix(line.Text, frontmatterTitle)))
+		}
+	}
+
+	if doc.Config, err = readConfig(doc.Frontmatter); err != nil {
+		return nil, err
+	}
+
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if fenced && line.Text == markdownFence {
+			break
+		}
+		if line.Text == "" || strings.HasPrefix(line.Text, commentPrefix) {
+			continue
+		}
+		doc.Lines = append(doc.Lines, line)
+	}
+
+	return doc, nil
+}
+
+// Header checks that the document body starts with one of the given diagram
+// keywords and returns the header line along with the text that follows the keyword.
+func (d *Document) Header(keywords ...string) (Line, string, error) {
+	if len(d.Lines) == 0 {
+		return Line{}, "", &Error{Line: 1, Column: 1, Message: "missing " + keywords[0] + " declaration"}
+	}
+
+	line := d.Lines[0]
+	for _, keyword := range keywords {
+		if line.Text == keyword {
+			return line, "", nil
+		}
+		if strings.HasPrefix(line.Text, keyword) && unicode.IsSpace(rune(line.Text[len(keyword)])) {
+			return line, strings.TrimSpace(line.Text[len(keyword):]), nil
+		}
+	}
+
+	return line, "", line.Errorf(0, "expected %s declaration", keywords[0])
+}
+
+// Body returns the document lines following the diagram header.
+func (d *Document) Body() []Line {
+	if len(d.Lines) == 0 {
+		return nil
+	}
+	return d.Lines[1:]
+}
+
+func readLines(r io.Reader) ([]Line, error) {
+	var lines []Line
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for number := 1; scanner.Scan(); number++ {
+		raw := strings.TrimRight(scanner.Text(), "\r")
+		text := strings.TrimLeftFunc(raw, unicode.IsSpace)
+		offset := len(raw) - len(text)
+		lines = append(lines, Line{
+			Number: number,
+			Raw:    raw,
+			Text:   strings.TrimRightFunc(text, unicode.IsSpace),
+			Offset: offset,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+func skipBlank(lines []Line, i int) int {
+	for i < len(lines) && lines[i].Text == "" {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,140 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantTitle string
+		wantLines []string
+	}{
+		{
+			name:      "Plain document",
+			input:     "flowchart TB\n    A --> B\n",
+			wantLines: []string{"flowchart TB", "A --> B"},
+		},
+		{
+			name:      "Document with frontmatter",
+			input:     "---\ntitle: My Diagram\nconfig:\n    theme: dark\n---\nflowchart TB\n",
+			wantTitle: "My Diagram",
+			wantLines: []string{"flowchart TB"},
+		},
+		{
+			name:      "Document with markdown fence",
+			input:     "```mermaid\n---\ntitle: Fenced\n---\nflowchart TB\n    A\n\n```\ntrailing text\n",
+			wantTitle: "Fenced",
+			wantLines: []string{"flowchart TB", "A"},
+		},
+		{
+			name:      "Document with quoted title",
+			input:     "---\ntitle: \"Step: \\\"one\\\" #1\"\n---\nflowchart TB\n",
+			wantTitle: `Step: "one" #1`,
+			wantLines: []string{"flowchart TB"},
+		},
+		{
+			name:      "Document with blank lines and comments",
+			input:     "\n\nflowchart TB\n\n    %% comment\n    A\r\n",
+			wantLines: []string{"flowchart TB", "A"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Read(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+
+			if doc.Title != tt.wantTitle {
+				t.Errorf("Read() title = %q, want %q", doc.Title, tt.wantTitle)
+			}
+
+			if len(doc.Lines) != len(tt.wantLines) {
+				t.Fatalf("Read() got %d lines, want %d", len(doc.Lines), len(tt.wantLines))
+			}
+
+			for i, want := range tt.wantLines {
+				if doc.Lines[i].Text != want {
+					t.Errorf("Read() line %d = %q, want %q", i, doc.Lines[i].Text, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRead_UnterminatedFrontmatter(t *testing.T) {
+	_, err := Read(strings.NewReader("---\ntitle: Broken\nflowchart TB\n"))
+
+	var parseErr *Error
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("Read() error = %v, want *Error", err)
+	}
+
+	if parseErr.Line != 1 || parseErr.Column != 1 {
+		t.Errorf("Read() error position = %d:%d, want 1:1", parseErr.Line, parseErr.Column)
+	}
+}
+
+func TestDocument_Header(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		keywords []string
+		wantRest string
+		wantErr  string
+	}{
+		{
+			name:     "Keyword only",
+			input:    "sequenceDiagram\n",
+			keywords: []string{"sequenceDiagram"},
+		},
+		{
+			name:     "Keyword with arguments",
+			input:    "graph LR\n",
+			keywords: []string{"flowchart", "graph"},
+			wantRest: "LR",
+		},
+		{
+			name:     "Wrong keyword",
+			input:    "flowchartTB\n",
+			keywords: []string{"flowchart"},
+			wantErr:  "line 1, column 1: expected flowchart declaration",
+		},
+		{
+			name:     "Empty document",
+			input:    "",
+			keywords: []string{"flowchart"},
+			wantErr:  "line 1, column 1: missing flowchart declaration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Read(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+
+			_, rest, err := doc.Header(tt.keywords...)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("Header() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Header() error = %v", err)
+			}
+
+			if rest != tt.wantRest {
+				t.Errorf("Header() rest = %q, want %q", rest, tt.wantRest)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package parser
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Error describes a syntax error at a given position of a Mermaid document.
+type Error struct {
+	Line    int // 1-based line number
+	Column  int // 1-based column, counted in characters
+	Message string
+}
+
+func newError(line Line, offset int, format string, args ...interface{}) *Error {
+	if offset > len(line.Raw) {
+		offset = len(line.Raw)
+	}
+
+	return &Error{
+		Line:    line.Number,
+		Column:  utf8.RuneCountInString(line.Raw[:offset]) + 1,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
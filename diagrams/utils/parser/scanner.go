@@ -0,0 +1,119 @@
+package parser
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Scanner walks through the text of a single line, keeping track of the
+// current position so that errors can be reported accurately.
+type Scanner struct {
+	line Line
+	pos  int
+}
+
+// NewScanner creates a Scanner positioned at the start of the line text.
+func NewScanner(line Line) *Scanner {
+	return &Scanner{line: line}
+}
+
+// Line returns the line being scanned.
+func (s *Scanner) Line() Line {
+	return s.line
+}
+
+// Pos returns the current byte offset within the line text.
+func (s *Scanner) Pos() int {
+	return s.pos
+}
+
+// EOF reports whether the whole line has been consumed.
+func (s *Scanner) EOF() bool {
+	return s.pos >= len(s.line.Text)
+}
+
+// Rest returns the text that has not been consumed yet.
+func (s *Scanner) Rest() string {
+	return s.line.Text[s.pos:]
+}
+
+// Advance consumes n bytes.
+func (s *Scanner) Advance(n int) {
+	s.pos += n
+	if s.pos > len(s.line.Text) {
+		s.pos = len(s.line.Text)
+	}
+}
+
+// SkipSpaces consumes any whitespace at the current position.
+func (s *Scanner) SkipSpaces() {
+	s.ReadWhile(unicode.IsSpace)
+}
+
+// HasPrefix reports whether the remaining text starts with prefix.
+func (s *Scanner) HasPrefix(prefix string) bool {
+	return strings.HasPrefix(s.Rest(), prefix)
+}
+
+// Consume consumes prefix if the remaining text starts with it.
+func (s *Scanner) Consume(prefix string) bool {
+	if !s.HasPrefix(prefix) {
+		return false
+	}
+	s.pos += len(prefix)
+	return true
+}
+
+// ReadWhile consumes and returns the characters matching f.
+func (s *Scanner) ReadWhile(f func(rune) bool) string {
+	start := s.pos
+	for s.pos < len(s.line.Text) {
+		r, size := utf8.DecodeRuneInString(s.line.Text[s.pos:])
+		if !f(r) {
+			break
+		}
+		s.pos += size
+	}
+	return s.line.Text[start:s.pos]
+}
+
+// ReadUntil consumes and returns the text up to the first occurrence of
+// delimiter, which is consumed as well. ok is false if delimiter is not found.
+func (s *Scanner) ReadUntil(delimiter string) (text string, ok bool) {
+	i := strings.Index(s.Rest(), delimiter)
+	if i < 0 {
+		return "", false
+	}
+	text = s.line.Text[s.pos : s.pos+i]
+	s.pos += i + len(delimiter)
+	return text, true
+}
+
+// ReadQuoted consumes a double quoted string and returns its content.
+func (s *Scanner) ReadQuoted() (string, error) {
+	start := s.pos
+	if !s.Consume(`"`) {
+		return "", s.Errorf("expected '\"'")
+	}
+	text, ok := s.ReadUntil(`"`)
+	if !ok {
+		return "", s.line.Errorf(start, "unterminated string")
+	}
+	return text, nil
+}
+
+// Errorf returns an Error positioned at the current position.
+func (s *Scanner) Errorf(format string, args ...interface{}) *Error {
+	return s.line.Errorf(s.pos, format, args...)
+}
+
+// ErrorAt returns an Error positioned at byte offset pos within the line text.
+func (s *Scanner) ErrorAt(pos int, format string, args ...interface{}) *Error {
+	return s.line.Errorf(pos, format, args...)
+}
+
+// IsIdentifier reports whether r may be part of a diagram identifier.
+func IsIdentifier(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
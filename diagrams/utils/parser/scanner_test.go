@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"testing"
+)
+
+func TestScanner(t *testing.T) {
+	line := Line{Number: 3, Raw: `    héllo "quoted text" rest`, Text: `héllo "quoted text" rest`, Offset: 4}
+	s := NewScanner(line)
+
+	if word := s.ReadWhile(IsIdentifier); word != "héllo" {
+		t.Errorf("ReadWhile() = %q, want %q", word, "héllo")
+	}
+
+	s.SkipSpaces()
+	quoted, err := s.ReadQuoted()
+	if err != nil {
+		t.Fatalf("ReadQuoted() error = %v", err)
+	}
+	if quoted != "quoted text" {
+		t.Errorf("ReadQuoted() = %q, want %q", quoted, "quoted text")
+	}
+
+	s.SkipSpaces()
+	if !s.HasPrefix("re") {
+		t.Errorf("HasPrefix() = false, want true")
+	}
+
+	parseErr := s.Errorf("unexpected %q", s.Rest())
+	if parseErr.Line != 3 || parseErr.Column != 25 {
+		t.Errorf("Errorf() position = %d:%d, want 3:25", parseErr.Line, parseErr.Column)
+	}
+	if parseErr.Error() != `line 3, column 25: unexpected "rest"` {
+		t.Errorf("Error() = %q", parseErr.Error())
+	}
+
+	if !s.Consume("rest") || !s.EOF() {
+		t.Errorf("Consume() did not reach the end of the line")
+	}
+}
+
+func TestScanner_ReadUntil(t *testing.T) {
+	s := NewScanner(Line{Number: 1, Raw: "a|b", Text: "a|b"})
+
+	text, ok := s.ReadUntil("|")
+	if !ok || text != "a" || s.Rest() != "b" {
+		t.Errorf("ReadUntil() = %q, %v, rest %q", text, ok, s.Rest())
+	}
+
+	if _, ok := s.ReadUntil("|"); ok {
+		t.Errorf("ReadUntil() found a missing delimiter")
+	}
+}
+
+func TestScanner_ReadQuotedUnterminated(t *testing.T) {
+	s := NewScanner(Line{Number: 1, Raw: `x "open`, Text: `x "open`})
+	s.Advance(2)
+
+	if _, err := s.ReadQuoted(); err == nil || err.Error() != "line 1, column 3: unterminated string" {
+		t.Errorf("ReadQuoted() error = %v", err)
+	}
+}
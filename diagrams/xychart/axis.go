@@ -0,0 +1,92 @@
+package xychart
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Base string formats for axes
+const (
+	xAxisKeyword string = "x-axis"
+	yAxisKeyword string = "y-axis"
+	baseRange    string = "%s --> %s"
+)
+
+// Range is the interval of values covered by a numeric axis.
+type Range struct {
+	Min float64
+	Max float64
+}
+
+// XAxis is the horizontal axis of a vertical chart. It is categorical when
+// Categories is set, and numeric otherwise, covering Range when it is set.
+type XAxis struct {
+	Title      string
+	Categories []string
+	Range      *Range
+}
+
+// YAxis is the value axis. Without Range, it fits the values of the series.
+type YAxis struct {
+	Title string
+	Range *Range
+}
+
+// String generates the Mermaid syntax for the x-axis, or nothing when the
+// axis is left to its defaults. Categories take precedence over Range.
+func (a XAxis) String() string {
+	var values string
+	switch {
+	case len(a.Categories) > 0:
+		categories := make([]string, len(a.Categories))
+		for i, category := range a.Categories {
+			categories[i] = basediagram.Quote(category)
+		}
+		values = "[" + strings.Join(categories, ", ") + "]"
+	case a.Range != nil:
+		values = a.Range.String()
+	}
+
+	return formatAxis(xAxisKeyword, a.Title, values)
+}
+
+// String generates the Mermaid syntax for the y-axis, or nothing when the
+// axis is left to its defaults.
+func (a YAxis) String() string {
+	var values string
+	if a.Range != nil {
+		values = a.Range.String()
+	}
+
+	return formatAxis(yAxisKeyword, a.Title, values)
+}
+
+// String generates the Mermaid syntax for the range
+func (r Range) String() string {
+	return fmt.Sprintf(baseRange, formatNumber(r.Min), formatNumber(r.Max))
+}
+
+func formatAxis(keyword string, title string, values string) string {
+	if title == "" && values == "" {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(basediagram.Indentation + keyword)
+	if title != "" {
+		sb.WriteString(" " + basediagram.Quote(title))
+	}
+	if values != "" {
+		sb.WriteString(" " + values)
+	}
+	sb.WriteString("\n")
+
+	return sb.String()
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,77 @@
+package xychart
+
+import "testing"
+
+func TestXAxis_String(t *testing.T) {
+	tests := []struct {
+		name string
+		axis XAxis
+		want string
+	}{
+		{
+			name: "Default axis",
+			axis: XAxis{},
+			want: "",
+		},
+		{
+			name: "Axis with title only",
+			axis: XAxis{Title: "Month"},
+			want: "    x-axis \"Month\"\n",
+		},
+		{
+			name: "Categorical axis",
+			axis: XAxis{Title: "Month", Categories: []string{"jan", "feb"}},
+			want: "    x-axis \"Month\" [\"jan\", \"feb\"]\n",
+		},
+		{
+			name: "Numeric axis",
+			axis: XAxis{Range: &Range{Min: -1.5, Max: 10}},
+			want: "    x-axis -1.5 --> 10\n",
+		},
+		{
+			name: "Categories take precedence over range",
+			axis: XAxis{Categories: []string{"a"}, Range: &Range{Min: 0, Max: 1}},
+			want: "    x-axis [\"a\"]\n",
+		},
+		{
+			name: "Categories that need escaping",
+			axis: XAxis{Categories: []string{`"q1"`, "a, b"}},
+			want: "    x-axis [\"#quot;q1#quot;\", \"a, b\"]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.axis.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestYAxis_String(t *testing.T) {
+	tests := []struct {
+		name string
+		axis YAxis
+		want string
+	}{
+		{
+			name: "Default axis",
+			axis: YAxis{},
+			want: "",
+		},
+		{
+			name: "Axis with title and range",
+			axis: YAxis{Title: "Revenue", Range: &Range{Min: 0, Max: 100}},
+			want: "    y-axis \"Revenue\" 0 --> 100\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.axis.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
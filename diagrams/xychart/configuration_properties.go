@@ -0,0 +1,126 @@
+package xychart
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+const (
+	xyChartConfigurationSection        string = "xyChart"
+	baseXyChartConfigurationProperties string = basediagram.Indentation + xyChartConfigurationSection + ":\n"
+
+	xyChartPropertyWidth                    string = "width"
+	xyChartPropertyHeight                   string = "height"
+	xyChartPropertyTitleFontSize            string = "titleFontSize"
+	xyChartPropertyTitlePadding             string = "titlePadding"
+	xyChartPropertyShowTitle                string = "showTitle"
+	xyChartPropertyShowDataLabel            string = "showDataLabel"
+	xyChartPropertyPlotReservedSpacePercent string = "plotReservedSpacePercent"
+	xyChartPropertyUseMaxWidth              string = "useMaxWidth"
+)
+
+// XYChartConfigurationProperties holds xyChart-specific configuration
+type XYChartConfigurationProperties struct {
+	basediagram.ConfigurationProperties
+	properties map[string]basediagram.DiagramProperty
+}
+
+func NewXYChartConfigurationProperties() XYChartConfigurationProperties {
+	return XYChartConfigurationProperties{
+		ConfigurationProperties: basediagram.NewConfigurationProperties(),
+		properties:              make(map[string]basediagram.DiagramProperty),
+	}
+}
+
+func (c *XYChartConfigurationProperties) SetWidth(v int) *XYChartConfigurationProperties {
+	c.properties[xyChartPropertyWidth] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: xyChartPropertyWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *XYChartConfigurationProperties) SetHeight(v int) *XYChartConfigurationProperties {
+	c.properties[xyChartPropertyHeight] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: xyChartPropertyHeight,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *XYChartConfigurationProperties) SetTitleFontSize(v int) *XYChartConfigurationProperties {
+	c.properties[xyChartPropertyTitleFontSize] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: xyChartPropertyTitleFontSize,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *XYChartConfigurationProperties) SetTitlePadding(v int) *XYChartConfigurationProperties {
+	c.properties[xyChartPropertyTitlePadding] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: xyChartPropertyTitlePadding,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *XYChartConfigurationProperties) SetShowTitle(v bool) *XYChartConfigurationProperties {
+	c.properties[xyChartPropertyShowTitle] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: xyChartPropertyShowTitle,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *XYChartConfigurationProperties) SetShowDataLabel(v bool) *XYChartConfigurationProperties {
+	c.properties[xyChartPropertyShowDataLabel] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: xyChartPropertyShowDataLabel,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *XYChartConfigurationProperties) SetPlotReservedSpacePercent(v int) *XYChartConfigurationProperties {
+	c.properties[xyChartPropertyPlotReservedSpacePercent] = &basediagram.IntProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: xyChartPropertyPlotReservedSpacePercent,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c *XYChartConfigurationProperties) SetUseMaxWidth(v bool) *XYChartConfigurationProperties {
+	c.properties[xyChartPropertyUseMaxWidth] = &basediagram.BoolProperty{
+		BaseProperty: basediagram.BaseProperty{
+			Name: xyChartPropertyUseMaxWidth,
+			Val:  v,
+		},
+	}
+	return c
+}
+
+func (c XYChartConfigurationProperties) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.ConfigurationProperties.String())
+
+	if len(c.properties) > 0 {
+		sb.WriteString(baseXyChartConfigurationProperties)
+		sb.WriteString(basediagram.FormatProperties(c.properties))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,202 @@
+package xychart
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestNewXYChartConfigurationProperties(t *testing.T) {
+	got := NewXYChartConfigurationProperties()
+
+	if got.properties == nil {
+		t.Error("NewXYChartConfigurationProperties() properties map is nil")
+	}
+
+	if len(got.properties) != 0 {
+		t.Errorf("NewXYChartConfigurationProperties() properties map length = %v, want 0", len(got.properties))
+	}
+}
+
+func TestXYChartConfigurationProperties_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   XYChartConfigurationProperties
+		setup    func(*XYChartConfigurationProperties)
+		contains []string
+	}{
+		{
+			name:   "Empty configuration",
+			config: NewXYChartConfigurationProperties(),
+			contains: []string{
+				"",
+			},
+		},
+		{
+			name:   "Configuration with single property",
+			config: NewXYChartConfigurationProperties(),
+			setup: func(c *XYChartConfigurationProperties) {
+				c.SetWidth(700)
+			},
+			contains: []string{
+				"xyChart:",
+				"width: 700",
+			},
+		},
+		{
+			name:   "Configuration with multiple properties",
+			config: NewXYChartConfigurationProperties(),
+			setup: func(c *XYChartConfigurationProperties) {
+				c.SetHeight(500)
+				c.SetUseMaxWidth(false)
+			},
+			contains: []string{
+				"xyChart:",
+				"height: 500",
+				"useMaxWidth: false",
+			},
+		},
+		{
+			name:   "Configuration with base properties",
+			config: NewXYChartConfigurationProperties(),
+			setup: func(c *XYChartConfigurationProperties) {
+				c.ConfigurationProperties.SetFontSize(12)
+				c.SetWidth(700)
+			},
+			contains: []string{
+				"fontSize: 12",
+				"xyChart:",
+				"width: 700",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.config)
+			}
+
+			got := tt.config.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("String() missing expected content %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestXYChartConfigurationProperties_Setters(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*XYChartConfigurationProperties) *XYChartConfigurationProperties
+		property string
+		value    interface{}
+	}{
+		{
+			name: "Set width",
+			setup: func(c *XYChartConfigurationProperties) *XYChartConfigurationProperties {
+				return c.SetWidth(700)
+			},
+			property: xyChartPropertyWidth,
+			value:    700,
+		},
+		{
+			name: "Set height",
+			setup: func(c *XYChartConfigurationProperties) *XYChartConfigurationProperties {
+				return c.SetHeight(500)
+			},
+			property: xyChartPropertyHeight,
+			value:    500,
+		},
+		{
+			name: "Set title font size",
+			setup: func(c *XYChartConfigurationProperties) *XYChartConfigurationProperties {
+				return c.SetTitleFontSize(20)
+			},
+			property: xyChartPropertyTitleFontSize,
+			value:    20,
+		},
+		{
+			name: "Set title padding",
+			setup: func(c *XYChartConfigurationProperties) *XYChartConfigurationProperties {
+				return c.SetTitlePadding(10)
+			},
+			property: xyChartPropertyTitlePadding,
+			value:    10,
+		},
+		{
+			name: "Set show title",
+			setup: func(c *XYChartConfigurationProperties) *XYChartConfigurationProperties {
+				return c.SetShowTitle(false)
+			},
+			property: xyChartPropertyShowTitle,
+			value:    false,
+		},
+		{
+			name: "Set show data label",
+			setup: func(c *XYChartConfigurationProperties) *XYChartConfigurationProperties {
+				return c.SetShowDataLabel(true)
+			},
+			property: xyChartPropertyShowDataLabel,
+			value:    true,
+		},
+		{
+			name: "Set plot reserved space percent",
+			setup: func(c *XYChartConfigurationProperties) *XYChartConfigurationProperties {
+				return c.SetPlotReservedSpacePercent(50)
+			},
+			property: xyChartPropertyPlotReservedSpacePercent,
+			value:    50,
+		},
+		{
+			name: "Set use max width",
+			setup: func(c *XYChartConfigurationProperties) *XYChartConfigurationProperties {
+				return c.SetUseMaxWidth(false)
+			},
+			property: xyChartPropertyUseMaxWidth,
+			value:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewXYChartConfigurationProperties()
+			result := tt.setup(&config)
+
+			// Test method chaining
+			if result != &config {
+				t.Error("Setter should return pointer to config for chaining")
+			}
+
+			// Test property was set
+			prop, exists := config.properties[tt.property]
+			if !exists {
+				t.Errorf("Property %q was not set", tt.property)
+				return
+			}
+
+			// Test property value
+			var got interface{}
+			switch p := prop.(type) {
+			case *basediagram.IntProperty:
+				got = p.Val
+			case *basediagram.FloatProperty:
+				got = p.Val
+			case *basediagram.BoolProperty:
+				got = p.Val
+			case *basediagram.StringProperty:
+				got = p.Val
+			case *basediagram.StringArrayProperty:
+				got = p.Val
+			}
+
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("Property %q = %v, want %v", tt.property, got, tt.value)
+			}
+		})
+	}
+}
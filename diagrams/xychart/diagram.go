@@ -0,0 +1,143 @@
+// Package xychart provides functionality for creating Mermaid XY charts
+package xychart
+
+import (
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+type chartOrientation string
+
+// List of possible chart orientations.
+const (
+	OrientationDefault    chartOrientation = ""
+	OrientationVertical   chartOrientation = "vertical"
+	OrientationHorizontal chartOrientation = "horizontal"
+)
+
+// Base string formats for XY charts
+const (
+	diagramType                string = "xychart-beta"
+	baseDiagramType            string = diagramType + "\n"
+	baseDiagramTypeOrientation string = diagramType + " %s\n"
+)
+
+// Diagram represents a Mermaid XY chart: bar and line series drawn over a
+// categorical or numeric x-axis and a numeric y-axis.
+// Reference: https://mermaid.js.org/syntax/xyChart.html
+type Diagram struct {
+	basediagram.BaseDiagram[XYChartConfigurationProperties]
+	Orientation chartOrientation
+	XAxis       XAxis
+	YAxis       YAxis
+	Series      []*Series
+}
+
+// NewDiagram creates a new XY chart without series
+func NewDiagram() *Diagram {
+	return &Diagram{
+		BaseDiagram: basediagram.NewBaseDiagram(NewXYChartConfigurationProperties()),
+		Series:      make([]*Series, 0),
+	}
+}
+
+// SetOrientation sets the chart orientation and returns the diagram for chaining
+func (d *Diagram) SetOrientation(orientation chartOrientation) *Diagram {
+	d.Orientation = orientation
+	return d
+}
+
+// SetXAxisTitle sets the title of the x-axis and returns the diagram for chaining
+func (d *Diagram) SetXAxisTitle(title string) *Diagram {
+	d.XAxis.Title = title
+	return d
+}
+
+// SetXAxisCategories makes the x-axis categorical and returns the diagram for chaining
+func (d *Diagram) SetXAxisCategories(categories ...string) *Diagram {
+	d.XAxis.Categories = categories
+	return d
+}
+
+// SetXAxisRange makes the x-axis numeric and returns the diagram for chaining
+func (d *Diagram) SetXAxisRange(min float64, max float64) *Diagram {
+	d.XAxis.Range = &Range{Min: min, Max: max}
+	return d
+}
+
+// SetYAxisTitle sets the title of the y-axis and returns the diagram for chaining
+func (d *Diagram) SetYAxisTitle(title string) *Diagram {
+	d.YAxis.Title = title
+	return d
+}
+
+// SetYAxisRange sets the range of the y-axis and returns the diagram for chaining.
+// Without range, the y-axis fits the values of the series.
+func (d *Diagram) SetYAxisRange(min float64, max float64) *Diagram {
+	d.YAxis.Range = &Range{Min: min, Max: max}
+	return d
+}
+
+// AddBar creates and adds a new bar series to the chart
+func (d *Diagram) AddBar(title string, values []float64) *Series {
+	return d.addSeries(SeriesBar, title, values)
+}
+
+// AddLine creates and adds a new line series to the chart
+func (d *Diagram) AddLine(title string, values []float64) *Series {
+	return d.addSeries(SeriesLine, title, values)
+}
+
+// AddTimeSeries sets the x-axis categories to the times of points formatted
+// with layout, as defined by time.Time.Format, and adds a series of their values.
+func (d *Diagram) AddTimeSeries(seriesType seriesType, title string, layout string, points []TimeValue) *Series {
+	categories, values := SplitTimeValues(layout, points)
+	d.SetXAxisCategories(categories...)
+	return d.addSeries(seriesType, title, values)
+}
+
+func (d *Diagram) addSeries(seriesType seriesType, title string, values []float64) *Series {
+	series := NewSeries(seriesType, title, values)
+	d.Series = append(d.Series, series)
+	return series
+}
+
+// String generates the Mermaid syntax for the XY chart
+func (d *Diagram) String() string {
+	var sb strings.Builder
+	d.WriteTo(&sb)
+	return sb.String()
+}
+
+// DiagramType returns the Mermaid keyword that introduces an XY chart.
+func (d *Diagram) DiagramType() string {
+	return diagramType
+}
+
+// RenderToFile saves the diagram to a file at the specified path
+func (d *Diagram) RenderToFile(path string) error {
+	return utils.WriteToFile(path, d)
+}
+
+// WriteTo streams the diagram to w one series at a time.
+func (d *Diagram) WriteTo(w io.Writer) (int64, error) {
+	return d.BaseDiagram.Render(w, func(w *basediagram.Writer) {
+		if d.Orientation != OrientationDefault {
+			w.Printf(baseDiagramTypeOrientation, string(d.Orientation))
+		} else {
+			w.WriteString(baseDiagramType)
+		}
+
+		w.WriteString(d.XAxis.String())
+		w.WriteString(d.YAxis.String())
+
+		for _, series := range d.Series {
+			if series != nil {
+				w.WriteString(series.String())
+			}
+		}
+	})
+}
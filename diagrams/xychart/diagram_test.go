@@ -0,0 +1,123 @@
+package xychart
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewDiagram(t *testing.T) {
+	diagram := NewDiagram()
+
+	if diagram.Orientation != OrientationDefault || diagram.XAxis.Range != nil || diagram.YAxis.Range != nil {
+		t.Errorf("NewDiagram() = %+v, want default axes and orientation", diagram)
+	}
+
+	if diagram.Series == nil || len(diagram.Series) != 0 {
+		t.Errorf("NewDiagram() series = %v, want empty slice", diagram.Series)
+	}
+}
+
+func TestDiagram_Setters(t *testing.T) {
+	diagram := NewDiagram()
+
+	result := diagram.
+		SetOrientation(OrientationHorizontal).
+		SetXAxisTitle("Month").
+		SetXAxisCategories("jan", "feb").
+		SetXAxisRange(0, 10).
+		SetYAxisTitle("Revenue").
+		SetYAxisRange(4000, 11000)
+	if result != diagram {
+		t.Error("Setters should return diagram for chaining")
+	}
+
+	wantX := XAxis{Title: "Month", Categories: []string{"jan", "feb"}, Range: &Range{Min: 0, Max: 10}}
+	if !reflect.DeepEqual(diagram.XAxis, wantX) {
+		t.Errorf("x-axis = %+v, want %+v", diagram.XAxis, wantX)
+	}
+
+	wantY := YAxis{Title: "Revenue", Range: &Range{Min: 4000, Max: 11000}}
+	if !reflect.DeepEqual(diagram.YAxis, wantY) {
+		t.Errorf("y-axis = %+v, want %+v", diagram.YAxis, wantY)
+	}
+}
+
+func TestDiagram_AddSeries(t *testing.T) {
+	diagram := NewDiagram()
+	bar := diagram.AddBar("Sales", []float64{1, 2})
+	line := diagram.AddLine("", []float64{3, 4})
+
+	if len(diagram.Series) != 2 || diagram.Series[0] != bar || diagram.Series[1] != line {
+		t.Fatalf("series = %v, want [%v %v]", diagram.Series, bar, line)
+	}
+
+	if bar.Type != SeriesBar || bar.Title != "Sales" || line.Type != SeriesLine || line.Title != "" {
+		t.Errorf("AddBar() = %+v and AddLine() = %+v", bar, line)
+	}
+}
+
+func TestDiagram_AddTimeSeries(t *testing.T) {
+	diagram := NewDiagram()
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	series := diagram.AddTimeSeries(SeriesLine, "ns/op", "Jan 2", []TimeValue{
+		{Time: start, Value: 120},
+		{Time: start.AddDate(0, 0, 1), Value: 98.5},
+	})
+
+	if want := []string{"Mar 1", "Mar 2"}; !reflect.DeepEqual(diagram.XAxis.Categories, want) {
+		t.Errorf("AddTimeSeries() categories = %v, want %v", diagram.XAxis.Categories, want)
+	}
+
+	if series.Type != SeriesLine || series.Title != "ns/op" || !reflect.DeepEqual(series.Values, []float64{120, 98.5}) {
+		t.Errorf("AddTimeSeries() = %+v", series)
+	}
+}
+
+func TestDiagram_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  string
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+			want:  "xychart-beta\n",
+		},
+		{
+			name: "Horizontal diagram",
+			setup: func(d *Diagram) {
+				d.SetOrientation(OrientationHorizontal)
+			},
+			want: "xychart-beta horizontal\n",
+		},
+		{
+			name: "Complete diagram",
+			setup: func(d *Diagram) {
+				d.SetXAxisCategories("jan", "feb", "mar")
+				d.SetYAxisTitle("Revenue (in $)").SetYAxisRange(4000, 11000)
+				d.AddBar("", []float64{5000, 6000, 7500})
+				d.AddLine("Trend", []float64{5000, 6000, 7500.5})
+			},
+			want: "xychart-beta\n" +
+				"    x-axis [\"jan\", \"feb\", \"mar\"]\n" +
+				"    y-axis \"Revenue (in $)\" 4000 --> 11000\n" +
+				"    bar [5000, 6000, 7500]\n" +
+				"    line \"Trend\" [5000, 6000, 7500.5]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.String(); !strings.HasSuffix(got, "---\n"+tt.want) {
+				t.Errorf("String() = %q, want body %q", got, tt.want)
+			}
+		})
+	}
+}
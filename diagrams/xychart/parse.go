@@ -0,0 +1,213 @@
+package xychart
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+// Parse reads Mermaid XY chart syntax and returns the corresponding Diagram.
+// It understands the syntax generated by Diagram.String as well as the
+// `title` statement, the `xychart` keyword and titles written without quotes.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (*Diagram, error) {
+	doc, err := parser.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rest, err := doc.Header(diagramType, "xychart")
+	if err != nil {
+		return nil, err
+	}
+
+	d := NewDiagram()
+	d.Title = doc.Title
+	if err := doc.Config.Apply(&d.Config.ConfigurationProperties, xyChartConfigurationSection, d.Config.properties); err != nil {
+		return nil, err
+	}
+
+	switch chartOrientation(rest) {
+	case OrientationDefault, OrientationVertical, OrientationHorizontal:
+		d.Orientation = chartOrientation(rest)
+	default:
+		return nil, header.Errorf(len(header.Text)-len(rest), "unknown orientation %q", rest)
+	}
+
+	for _, line := range doc.Body() {
+		if err := parseLine(d, line); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+func parseLine(d *Diagram, line parser.Line) error {
+	keyword, rest, _ := strings.Cut(line.Text, " ")
+	pos := len(keyword) + 1
+
+	switch keyword {
+	case "title":
+		d.Title = unquote(strings.TrimSpace(rest))
+		return nil
+
+	case xAxisKeyword:
+		title, values, valuesPos := splitTitle(rest, pos)
+		d.XAxis = XAxis{Title: title}
+		switch {
+		case strings.HasPrefix(values, "["):
+			categories, err := parseList(line, valuesPos, values)
+			if err != nil {
+				return err
+			}
+			for _, category := range categories {
+				d.XAxis.Categories = append(d.XAxis.Categories, unquote(category))
+			}
+		case values != "":
+			r, err := parseRange(line, valuesPos, values)
+			if err != nil {
+				return err
+			}
+			d.XAxis.Range = r
+		}
+		return nil
+
+	case yAxisKeyword:
+		title, values, valuesPos := splitTitle(rest, pos)
+		d.YAxis = YAxis{Title: title}
+		if values != "" {
+			r, err := parseRange(line, valuesPos, values)
+			if err != nil {
+				return err
+			}
+			d.YAxis.Range = r
+		}
+		return nil
+
+	case string(SeriesBar), string(SeriesLine):
+		title, values, valuesPos := splitTitle(rest, pos)
+		if !strings.HasPrefix(values, "[") {
+			return line.Errorf(valuesPos, "expected '['")
+		}
+
+		items, err := parseList(line, valuesPos, values)
+		if err != nil {
+			return err
+		}
+
+		numbers := make([]float64, len(items))
+		for i, item := range items {
+			if numbers[i], err = strconv.ParseFloat(item, 64); err != nil {
+				return line.Errorf(valuesPos, "invalid value %q", item)
+			}
+		}
+
+		d.addSeries(seriesType(keyword), title, numbers)
+		return nil
+
+	case "accTitle", "accDescr":
+		return line.Errorf(0, "unsupported statement %q", keyword)
+	}
+
+	return line.Errorf(0, "unknown statement %q", keyword)
+}
+
+// splitTitle splits the arguments of a statement starting at byte offset pos
+// into the optional title and the values that follow it: a list in brackets
+// or a `min --> max` range. It returns the position of the values.
+func splitTitle(text string, pos int) (title string, values string, valuesPos int) {
+	if strings.HasPrefix(text, `"`) {
+		if end := strings.Index(text[1:], `"`); end >= 0 {
+			title = basediagram.Unescape(text[1 : end+1])
+			values = strings.TrimLeft(text[end+2:], " ")
+			return title, values, pos + len(text) - len(values)
+		}
+	}
+
+	valuesStart := len(text)
+	if i := strings.Index(text, "["); i >= 0 {
+		valuesStart = i
+	} else if i := strings.Index(text, "-->"); i >= 0 {
+		valuesStart = strings.LastIndex(strings.TrimRight(text[:i], " "), " ") + 1
+	}
+
+	title = basediagram.Unescape(strings.TrimSpace(text[:valuesStart]))
+	return title, text[valuesStart:], pos + valuesStart
+}
+
+// parseList splits `[a, "b", c]` starting at byte offset pos into its
+// trimmed items, keeping the quotes.
+func parseList(line parser.Line, pos int, text string) ([]string, error) {
+	if !strings.HasSuffix(text, "]") {
+		return nil, line.Errorf(pos+len(text), "expected ']'")
+	}
+
+	inner := text[1 : len(text)-1]
+	// offset returns the position of the remaining inner text in the line
+	offset := func() int { return pos + len(text) - 1 - len(inner) }
+
+	items := make([]string, 0)
+	for {
+		inner = strings.TrimLeft(inner, " ")
+		if inner == "" {
+			return items, nil
+		}
+
+		var item string
+		if strings.HasPrefix(inner, `"`) {
+			end := strings.Index(inner[1:], `"`)
+			if end < 0 {
+				return nil, line.Errorf(offset(), "unterminated string")
+			}
+			item, inner = inner[:end+2], strings.TrimLeft(inner[end+2:], " ")
+		} else {
+			end := strings.Index(inner, ",")
+			if end < 0 {
+				end = len(inner)
+			}
+			item, inner = strings.TrimSpace(inner[:end]), inner[end:]
+		}
+		items = append(items, item)
+
+		if inner == "" {
+			return items, nil
+		}
+		if !strings.HasPrefix(inner, ",") {
+			return nil, line.Errorf(offset(), "expected ','")
+		}
+		inner = inner[1:]
+	}
+}
+
+// parseRange reads `min --> max`.
+func parseRange(line parser.Line, pos int, text string) (*Range, error) {
+	minText, maxText, found := strings.Cut(text, "-->")
+	if !found {
+		return nil, line.Errorf(pos, "expected '[' or range")
+	}
+
+	min, err := strconv.ParseFloat(strings.TrimSpace(minText), 64)
+	if err != nil {
+		return nil, line.Errorf(pos, "invalid number %q", strings.TrimSpace(minText))
+	}
+
+	max, err := strconv.ParseFloat(strings.TrimSpace(maxText), 64)
+	if err != nil {
+		return nil, line.Errorf(pos+len(minText)+len("-->"), "invalid number %q", strings.TrimSpace(maxText))
+	}
+
+	return &Range{Min: min, Max: max}, nil
+}
+
+// unquote removes the double quotes around a category or title and decodes
+// its entity codes.
+func unquote(text string) string {
+	if len(text) >= 2 && strings.HasPrefix(text, `"`) && strings.HasSuffix(text, `"`) {
+		text = text[1 : len(text)-1]
+	}
+	return basediagram.Unescape(text)
+}
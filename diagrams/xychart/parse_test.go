@@ -0,0 +1,183 @@
+package xychart
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+	}{
+		{
+			name:  "Empty chart",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Chart with title, config, orientation and markdown fence",
+			setup: func(d *Diagram) {
+				d.Title = "Benchmarks"
+				d.Config.SetWidth(900).SetShowDataLabel(true)
+				d.SetOrientation(OrientationHorizontal)
+				d.EnableMarkdownFence()
+				d.AddBar("", []float64{1, 2})
+			},
+		},
+		{
+			name: "Chart with categories and series",
+			setup: func(d *Diagram) {
+				d.SetXAxisTitle("Month").SetXAxisCategories("jan", "feb", "mar")
+				d.SetYAxisTitle("Revenue (in $)").SetYAxisRange(4000, 11000)
+				d.AddBar("Sales", []float64{5000, 6000, 7500})
+				d.AddLine("", []float64{5000, 6000.5, 7500})
+			},
+		},
+		{
+			name: "Chart with numeric axis",
+			setup: func(d *Diagram) {
+				d.SetXAxisRange(-1.5, 10).SetYAxisTitle("Value")
+				d.AddLine("", []float64{-1, 0, 1})
+			},
+		},
+		{
+			name: "Chart with text that needs escaping",
+			setup: func(d *Diagram) {
+				d.SetXAxisTitle(`Say "hi"`).SetXAxisCategories("a, b", "[c]", "#1")
+				d.AddBar("Total; all", []float64{1, 2, 3})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDiagram()
+			tt.setup(want)
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.IsMarkdownFenceEnabled() != want.IsMarkdownFenceEnabled() {
+				got.EnableMarkdownFence()
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() round trip mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_StandardSyntax(t *testing.T) {
+	input := `xychart-beta
+    title "Sales Revenue"
+    x-axis Months [jan, feb, "mar"]
+    y-axis Revenue 4000 --> 11000
+    bar [5000, 6000, 7500]
+    line [5000,6000,7500]
+`
+
+	d, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if d.Title != "Sales Revenue" {
+		t.Errorf("Parse() title = %q, want Sales Revenue", d.Title)
+	}
+
+	wantX := XAxis{Title: "Months", Categories: []string{"jan", "feb", "mar"}}
+	if !reflect.DeepEqual(d.XAxis, wantX) {
+		t.Errorf("Parse() x-axis = %+v, want %+v", d.XAxis, wantX)
+	}
+
+	wantY := YAxis{Title: "Revenue", Range: &Range{Min: 4000, Max: 11000}}
+	if !reflect.DeepEqual(d.YAxis, wantY) {
+		t.Errorf("Parse() y-axis = %+v, want %+v", d.YAxis, wantY)
+	}
+
+	if len(d.Series) != 2 || d.Series[0].Type != SeriesBar || !reflect.DeepEqual(d.Series[1].Values, []float64{5000, 6000, 7500}) {
+		t.Errorf("Parse() series = %+v", d.Series)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Missing header",
+			input:   "bar [1]\n",
+			line:    1,
+			column:  1,
+			message: "expected xychart-beta declaration",
+		},
+		{
+			name:    "Unknown orientation",
+			input:   "xychart-beta diagonal\n",
+			line:    1,
+			column:  14,
+			message: `unknown orientation "diagonal"`,
+		},
+		{
+			name:    "Unknown statement",
+			input:   "xychart-beta\n    area [1, 2]\n",
+			line:    2,
+			column:  5,
+			message: `unknown statement "area"`,
+		},
+		{
+			name:    "Series without values",
+			input:   "xychart-beta\n    bar \"Sales\"\n",
+			line:    2,
+			column:  16,
+			message: "expected '['",
+		},
+		{
+			name:    "Invalid value",
+			input:   "xychart-beta\n    bar [1, two]\n",
+			line:    2,
+			column:  9,
+			message: `invalid value "two"`,
+		},
+		{
+			name:    "Unterminated list",
+			input:   "xychart-beta\n    x-axis [a, b\n",
+			line:    2,
+			column:  17,
+			message: "expected ']'",
+		},
+		{
+			name:    "Invalid range",
+			input:   "xychart-beta\n    y-axis 0 --> max\n",
+			line:    2,
+			column:  17,
+			message: `invalid number "max"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}
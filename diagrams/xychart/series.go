@@ -0,0 +1,50 @@
+package xychart
+
+import (
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+type seriesType string
+
+// List of possible series types.
+const (
+	SeriesBar  seriesType = "bar"
+	SeriesLine seriesType = "line"
+)
+
+// Series represents a list of values drawn as bars or as a line, one value
+// per category of the x-axis. The title is optional.
+type Series struct {
+	Type   seriesType
+	Title  string
+	Values []float64
+}
+
+// NewSeries creates a new series
+func NewSeries(seriesType seriesType, title string, values []float64) *Series {
+	return &Series{
+		Type:   seriesType,
+		Title:  title,
+		Values: values,
+	}
+}
+
+// String generates the Mermaid syntax for the series
+func (s *Series) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(basediagram.Indentation + string(s.Type))
+	if s.Title != "" {
+		sb.WriteString(" " + basediagram.Quote(s.Title))
+	}
+
+	values := make([]string, len(s.Values))
+	for i, value := range s.Values {
+		values[i] = formatNumber(value)
+	}
+	sb.WriteString(" [" + strings.Join(values, ", ") + "]\n")
+
+	return sb.String()
+}
@@ -0,0 +1,35 @@
+package xychart
+
+import "testing"
+
+func TestSeries_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		series *Series
+		want   string
+	}{
+		{
+			name:   "Bar series",
+			series: NewSeries(SeriesBar, "", []float64{1, 2.5, -3}),
+			want:   "    bar [1, 2.5, -3]\n",
+		},
+		{
+			name:   "Line series with title",
+			series: NewSeries(SeriesLine, "Trend", []float64{0.001}),
+			want:   "    line \"Trend\" [0.001]\n",
+		},
+		{
+			name:   "Series without values",
+			series: NewSeries(SeriesLine, "", nil),
+			want:   "    line []\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.series.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package xychart
+
+import "time"
+
+// TimeValue is a value measured at a point in time, such as the result of
+// a benchmark run.
+type TimeValue struct {
+	Time  time.Time
+	Value float64
+}
+
+// SplitTimeValues returns the times of points formatted with layout, as
+// defined by time.Time.Format, for use as x-axis categories, along with
+// the values of points in the same order.
+func SplitTimeValues(layout string, points []TimeValue) (categories []string, values []float64) {
+	categories = make([]string, len(points))
+	values = make([]float64, len(points))
+
+	for i, point := range points {
+		categories[i] = point.Time.Format(layout)
+		values[i] = point.Value
+	}
+
+	return categories, values
+}
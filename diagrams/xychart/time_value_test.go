@@ -0,0 +1,59 @@
+package xychart
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSplitTimeValues(t *testing.T) {
+	start := time.Date(2024, time.January, 31, 15, 4, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		layout         string
+		points         []TimeValue
+		wantCategories []string
+		wantValues     []float64
+	}{
+		{
+			name:           "No points",
+			layout:         time.DateOnly,
+			wantCategories: []string{},
+			wantValues:     []float64{},
+		},
+		{
+			name:   "Daily points",
+			layout: time.DateOnly,
+			points: []TimeValue{
+				{Time: start, Value: 1.5},
+				{Time: start.AddDate(0, 0, 1), Value: 2},
+			},
+			wantCategories: []string{"2024-01-31", "2024-02-01"},
+			wantValues:     []float64{1.5, 2},
+		},
+		{
+			name:   "Hourly points",
+			layout: "15:04",
+			points: []TimeValue{
+				{Time: start, Value: 3},
+				{Time: start.Add(time.Hour), Value: 4},
+			},
+			wantCategories: []string{"15:04", "16:04"},
+			wantValues:     []float64{3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categories, values := SplitTimeValues(tt.layout, tt.points)
+
+			if !reflect.DeepEqual(categories, tt.wantCategories) {
+				t.Errorf("SplitTimeValues() categories = %v, want %v", categories, tt.wantCategories)
+			}
+			if !reflect.DeepEqual(values, tt.wantValues) {
+				t.Errorf("SplitTimeValues() values = %v, want %v", values, tt.wantValues)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package xychart
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+// Validate checks the diagram for problems that String would render silently:
+// empty ranges, an x-axis range hidden by categories, series without values
+// or with values that are not finite numbers, and series whose number of
+// values differs from the number of categories.
+func (d *Diagram) Validate() []basediagram.ValidationError {
+	var v basediagram.Validator
+
+	if len(d.XAxis.Categories) > 0 && d.XAxis.Range != nil {
+		v.Warning(basediagram.CodeIgnoredValue, "XAxis.Range", "range is ignored for an axis with categories")
+	}
+	validateRange(&v, "XAxis.Range", d.XAxis.Range)
+	validateRange(&v, "YAxis.Range", d.YAxis.Range)
+
+	for i, series := range d.Series {
+		path := fmt.Sprintf("Series[%d]", i)
+		if series == nil {
+			v.Error(basediagram.CodeMissingReference, path, "missing series")
+			continue
+		}
+
+		switch series.Type {
+		case SeriesBar, SeriesLine:
+		default:
+			v.Error(basediagram.CodeInvalidValue, path+".Type", "unknown series type %q", series.Type)
+		}
+
+		switch {
+		case len(series.Values) == 0:
+			v.Error(basediagram.CodeInvalidValue, path+".Values", "series has no values")
+		case len(d.XAxis.Categories) > 0 && len(series.Values) != len(d.XAxis.Categories):
+			v.Error(basediagram.CodeInvalidValue, path+".Values", "series has %d values for %d categories", len(series.Values), len(d.XAxis.Categories))
+		}
+
+		for j, value := range series.Values {
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				v.Error(basediagram.CodeInvalidValue, fmt.Sprintf("%s.Values[%d]", path, j), "value %v is not a number", value)
+			}
+		}
+	}
+
+	return v.Errors()
+}
+
+func validateRange(v *basediagram.Validator, path string, r *Range) {
+	switch {
+	case r == nil:
+	case math.IsNaN(r.Min) || math.IsInf(r.Min, 0) || math.IsNaN(r.Max) || math.IsInf(r.Max, 0):
+		v.Error(basediagram.CodeInvalidValue, path, "range %v --> %v is not a number", r.Min, r.Max)
+	case r.Min >= r.Max:
+		v.Error(basediagram.CodeOutOfRange, path, "range %v --> %v is empty", r.Min, r.Max)
+	}
+}
@@ -0,0 +1,69 @@
+package xychart
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func TestDiagram_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Diagram)
+		want  []basediagram.ValidationError
+	}{
+		{
+			name:  "Empty diagram",
+			setup: func(d *Diagram) {},
+		},
+		{
+			name: "Valid diagram",
+			setup: func(d *Diagram) {
+				d.SetXAxisCategories("a", "b").SetYAxisRange(-10, 10)
+				d.AddBar("", []float64{1, -2})
+				d.AddLine("Trend", []float64{1, 2})
+			},
+		},
+		{
+			name: "Invalid axes",
+			setup: func(d *Diagram) {
+				d.SetXAxisCategories("a").SetXAxisRange(1, 0)
+				d.SetYAxisRange(math.NaN(), 10)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeIgnoredValue, Severity: basediagram.SeverityWarning, Path: "XAxis.Range", Message: "range is ignored for an axis with categories"},
+				{Code: basediagram.CodeOutOfRange, Severity: basediagram.SeverityError, Path: "XAxis.Range", Message: "range 1 --> 0 is empty"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "YAxis.Range", Message: "range NaN --> 10 is not a number"},
+			},
+		},
+		{
+			name: "Invalid series",
+			setup: func(d *Diagram) {
+				d.SetXAxisCategories("a", "b")
+				d.AddBar("", nil)
+				d.AddLine("", []float64{1, math.Inf(1), 3})
+				d.Series = append(d.Series, NewSeries("area", "", []float64{1, 2}), nil)
+			},
+			want: []basediagram.ValidationError{
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Series[0].Values", Message: "series has no values"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Series[1].Values", Message: "series has 3 values for 2 categories"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Series[1].Values[1]", Message: "value +Inf is not a number"},
+				{Code: basediagram.CodeInvalidValue, Severity: basediagram.SeverityError, Path: "Series[2].Type", Message: `unknown series type "area"`},
+				{Code: basediagram.CodeMissingReference, Severity: basediagram.SeverityError, Path: "Series[3]", Message: "missing series"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDiagram()
+			tt.setup(d)
+
+			if got := d.Validate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/architecture"
+)
+
+func main() {
+	// Create a new architecture diagram of a cloud deployment
+	diagram := architecture.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Production Deployment")
+	diagram.Config.SetIconSize(60).SetFontSize(14)
+
+	// Nest the network groups: a VPC holding a public and a private subnet
+	vpc := diagram.AddGroup("vpc", architecture.IconCloud, "Production VPC")
+	public := diagram.AddGroup("public", "", "Public Subnet").SetParent(vpc)
+	private := diagram.AddGroup("private", "", "Private Subnet").SetParent(vpc)
+
+	// Add one service per resource of the inventory, placed in its subnet
+	inventory := []struct {
+		id     string
+		kind   string
+		title  string
+		subnet *architecture.Group
+	}{
+		{"lb", "load_balancer", "Load Balancer", public},
+		{"web1", "instance", "Web 1", private},
+		{"web2", "instance", "Web 2", private},
+		{"db", "database", "Primary DB", private},
+		{"backups", "bucket", "Backups", nil},
+	}
+	icons := map[string]string{
+		"load_balancer": architecture.IconInternet,
+		"instance":      architecture.IconServer,
+		"database":      architecture.IconDatabase,
+		"bucket":        architecture.IconDisk,
+	}
+
+	services := make(map[string]*architecture.Service)
+	for _, resource := range inventory {
+		service := diagram.AddService(resource.id, icons[resource.kind], resource.title)
+		if resource.subnet != nil {
+			service.SetParent(resource.subnet)
+		}
+		services[resource.id] = service
+	}
+	users := diagram.AddService("users", architecture.IconInternet, "Users")
+
+	// Split the traffic of the load balancer through a junction
+	split := diagram.AddJunction("split").SetParent(private)
+	diagram.AddEdge(users, architecture.SideRight, services["lb"], architecture.SideLeft).SetArrows(false, true)
+	diagram.AddEdge(services["lb"], architecture.SideBottom, split, architecture.SideTop).SetArrows(false, true).SetGroups(true, false)
+	diagram.AddEdge(split, architecture.SideLeft, services["web1"], architecture.SideRight).SetArrows(false, true)
+	diagram.AddEdge(split, architecture.SideRight, services["web2"], architecture.SideLeft).SetArrows(false, true)
+
+	// Connect the servers to the database and the database to its backups
+	diagram.AddEdge(services["web1"], architecture.SideBottom, services["db"], architecture.SideTop).SetArrows(false, true)
+	diagram.AddEdge(services["web2"], architecture.SideBottom, services["db"], architecture.SideRight).SetArrows(false, true)
+	diagram.AddEdge(services["db"], architecture.SideBottom, services["backups"], architecture.SideTop).SetArrows(false, true).SetGroups(true, false).SetLabel("nightly")
+
+	// Report problems such as unknown icons before writing the diagram
+	for _, problem := range diagram.Validate() {
+		fmt.Println(problem)
+	}
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/architecture"
+)
+
+func main() {
+	// Create a new architecture diagram
+	diagram := architecture.NewDiagram()
+	diagram.EnableMarkdownFence()
+
+	// Add a group holding the services
+	api := diagram.AddGroup("api", architecture.IconCloud, "API")
+	db := diagram.AddService("db", architecture.IconDatabase, "Database").SetParent(api)
+	disk1 := diagram.AddService("disk1", architecture.IconDisk, "Storage").SetParent(api)
+	disk2 := diagram.AddService("disk2", architecture.IconDisk, "Storage").SetParent(api)
+	server := diagram.AddService("server", architecture.IconServer, "Server").SetParent(api)
+
+	// Connect the sides of the services
+	diagram.AddEdge(db, architecture.SideLeft, server, architecture.SideRight)
+	diagram.AddEdge(disk1, architecture.SideTop, server, architecture.SideBottom)
+	diagram.AddEdge(disk2, architecture.SideTop, db, architecture.SideBottom)
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
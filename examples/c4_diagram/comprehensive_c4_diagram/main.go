@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/c4"
+)
+
+func main() {
+	// Create a new container diagram
+	diagram := c4.NewDiagram().SetKind(c4.DiagramContainer)
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Container diagram for Internet Banking System")
+
+	// Configure the layout
+	diagram.Config.SetC4ShapeInRow(3).SetC4BoundaryInRow(1)
+
+	// People and external systems
+	customer := diagram.AddPerson("customer", "Customer").
+		SetDescription("A customer of the bank, with personal bank accounts")
+	email := diagram.AddSystem("email", "E-Mail System").
+		SetExternal(true).
+		SetDescription("The internal Microsoft Exchange system")
+	mainframe := diagram.AddSystem("mainframe", "Mainframe Banking System").
+		SetExternal(true).
+		SetVariant(c4.VariantDb).
+		SetDescription("Stores all of the core banking information")
+
+	// The containers of the system, grouped in nested boundaries
+	banking := diagram.AddBoundary(c4.BoundarySystem, "banking", "Internet Banking")
+	web := banking.AddContainer("web", "Web Application").
+		SetTechnology("Go, net/http").
+		SetDescription("Delivers the static content and the single page application")
+	spa := banking.AddContainer("spa", "Single-Page App").
+		SetTechnology("TypeScript, React").
+		SetDescription("Provides the banking functionality in the browser")
+
+	backend := banking.AddBoundary(c4.BoundaryContainer, "backend", "Backend")
+	api := backend.AddContainer("api", "API Application").
+		SetTechnology("Go, gRPC").
+		SetDescription("Provides the banking functionality as a JSON/HTTPS API").
+		SetLink("https://example.com/api")
+	events := backend.AddContainer("events", "Event Bus").
+		SetVariant(c4.VariantQueue).
+		SetTechnology("Kafka")
+	database := backend.AddContainer("database", "Database").
+		SetVariant(c4.VariantDb).
+		SetTechnology("PostgreSQL").
+		SetDescription("Stores user registration information and access logs")
+
+	// Relationships, placed with explicit directions where it helps the layout
+	diagram.AddRelationship(customer, web, "Visits").SetTechnology("HTTPS")
+	diagram.AddRelationship(customer, spa, "Uses").SetTechnology("HTTPS")
+	diagram.AddRelationship(web, spa, "Delivers").SetType(c4.RelationshipRight)
+	diagram.AddRelationship(spa, api, "Calls").SetType(c4.RelationshipDown).SetTechnology("JSON/HTTPS")
+	diagram.AddRelationship(api, database, "Reads from and writes to").SetTechnology("SQL/TCP")
+	diagram.AddRelationship(api, events, "Publishes to").SetType(c4.RelationshipBidirectional)
+	diagram.AddRelationship(api, mainframe, "Uses").SetTechnology("XML/HTTPS")
+	diagram.AddRelationship(email, customer, "Sends e-mails to").SetType(c4.RelationshipBack)
+	diagram.AddRelationship(api, email, "Sends e-mails using").SetType(c4.RelationshipLeft)
+
+	// Highlight the external systems and move a crowded label
+	diagram.UpdateElementStyle(mainframe).SetBgColor("grey").SetBorderColor("black")
+	diagram.UpdateElementStyle(email).SetBgColor("grey").SetBorderColor("black")
+	diagram.UpdateRelStyle(customer, web).SetTextColor("blue").SetLineColor("blue").SetOffset(-40, -20)
+
+	// Report problems such as duplicate aliases before writing the diagram
+	for _, problem := range diagram.Validate() {
+		fmt.Println(problem)
+	}
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/c4"
+)
+
+func main() {
+	// Create a new system context diagram
+	diagram := c4.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("System Context diagram for Internet Banking System")
+
+	// Add the people and systems
+	customer := diagram.AddPerson("customer", "Personal Banking Customer").
+		SetDescription("A customer of the bank, with personal bank accounts.")
+	banking := diagram.AddSystem("banking", "Internet Banking System").
+		SetDescription("Allows customers to view information about their bank accounts.")
+	mainframe := diagram.AddSystem("mainframe", "Mainframe Banking System").
+		SetExternal(true).
+		SetDescription("Stores all of the core banking information.")
+
+	// Connect them
+	diagram.AddRelationship(customer, banking, "Uses")
+	diagram.AddRelationship(banking, mainframe, "Gets account information from")
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/flowchart"
+)
+
+func main() {
+	// Create a new flowchart flowing from left to right
+	diagram := flowchart.NewFlowchart()
+	diagram.SetTitle("Order Processing")
+	diagram.SetDirection(flowchart.FlowchartDirectionLeftRight)
+
+	// Add nodes with different shapes
+	start := diagram.AddNode("Order received")
+	start.SetShape(flowchart.NodeShapeTerminal)
+
+	valid := diagram.AddNode("Valid?")
+	valid.SetShape(flowchart.NodeShapeDecision)
+
+	reserve := diagram.AddNode("Reserve stock")
+	charge := diagram.AddNode("Charge card")
+
+	orders := diagram.AddNode("Orders")
+	orders.SetShape(flowchart.NodeShapeDatabase)
+
+	reject := diagram.AddNode("Reject order")
+	done := diagram.AddNode("Done")
+	done.SetShape(flowchart.NodeShapeStopDouble)
+
+	// Highlight the failure path with a class
+	failure := diagram.AddClass("failure")
+	failure.Style.Fill = "#fdd"
+	failure.Style.Stroke = "#c00"
+	reject.SetClass(failure)
+
+	// Group the fulfillment steps in a subgraph
+	fulfillment := diagram.AddSubgraph("Fulfillment")
+	fulfillment.AddLink(reserve, charge)
+	fulfillment.AddLink(charge, orders).SetShape(flowchart.LinkShapeThick)
+
+	// Add links between nodes
+	diagram.AddLink(start, valid)
+	diagram.AddLink(valid, reserve).SetText("yes")
+	diagram.AddLink(valid, reject).SetText("no").SetShape(flowchart.LinkShapeDotted)
+	diagram.AddLink(orders, done)
+	diagram.AddLink(reject, done).SetHead(flowchart.LinkArrowTypeCross)
+
+	// Write the image to flowchart.svg in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	file, err := os.Create(filepath.Join(dir, "flowchart.svg"))
+	if err != nil {
+		fmt.Printf("Error creating flowchart.svg: %v\n", err)
+		return
+	}
+	defer file.Close()
+
+	if _, err := diagram.RenderSVG(file); err != nil {
+		fmt.Printf("Error writing flowchart.svg: %v\n", err)
+		return
+	}
+}
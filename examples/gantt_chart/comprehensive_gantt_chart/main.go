@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/gantt"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func main() {
+	// Create a new gantt chart
+	diagram := gantt.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Product Release Plan")
+	diagram.Config.SetTheme(basediagram.ThemeForest)
+	diagram.Config.SetBarHeight(24).SetSectionFontSize(14).SetTopAxis(true)
+
+	// Dates are skipped on weekends and public holidays
+	diagram.SetAxisFormat("%b %d").
+		SetTickInterval("1week").
+		AddExcludes(gantt.ExcludeWeekends, "2024-01-15", "2024-02-19").
+		SetTodayMarker(gantt.TodayMarkerOff)
+
+	// Planning Phase
+	planning := diagram.AddSection("Planning")
+	kickoff := planning.AddTask("Kickoff").SetStatuses(gantt.TaskStatusMilestone).SetID("kickoff").SetStart("2024-01-02").SetDuration("0d")
+	research := planning.AddTask("Market research").SetStatuses(gantt.TaskStatusDone).SetID("research").SetAfter(kickoff).SetDuration("5d")
+	scope := planning.AddTask("Scope definition").SetStatuses(gantt.TaskStatusDone).SetID("scope").SetAfter(research).SetDuration("3d")
+
+	// Design Phase
+	design := diagram.AddSection("Design")
+	architecture := design.AddTask("Architecture").SetStatuses(gantt.TaskStatusActive, gantt.TaskStatusCritical).SetID("arch").SetAfter(scope).SetDuration("5d")
+	wireframes := design.AddTask("Wireframes").SetStatuses(gantt.TaskStatusActive).SetID("ux").SetAfter(scope).SetDuration("7d")
+
+	// Development Phase
+	development := diagram.AddSection("Development")
+	backend := development.AddTask("Backend services").SetStatuses(gantt.TaskStatusCritical).SetID("backend").SetAfter(architecture).SetDuration("15d")
+	frontend := development.AddTask("Frontend").SetID("frontend").SetAfter(architecture, wireframes).SetDuration("12d")
+	integration := development.AddTask("Integration").SetStatuses(gantt.TaskStatusCritical).SetID("integration").SetAfter(backend, frontend).SetDuration("5d")
+
+	// Release Phase
+	release := diagram.AddSection("Release")
+	beta := release.AddTask("Beta program").SetID("beta").SetAfter(integration).SetDuration("10d")
+	release.AddTask("Launch").SetStatuses(gantt.TaskStatusMilestone, gantt.TaskStatusCritical).SetID("launch").SetAfter(beta).SetDuration("0d")
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
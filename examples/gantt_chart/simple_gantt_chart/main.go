@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/gantt"
+)
+
+func main() {
+	// Create a new gantt chart
+	diagram := gantt.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Simple Release Plan")
+
+	// Add a Planning section with tasks
+	planning := diagram.AddSection("Planning")
+	requirements := planning.AddTask("Requirements").SetID("req").SetStart("2024-01-01").SetDuration("5d")
+	design := planning.AddTask("Design").SetID("design").SetAfter(requirements).SetDuration("7d")
+
+	// Add a Development section with tasks
+	development := diagram.AddSection("Development")
+	development.AddTask("Implementation").SetID("impl").SetAfter(design).SetDuration("14d")
+	development.AddTask("Testing").SetDuration("5d")
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
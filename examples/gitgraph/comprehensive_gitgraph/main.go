@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/gitgraph"
+)
+
+func main() {
+	// Create a new git graph drawn from top to bottom
+	diagram := gitgraph.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Release process")
+	diagram.SetDirection(gitgraph.DirectionTopToBottom)
+
+	// Configure the branches and labels
+	diagram.Config.SetMainBranchName("trunk").SetRotateCommitLabel(false)
+
+	// Every step is checked against the history built so far
+	steps := []func() error{
+		func() error {
+			diagram.Commit().SetID("init").SetTag("v0.1")
+			return nil
+		},
+		func() error {
+			develop, err := diagram.Branch("develop")
+			if err == nil {
+				develop.SetOrder(2)
+			}
+			return err
+		},
+		func() error {
+			diagram.Commit().SetID("login")
+			diagram.Commit().SetID("typo").SetType(gitgraph.CommitTypeReverse)
+			diagram.Commit().SetID("search").SetType(gitgraph.CommitTypeHighlight)
+			return nil
+		},
+		func() error {
+			return diagram.Checkout("trunk")
+		},
+		func() error {
+			merge, err := diagram.Merge("develop")
+			if err == nil {
+				merge.SetID("release").SetTag("v1.0")
+			}
+			return err
+		},
+		func() error {
+			hotfix, err := diagram.Branch("hotfix")
+			if err == nil {
+				hotfix.SetOrder(1)
+			}
+			return err
+		},
+		func() error {
+			diagram.Commit().SetID("security fix")
+			return diagram.Switch("develop")
+		},
+		func() error {
+			_, err := diagram.CherryPick("security fix")
+			return err
+		},
+		func() error {
+			// Merging a branch into itself is refused
+			_, err := diagram.Merge("develop")
+			if err == nil {
+				return fmt.Errorf("merge into itself was accepted")
+			}
+			fmt.Printf("Refused: %v\n", err)
+			return nil
+		},
+	}
+
+	for _, step := range steps {
+		if err := step(); err != nil {
+			fmt.Printf("Error building history: %v\n", err)
+			return
+		}
+	}
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
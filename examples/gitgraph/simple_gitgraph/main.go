@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/gitgraph"
+)
+
+func main() {
+	// Create a new git graph
+	diagram := gitgraph.NewDiagram()
+	diagram.EnableMarkdownFence()
+
+	// Work on a feature branch and merge it back
+	diagram.Commit()
+	if _, err := diagram.Branch("feature"); err != nil {
+		fmt.Printf("Error creating branch: %v\n", err)
+		return
+	}
+	diagram.Commit()
+	diagram.Commit()
+	if err := diagram.Checkout("main"); err != nil {
+		fmt.Printf("Error checking out branch: %v\n", err)
+		return
+	}
+	if _, err := diagram.Merge("feature"); err != nil {
+		fmt.Printf("Error merging branch: %v\n", err)
+		return
+	}
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/kanban"
+)
+
+func main() {
+	// Create a new kanban board
+	diagram := kanban.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Sprint 12")
+
+	// Link the tickets to the issue tracker
+	diagram.Config.SetTicketBaseURL("https://example.atlassian.net/browse/#TICKET#")
+
+	// Backlog
+	backlog := diagram.AddColumn("backlog", "Backlog")
+	backlog.AddCard("search", "Full text search").SetPriority(kanban.PriorityLow)
+	backlog.AddCard("export", "Export boards as Markdown").SetTicket("MC-2041")
+
+	// Work in progress, with assignees and priorities
+	doing := diagram.AddColumn("doing", "In progress")
+	doing.AddCard("grammar", "Design grammar").
+		SetAssigned("knsv").
+		SetTicket("MC-2037").
+		SetPriority(kanban.PriorityVeryHigh)
+	doing.AddCard("renderer", "Create renderer").
+		SetAssigned("K.Sveidqvist").
+		SetTicket("MC-2038").
+		SetPriority(kanban.PriorityHigh)
+
+	// Review and done
+	review := diagram.AddColumn("review", "Ready for review")
+	review.AddCard("tests", "Create parsing tests").SetAssigned("knsv").SetPriority(kanban.PriorityVeryLow)
+
+	done := diagram.AddColumn("done", "Done")
+	done.AddCard("docs", "Write documentation").SetTicket("MC-2036")
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/kanban"
+)
+
+func main() {
+	// Create a new kanban board
+	diagram := kanban.NewDiagram()
+	diagram.EnableMarkdownFence()
+
+	// Add the columns and their cards
+	todo := diagram.AddColumn("todo", "Todo")
+	todo.AddCard("docs", "Write documentation")
+	todo.AddCard("tests", "Add tests")
+
+	doing := diagram.AddColumn("doing", "In progress")
+	doing.AddCard("parser", "Write parser")
+
+	diagram.AddColumn("done", "Done")
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/mindmap"
+)
+
+func main() {
+	// Create a new mindmap
+	diagram := mindmap.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Mind mapping")
+
+	// Configure the layout
+	diagram.Config.SetPadding(16).SetMaxNodeWidth(200)
+
+	// Use a circle for the root idea
+	root := diagram.SetRoot("mindmap").SetID("root").SetShape(mindmap.NodeShapeCircle)
+
+	// Each branch tools a different shape
+	origins := root.AddChild("Origins").SetID("origins").SetShape(mindmap.NodeShapeCloud)
+	origins.AddChild("Long history").SetIcon("fa fa-book")
+	popularisation := origins.AddChild("Popularisation")
+	popularisation.AddChild("British popular psychology author Tony Buzan")
+
+	research := root.AddChild("Research").SetID("research").SetShape(mindmap.NodeShapeRounded)
+	research.AddChild("On effectiveness and features")
+	research.AddChild("On automatic creation").SetID("auto").SetShape(mindmap.NodeShapeBang).AddClass("urgent")
+
+	tools := root.AddChild("Tools").SetID("tools").SetShape(mindmap.NodeShapeHexagon)
+	tools.AddChild("Pen and paper").SetID("pen").SetShape(mindmap.NodeShapeSquare).SetIcon("fa fa-pen")
+	tools.AddChild("Mermaid")
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
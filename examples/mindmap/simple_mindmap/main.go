@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/mindmap"
+)
+
+func main() {
+	// Create a new mindmap
+	diagram := mindmap.NewDiagram()
+	diagram.EnableMarkdownFence()
+
+	// Add the root idea and its branches
+	root := diagram.SetRoot("Go")
+	root.AddChild("Goroutines")
+	root.AddChild("Channels")
+	root.AddChild("Interfaces")
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strconv"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/packet"
+)
+
+// tcpHeader is the fixed part of a TCP header as it is encoded. Fields
+// narrower than their Go type give their width in bits with a bits tag.
+type tcpHeader struct {
+	SourcePort      uint16 `label:"Source Port"`
+	DestinationPort uint16 `label:"Destination Port"`
+	SequenceNumber  uint32 `label:"Sequence Number"`
+	AckNumber       uint32 `label:"Acknowledgment Number"`
+	DataOffset      uint8  `label:"Data Offset" bits:"4"`
+	Reserved        uint8  `label:"Reserved" bits:"6"`
+	URG             bool   `label:"URG" bits:"1"`
+	ACK             bool   `label:"ACK" bits:"1"`
+	PSH             bool   `label:"PSH" bits:"1"`
+	RST             bool   `label:"RST" bits:"1"`
+	SYN             bool   `label:"SYN" bits:"1"`
+	FIN             bool   `label:"FIN" bits:"1"`
+	Window          uint16 `label:"Window"`
+	Checksum        uint16 `label:"Checksum"`
+	UrgentPointer   uint16 `label:"Urgent Pointer"`
+	Options         [5]byte
+}
+
+func main() {
+	// Create a new packet diagram drawing 32 bits per row
+	diagram := packet.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("TCP Header")
+	diagram.Config.SetBitsPerRow(32).SetShowBits(true).SetRowHeight(36)
+
+	// Add one field per struct field, sized from its type unless tagged.
+	// The options cross the end of a row and are written as two ranges.
+	header := reflect.TypeOf(tcpHeader{})
+	for i := 0; i < header.NumField(); i++ {
+		field := header.Field(i)
+
+		bits := int(field.Type.Size()) * 8
+		if tag, ok := field.Tag.Lookup("bits"); ok {
+			bits, _ = strconv.Atoi(tag)
+		}
+
+		label := field.Tag.Get("label")
+		if label == "" {
+			label = field.Name
+		}
+
+		diagram.AddField(bits, label)
+	}
+
+	// Report problems such as overlapping fields before writing the diagram
+	for _, problem := range diagram.Validate() {
+		fmt.Println(problem)
+	}
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/packet"
+)
+
+func main() {
+	// Create a new packet diagram
+	diagram := packet.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("UDP Packet")
+
+	// Add the fields by width, the bit ranges follow one another
+	diagram.AddField(16, "Source Port")
+	diagram.AddField(16, "Destination Port")
+	diagram.AddField(16, "Length")
+	diagram.AddField(16, "Checksum")
+	diagram.AddField(32, "Data (variable length)")
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/pie"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/basediagram"
+)
+
+func main() {
+	// Create a new pie chart showing the values in the legend
+	diagram := pie.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Weekly Requests by Service")
+	diagram.SetShowData(true)
+	diagram.Config.SetTheme(basediagram.ThemeNeutral)
+	diagram.Config.SetTextPosition(0.6)
+
+	// Add one slice per service, in thousands of requests
+	requests := []struct {
+		service string
+		count   float64
+	}{
+		{"Authentication", 1240.5},
+		{"Search", 980.25},
+		{"Checkout", 412},
+		{"Recommendations", 305.75},
+		{"Notifications", 128},
+	}
+	for _, r := range requests {
+		diagram.AddSlice(r.service, r.count)
+	}
+
+	// Report problems such as negative values before writing the chart
+	for _, problem := range diagram.Validate() {
+		fmt.Println(problem)
+	}
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
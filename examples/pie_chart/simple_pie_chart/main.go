@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/pie"
+)
+
+func main() {
+	// Create a new pie chart
+	diagram := pie.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Pets adopted by volunteers")
+
+	// Add one slice per kind of pet
+	diagram.AddSlice("Dogs", 386)
+	diagram.AddSlice("Cats", 85)
+	diagram.AddSlice("Rats", 15)
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
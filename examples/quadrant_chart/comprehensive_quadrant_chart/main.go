@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/quadrant"
+)
+
+func main() {
+	// Create a new quadrant chart to prioritize tech debt
+	diagram := quadrant.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Tech debt prioritization")
+
+	// Configure the layout and colors
+	diagram.Config.SetChartWidth(600).SetChartHeight(600).SetPointLabelFontSize(14)
+	diagram.Config.SetQuadrant1Fill("#e3f2fd").SetQuadrant2Fill("#e8f5e9").
+		SetQuadrant3Fill("#fffde7").SetQuadrant4Fill("#ffebee").
+		SetQuadrantPointFill("#455a64")
+
+	// Label the axes and quadrants
+	diagram.SetXAxis("Low effort", "High effort")
+	diagram.SetYAxis("Low impact", "High impact")
+	diagram.SetQuadrantLabel(quadrant.QuadrantTopRight, "Plan carefully")
+	diagram.SetQuadrantLabel(quadrant.QuadrantTopLeft, "Quick wins")
+	diagram.SetQuadrantLabel(quadrant.QuadrantBottomLeft, "Fill-ins")
+	diagram.SetQuadrantLabel(quadrant.QuadrantBottomRight, "Avoid")
+
+	// Place the debt items, highlighting the ones to do first
+	diagram.AddPoint("Flaky tests", 0.2, 0.85).SetRadius(12).SetColor("#2e7d32")
+	diagram.AddPoint("Legacy auth", 0.85, 0.9).SetRadius(15).SetStrokeColor("#b71c1c").SetStrokeWidth(3)
+	diagram.AddPoint("Lint warnings", 0.15, 0.2)
+	diagram.AddPoint("Rewrite UI", 0.9, 0.35).SetColor("#c62828")
+	diagram.AddPoint("Slow build", 0.4, 0.7).SetRadius(10)
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
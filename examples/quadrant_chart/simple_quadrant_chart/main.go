@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/quadrant"
+)
+
+func main() {
+	// Create a new quadrant chart
+	diagram := quadrant.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Reach and engagement of campaigns")
+
+	// Label the axes and quadrants
+	diagram.SetXAxis("Low Reach", "High Reach")
+	diagram.SetYAxis("Low Engagement", "High Engagement")
+	diagram.SetQuadrantLabel(quadrant.QuadrantTopRight, "We should expand")
+	diagram.SetQuadrantLabel(quadrant.QuadrantTopLeft, "Need to promote")
+	diagram.SetQuadrantLabel(quadrant.QuadrantBottomLeft, "Re-evaluate")
+	diagram.SetQuadrantLabel(quadrant.QuadrantBottomRight, "May be improved")
+
+	// Place the campaigns
+	diagram.AddPoint("Campaign A", 0.3, 0.6)
+	diagram.AddPoint("Campaign B", 0.45, 0.23)
+	diagram.AddPoint("Campaign C", 0.57, 0.69)
+	diagram.AddPoint("Campaign D", 0.78, 0.34)
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
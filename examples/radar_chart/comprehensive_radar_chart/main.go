@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/radar"
+)
+
+func main() {
+	// Create a new radar chart comparing service maturity across teams
+	diagram := radar.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Service Maturity")
+	diagram.Config.SetWidth(640).SetHeight(640).SetCurveTension(0.1)
+
+	// Add one axis per maturity dimension
+	dimensions := []struct {
+		id    string
+		label string
+	}{
+		{"reliability", "Reliability"},
+		{"security", "Security"},
+		{"observability", "Observability"},
+		{"testing", "Testing"},
+		{"docs", "Documentation"},
+		{"delivery", "Delivery"},
+	}
+	for _, dimension := range dimensions {
+		diagram.AddAxis(dimension.id, dimension.label)
+	}
+
+	// Add one curve per team, with the scores in the order of the axes
+	scores := []struct {
+		id     string
+		team   string
+		values []float64
+	}{
+		{"payments", "Payments", []float64{4.5, 5, 3.5, 4, 3, 4}},
+		{"search", "Search", []float64{3.5, 3, 4.5, 3, 2.5, 5}},
+		{"identity", "Identity", []float64{5, 5, 4, 4.5, 4, 3}},
+	}
+	for _, score := range scores {
+		diagram.AddCurve(score.id, score.team, score.values...)
+	}
+
+	// Score from 0 to 5 with one ring per level, drawn as polygons
+	diagram.SetMin(0).SetMax(5).SetTicks(5)
+	diagram.SetGraticule(radar.GraticulePolygon)
+	diagram.SetShowLegend(true)
+
+	// Report problems such as missing scores before writing the chart
+	for _, problem := range diagram.Validate() {
+		fmt.Println(problem)
+	}
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/radar"
+)
+
+func main() {
+	// Create a new radar chart
+	diagram := radar.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Grades")
+
+	// Add the axes, then one curve per student with one value per axis
+	diagram.AddAxis("m", "Math")
+	diagram.AddAxis("s", "Science")
+	diagram.AddAxis("e", "English")
+	diagram.AddAxis("h", "History")
+	diagram.AddAxis("g", "Geography")
+	diagram.AddCurve("a", "Alice", 85, 90, 80, 70, 75)
+	diagram.AddCurve("b", "Bob", 70, 75, 85, 80, 90)
+
+	// Scale the chart from 0 to 100
+	diagram.SetMin(0).SetMax(100)
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/requirement"
+)
+
+// spec is a requirement as kept in a service's requirement file
+type spec struct {
+	name     string
+	id       string
+	text     string
+	kind     string
+	risk     string
+	verify   string
+	parent   string
+	verified []string
+}
+
+func main() {
+	// Create a new requirement diagram
+	diagram := requirement.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Payment service traceability")
+
+	// Configure the diagram
+	diagram.Config.SetRectMinWidth(250).SetRectPadding(12)
+
+	// Requirements loaded from the service's requirement file
+	specs := []spec{
+		{name: "secure_payments", id: "PAY-1", text: "Payments must be processed securely", kind: "requirement", risk: "High", verify: "Inspection"},
+		{name: "card_tokenization", id: "PAY-1.1", text: "Card numbers are never stored in clear text", kind: "functionalRequirement", risk: "High", verify: "Test", parent: "secure_payments", verified: []string{"tokenizer_tests"}},
+		{name: "payment_latency", id: "PAY-1.2", text: "99% of payments complete within 2s", kind: "performanceRequirement", risk: "Medium", verify: "Analysis", parent: "secure_payments", verified: []string{"load_tests"}},
+		{name: "psp_api", id: "PAY-1.3", text: "Use the provider REST API, version 3", kind: "interfaceRequirement", risk: "Low", verify: "Demonstration", parent: "secure_payments"},
+		{name: "eu_data_residency", id: "PAY-2", text: "Payment data stays in EU regions", kind: "designConstraint", risk: "Medium", verify: "Inspection"},
+	}
+
+	// Elements that implement or verify the requirements
+	tests := map[string]*requirement.Element{
+		"tokenizer_tests": diagram.AddElement("tokenizer_tests").SetType("test suite").SetDocRef("payments/tokenizer_test.go"),
+		"load_tests":      diagram.AddElement("load_tests").SetType("benchmark").SetDocRef("payments/load/README.md"),
+	}
+	service := diagram.AddElement("payment_service").SetType("service").SetDocRef("https://git.example.com/payments")
+
+	// Add the requirements, then connect them to their parents and tests
+	requirements := make(map[string]*requirement.Requirement)
+	for _, s := range specs {
+		requirements[s.name] = diagram.AddRequirement(requirement.RequirementType(s.kind), s.name).
+			SetID(s.id).
+			SetText(s.text).
+			SetRisk(requirement.Risk(s.risk)).
+			SetVerifyMethod(requirement.VerifyMethod(s.verify))
+	}
+
+	for _, s := range specs {
+		req := requirements[s.name]
+		if s.parent != "" {
+			diagram.AddRelationship(requirements[s.parent], requirement.RelationshipContains, req)
+		}
+		for _, test := range s.verified {
+			diagram.AddRelationship(tests[test], requirement.RelationshipVerifies, req)
+		}
+	}
+
+	diagram.AddRelationship(service, requirement.RelationshipSatisfies, requirements["secure_payments"])
+	diagram.AddRelationship(service, requirement.RelationshipSatisfies, requirements["eu_data_residency"])
+	diagram.AddRelationship(requirements["eu_data_residency"], requirement.RelationshipRefines, requirements["secure_payments"])
+
+	// Report problems such as unknown risks before writing the diagram
+	for _, problem := range diagram.Validate() {
+		fmt.Println(problem)
+	}
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/requirement"
+)
+
+func main() {
+	// Create a new requirement diagram
+	diagram := requirement.NewDiagram()
+	diagram.EnableMarkdownFence()
+
+	// Add a requirement
+	req := diagram.AddRequirement(requirement.TypeRequirement, "test_req").
+		SetID("1").
+		SetText("the test text.").
+		SetRisk(requirement.RiskHigh).
+		SetVerifyMethod(requirement.VerifyTest)
+
+	// Add the element that satisfies it
+	entity := diagram.AddElement("test_entity").
+		SetType("simulation")
+	diagram.AddRelationship(entity, requirement.RelationshipSatisfies, req)
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/sankey"
+)
+
+// route is an aggregated request count between two services
+type route struct {
+	from     string
+	to       string
+	requests float64
+}
+
+func main() {
+	// Create a new sankey diagram
+	diagram := sankey.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Request routing, requests per second")
+
+	// Configure the diagram
+	diagram.Config.SetWidth(900).SetHeight(500)
+	diagram.Config.SetLinkColor("source").SetNodeAlignment("left")
+	diagram.Config.SetShowValues(true).SetSuffix("req/s")
+
+	// Build the flows from aggregated metrics
+	routes := []route{
+		{"Edge, EU", "Gateway", 1200},
+		{"Edge, US", "Gateway", 1850},
+		{"Gateway", "Orders API", 1400},
+		{"Gateway", "Catalog API", 1500},
+		{"Gateway", `"Legacy" API`, 150},
+		{"Orders API", "Payments", 600},
+		{"Orders API", "Database", 800},
+		{"Catalog API", "Cache", 1100},
+		{"Catalog API", "Database", 400},
+	}
+	for _, r := range routes {
+		diagram.AddLink(r.from, r.to, r.requests)
+	}
+
+	// Report problems such as cycles or negative values before writing the diagram
+	for _, problem := range diagram.Validate() {
+		fmt.Println(problem)
+	}
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
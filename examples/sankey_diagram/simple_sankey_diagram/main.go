@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/sankey"
+)
+
+func main() {
+	// Create a new sankey diagram
+	diagram := sankey.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Energy flows")
+
+	// Add the flows between the nodes
+	diagram.AddLink("Agricultural 'waste'", "Bio-conversion", 124.729)
+	diagram.AddLink("Bio-conversion", "Liquid", 0.597)
+	diagram.AddLink("Bio-conversion", "Losses", 26.862)
+	diagram.AddLink("Bio-conversion", "Solid", 280.322)
+	diagram.AddLink("Bio-conversion", "Gas", 81.144)
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
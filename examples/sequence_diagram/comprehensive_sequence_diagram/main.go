@@ -30,13 +30,13 @@ func main() {
 	// Initial order submission
 	orderReq := diagram.AddMessage(browser, frontend, sequenceDiagram.MessageSolid, "Submit Order")
 
-	// Dynamically create payment service actor
+	// Dynamically create payment service actor, which must receive a message right away
 	paymentSvc := diagram.CreateActor(frontend, "payment", "Payment Service", sequenceDiagram.ActorParticipant)
-	diagram.AddNote(sequenceDiagram.NoteRight, "Payment service initialized on demand", paymentSvc)
 
 	// Payment processing flow with nested messages
-	paymentFlow := orderReq.AddNestedMessage(frontend, paymentSvc, sequenceDiagram.MessageSolid, "Process Payment")
+	paymentFlow := diagram.AddMessage(frontend, paymentSvc, sequenceDiagram.MessageSolid, "Process Payment")
 	paymentFlow.AddNestedMessage(paymentSvc, frontend, sequenceDiagram.MessageAsync, "Payment Processing Started")
+	diagram.AddNote(sequenceDiagram.NoteRight, "Payment service initialized on demand", paymentSvc)
 
 	// Add inventory service
 	inventory := diagram.AddActor("inventory", "Inventory Service", sequenceDiagram.ActorParticipant)
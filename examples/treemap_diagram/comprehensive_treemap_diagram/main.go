@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/treemap"
+)
+
+func main() {
+	// Create a new treemap of the size of a binary per package, in KiB
+	diagram := treemap.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Binary Size by Package (KiB)")
+	diagram.Config.SetShowValues(true).SetValueFormat(",").SetLabelFontSize(12)
+
+	// Build the tree from the package paths; "net/http" is both a package
+	// and the parent of "net/http/internal", so its own size gets a leaf
+	sizes := map[string]float64{
+		"runtime":                          1480,
+		"net/http":                         1210,
+		"net/http/internal":                35,
+		"net/netip":                        90,
+		"net":                              620,
+		"crypto/tls":                       540,
+		"crypto/x509":                      310,
+		"crypto/internal/fips140/aes":      60,
+		"encoding/json":                    260,
+		"github.com/example/app/server":    180,
+		"github.com/example/app/storage":   140,
+		"github.com/example/app/cmd/serve": 12,
+	}
+	diagram.AddPaths(sizes)
+
+	// Highlight the largest packages and our own module
+	large := diagram.AddClass("large").SetFill("#f96").SetStroke("#333").SetStrokeWidth(2)
+	ours := diagram.AddClass("ours").SetFill("#9cf").SetColor("#000")
+
+	var highlight func(nodes []*treemap.Node)
+	highlight = func(nodes []*treemap.Node) {
+		for _, node := range nodes {
+			if !node.IsSection() && node.Value >= 1000 {
+				node.SetClass(large)
+			}
+			highlight(node.Children)
+		}
+	}
+	highlight(diagram.Nodes)
+
+	for _, node := range diagram.Nodes {
+		if node.Name == "github.com" {
+			node.SetClass(ours)
+		}
+	}
+
+	// Report problems such as negative sizes before writing the treemap
+	for _, problem := range diagram.Validate() {
+		fmt.Println(problem)
+	}
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/treemap"
+)
+
+func main() {
+	// Create a new treemap
+	diagram := treemap.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Household Budget")
+
+	// Add sections holding leaves sized by their value
+	housing := diagram.AddSection("Housing")
+	housing.AddLeaf("Rent", 1200)
+	housing.AddLeaf("Utilities", 180)
+
+	food := diagram.AddSection("Food")
+	food.AddLeaf("Groceries", 450)
+	food.AddLeaf("Restaurants", 150)
+
+	diagram.AddLeaf("Savings", 600)
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/xychart"
+)
+
+func main() {
+	// Create a new XY chart
+	diagram := xychart.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Nightly benchmark results")
+
+	// Configure the chart
+	diagram.Config.SetWidth(900).SetHeight(500).SetShowDataLabel(true)
+	diagram.SetOrientation(xychart.OrientationVertical)
+
+	// Collect one result per night
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	latency := []float64{42, 40.5, 44, 39, 37.5, 38, 36}
+	allocations := []float64{120, 118, 121, 110, 104, 104, 98}
+
+	var latencyPoints, allocationPoints []xychart.TimeValue
+	for i := range latency {
+		day := start.AddDate(0, 0, i)
+		latencyPoints = append(latencyPoints, xychart.TimeValue{Time: day, Value: latency[i]})
+		allocationPoints = append(allocationPoints, xychart.TimeValue{Time: day, Value: allocations[i]})
+	}
+
+	// The time series helper formats the dates as the x-axis categories
+	diagram.SetXAxisTitle("Night")
+	diagram.AddTimeSeries(xychart.SeriesBar, "Allocations", "Jan 2", allocationPoints)
+	diagram.AddTimeSeries(xychart.SeriesLine, "Latency (ms)", "Jan 2", latencyPoints)
+	diagram.SetYAxisTitle("Value").SetYAxisRange(0, 130)
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"github.com/TyphonHill/go-mermaid/diagrams/xychart"
+)
+
+func main() {
+	// Create a new XY chart
+	diagram := xychart.NewDiagram()
+	diagram.EnableMarkdownFence()
+	diagram.SetTitle("Sales Revenue")
+
+	// Configure the axes
+	diagram.SetXAxisCategories("jan", "feb", "mar", "apr", "may", "jun")
+	diagram.SetYAxisTitle("Revenue (in $)").SetYAxisRange(4000, 11000)
+
+	// Plot the same data as bars and as a line
+	revenue := []float64{5000, 6000, 7500, 8200, 9500, 10500}
+	diagram.AddBar("", revenue)
+	diagram.AddLine("", revenue)
+
+	// Write the diagram to README.md in the same directory as this source file
+	_, filename, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(filename)
+	readmePath := filepath.Join(dir, "README.md")
+	if err := diagram.RenderToFile(readmePath); err != nil {
+		fmt.Printf("Error writing diagram to README.md: %v\n", err)
+		return
+	}
+}
@@ -0,0 +1,110 @@
+// Package mermaid reads Mermaid documents into the diagram types of this module
+package mermaid
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/TyphonHill/go-mermaid/diagrams"
+	"github.com/TyphonHill/go-mermaid/diagrams/architecture"
+	"github.com/TyphonHill/go-mermaid/diagrams/block"
+	"github.com/TyphonHill/go-mermaid/diagrams/c4"
+	"github.com/TyphonHill/go-mermaid/diagrams/class"
+	"github.com/TyphonHill/go-mermaid/diagrams/entityrelationship"
+	"github.com/TyphonHill/go-mermaid/diagrams/flowchart"
+	"github.com/TyphonHill/go-mermaid/diagrams/gantt"
+	"github.com/TyphonHill/go-mermaid/diagrams/gitgraph"
+	"github.com/TyphonHill/go-mermaid/diagrams/kanban"
+	"github.com/TyphonHill/go-mermaid/diagrams/mindmap"
+	"github.com/TyphonHill/go-mermaid/diagrams/packet"
+	"github.com/TyphonHill/go-mermaid/diagrams/pie"
+	"github.com/TyphonHill/go-mermaid/diagrams/quadrant"
+	"github.com/TyphonHill/go-mermaid/diagrams/radar"
+	"github.com/TyphonHill/go-mermaid/diagrams/requirement"
+	"github.com/TyphonHill/go-mermaid/diagrams/sankey"
+	"github.com/TyphonHill/go-mermaid/diagrams/sequence"
+	"github.com/TyphonHill/go-mermaid/diagrams/state"
+	"github.com/TyphonHill/go-mermaid/diagrams/timeline"
+	"github.com/TyphonHill/go-mermaid/diagrams/treemap"
+	"github.com/TyphonHill/go-mermaid/diagrams/userjourney"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+	"github.com/TyphonHill/go-mermaid/diagrams/xychart"
+)
+
+// parsers maps each diagram keyword to the parser of its package.
+var parsers = map[string]func(io.Reader) (diagrams.Diagram, error){
+	"flowchart":          parseWith(flowchart.Parse),
+	"graph":              parseWith(flowchart.Parse),
+	"sequenceDiagram":    parseWith(sequence.Parse),
+	"classDiagram":       parseWith(class.Parse),
+	"classDiagram-v2":    parseWith(class.Parse),
+	"stateDiagram-v2":    parseWith(state.Parse),
+	"erDiagram":          parseWith(entityrelationship.Parse),
+	"block-beta":         parseWith(block.Parse),
+	"timeline":           parseWith(timeline.Parse),
+	"journey":            parseWith(userjourney.Parse),
+	"gantt":              parseWith(gantt.Parse),
+	"pie":                parseWith(pie.Parse),
+	"mindmap":            parseWith(mindmap.Parse),
+	"gitGraph":           parseWith(gitgraph.Parse),
+	"kanban":             parseWith(kanban.Parse),
+	"quadrantChart":      parseWith(quadrant.Parse),
+	"xychart-beta":       parseWith(xychart.Parse),
+	"xychart":            parseWith(xychart.Parse),
+	"sankey-beta":        parseWith(sankey.Parse),
+	"sankey":             parseWith(sankey.Parse),
+	"requirementDiagram": parseWith(requirement.Parse),
+	"C4Context":          parseWith(c4.Parse),
+	"C4Container":        parseWith(c4.Parse),
+	"C4Component":        parseWith(c4.Parse),
+	"C4Dynamic":          parseWith(c4.Parse),
+	"C4Deployment":       parseWith(c4.Parse),
+	"architecture-beta":  parseWith(architecture.Parse),
+	"packet-beta":        parseWith(packet.Parse),
+	"radar-beta":         parseWith(radar.Parse),
+	"treemap-beta":       parseWith(treemap.Parse),
+}
+
+// Parse reads a Mermaid document and returns the diagram matching its keyword.
+// An optional ```mermaid markdown fence is stripped, and the title and config
+// of the frontmatter are copied to the diagram. The returned value can be
+// converted to the concrete type of its package, such as *flowchart.Flowchart.
+// Syntax errors are reported as *parser.Error values holding the line and column.
+func Parse(r io.Reader) (diagrams.Diagram, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := parser.Read(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(doc.Lines) == 0 {
+		return nil, &parser.Error{Line: 1, Column: 1, Message: "missing diagram declaration"}
+	}
+
+	header := doc.Lines[0]
+	keyword := strings.Fields(header.Text)[0]
+
+	parse, ok := parsers[keyword]
+	if !ok {
+		return nil, header.Errorf(0, "unknown diagram type %q", keyword)
+	}
+
+	return parse(bytes.NewReader(data))
+}
+
+// parseWith adapts a package parser to return a diagrams.Diagram, keeping
+// the result nil when parsing fails.
+func parseWith[T diagrams.Diagram](parse func(io.Reader) (T, error)) func(io.Reader) (diagrams.Diagram, error) {
+	return func(r io.Reader) (diagrams.Diagram, error) {
+		diagram, err := parse(r)
+		if err != nil {
+			return nil, err
+		}
+		return diagram, nil
+	}
+}
@@ -0,0 +1,388 @@
+package mermaid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/TyphonHill/go-mermaid/diagrams"
+	"github.com/TyphonHill/go-mermaid/diagrams/architecture"
+	"github.com/TyphonHill/go-mermaid/diagrams/block"
+	"github.com/TyphonHill/go-mermaid/diagrams/c4"
+	"github.com/TyphonHill/go-mermaid/diagrams/class"
+	"github.com/TyphonHill/go-mermaid/diagrams/entityrelationship"
+	"github.com/TyphonHill/go-mermaid/diagrams/flowchart"
+	"github.com/TyphonHill/go-mermaid/diagrams/gantt"
+	"github.com/TyphonHill/go-mermaid/diagrams/gitgraph"
+	"github.com/TyphonHill/go-mermaid/diagrams/kanban"
+	"github.com/TyphonHill/go-mermaid/diagrams/mindmap"
+	"github.com/TyphonHill/go-mermaid/diagrams/packet"
+	"github.com/TyphonHill/go-mermaid/diagrams/pie"
+	"github.com/TyphonHill/go-mermaid/diagrams/quadrant"
+	"github.com/TyphonHill/go-mermaid/diagrams/radar"
+	"github.com/TyphonHill/go-mermaid/diagrams/requirement"
+	"github.com/TyphonHill/go-mermaid/diagrams/sankey"
+	"github.com/TyphonHill/go-mermaid/diagrams/sequence"
+	"github.com/TyphonHill/go-mermaid/diagrams/state"
+	"github.com/TyphonHill/go-mermaid/diagrams/timeline"
+	"github.com/TyphonHill/go-mermaid/diagrams/treemap"
+	"github.com/TyphonHill/go-mermaid/diagrams/userjourney"
+	"github.com/TyphonHill/go-mermaid/diagrams/utils/parser"
+	"github.com/TyphonHill/go-mermaid/diagrams/xychart"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		diagram func() diagrams.Diagram
+	}{
+		{
+			name: "Flowchart",
+			diagram: func() diagrams.Diagram {
+				f := flowchart.NewFlowchart()
+				f.Title = "Flow"
+				f.Config.SetCurve("basis")
+				f.AddLink(f.AddNode("Start"), f.AddNode("End"))
+				return f
+			},
+		},
+		{
+			name: "Sequence diagram",
+			diagram: func() diagrams.Diagram {
+				d := sequence.NewDiagram()
+				d.Title = "Sequence"
+				d.Config.SetMirrorActors(false)
+				d.AddMessage(d.AddActor("A", "Alice", sequence.ActorParticipant), d.AddActor("B", "Bob", sequence.ActorActor), sequence.MessageSolidArrow, "Hello")
+				return d
+			},
+		},
+		{
+			name: "Class diagram",
+			diagram: func() diagrams.Diagram {
+				d := class.NewClassDiagram()
+				d.Title = "Classes"
+				d.Config.SetHideEmptyMembersBox(true)
+				d.AddRelation(d.AddClass("Animal", nil), d.AddClass("Dog", nil))
+				return d
+			},
+		},
+		{
+			name: "State diagram",
+			diagram: func() diagrams.Diagram {
+				d := state.NewDiagram()
+				d.Title = "States"
+				d.Config.SetPadding(4)
+				d.AddTransition(d.AddState("Idle", "Waiting", state.StateNormal), nil, "stop")
+				return d
+			},
+		},
+		{
+			name: "Entity relationship diagram",
+			diagram: func() diagrams.Diagram {
+				d := entityrelationship.NewDiagram()
+				d.Title = "Entities"
+				d.Config.SetLayoutDirection("LR")
+				d.AddRelationship(d.AddEntity("CUSTOMER"), d.AddEntity("ORDER")).SetCardinality(entityrelationship.OneToZeroOrMore)
+				return d
+			},
+		},
+		{
+			name: "Block diagram",
+			diagram: func() diagrams.Diagram {
+				d := block.NewDiagram()
+				d.Title = "Blocks"
+				d.Config.SetPadding(8)
+				d.AddLink(d.AddBlock("A"), d.AddBlock("B"))
+				return d
+			},
+		},
+		{
+			name: "Timeline diagram",
+			diagram: func() diagrams.Diagram {
+				d := timeline.NewDiagram()
+				d.Title = "Timeline"
+				d.Config.SetWidth(300)
+				d.AddSection("Start").AddEvent("2002", "LinkedIn")
+				return d
+			},
+		},
+		{
+			name: "User journey diagram",
+			diagram: func() diagrams.Diagram {
+				d := userjourney.NewDiagram()
+				d.Title = "Journey"
+				d.Config.SetTheme("forest")
+				d.AddSection("Work").AddTask("Make tea", 5, "Me")
+				return d
+			},
+		},
+		{
+			name: "Gantt chart",
+			diagram: func() diagrams.Diagram {
+				d := gantt.NewDiagram()
+				d.Title = "Gantt"
+				d.Config.SetBarHeight(20)
+				d.AddSection("Plan").AddTask("Design").SetStart("2024-01-01").SetDuration("5d")
+				return d
+			},
+		},
+		{
+			name: "Pie chart",
+			diagram: func() diagrams.Diagram {
+				d := pie.NewDiagram()
+				d.Title = "Pets"
+				d.Config.SetTextPosition(0.5)
+				d.SetShowData(true).AddSlice("Dogs", 386)
+				return d
+			},
+		},
+		{
+			name: "Mindmap",
+			diagram: func() diagrams.Diagram {
+				d := mindmap.NewDiagram()
+				d.Title = "Ideas"
+				d.Config.SetPadding(12)
+				d.SetRoot("Root").SetShape(mindmap.NodeShapeCircle).AddChild("Child")
+				return d
+			},
+		},
+		{
+			name: "Git graph",
+			diagram: func() diagrams.Diagram {
+				d := gitgraph.NewDiagram()
+				d.Title = "History"
+				d.Config.SetShowCommitLabel(false)
+				d.SetDirection(gitgraph.DirectionTopToBottom)
+				d.Commit().SetID("init")
+				d.Branch("develop")
+				d.Commit()
+				d.Checkout("main")
+				d.Merge("develop")
+				return d
+			},
+		},
+		{
+			name: "Kanban board",
+			diagram: func() diagrams.Diagram {
+				d := kanban.NewDiagram()
+				d.Title = "Sprint"
+				d.Config.SetTicketBaseURL("https://example.com/#TICKET#")
+				d.AddColumn("todo", "Todo").AddCard("a", "Task").SetAssigned("knsv").SetPriority(kanban.PriorityHigh)
+				return d
+			},
+		},
+		{
+			name: "Quadrant chart",
+			diagram: func() diagrams.Diagram {
+				d := quadrant.NewDiagram()
+				d.Title = "Tech debt"
+				d.Config.SetChartWidth(500)
+				d.SetXAxis("Low effort", "High effort").SetQuadrantLabel(quadrant.QuadrantTopRight, "Do first")
+				d.AddPoint("Logging", 0.2, 0.8).SetRadius(8)
+				return d
+			},
+		},
+		{
+			name: "XY chart",
+			diagram: func() diagrams.Diagram {
+				d := xychart.NewDiagram()
+				d.Title = "Sales"
+				d.Config.SetWidth(700)
+				d.SetXAxisCategories("jan", "feb").SetYAxisRange(0, 100)
+				d.AddBar("Revenue", []float64{40, 60})
+				return d
+			},
+		},
+		{
+			name: "Sankey diagram",
+			diagram: func() diagrams.Diagram {
+				d := sankey.NewDiagram()
+				d.Title = "Routing"
+				d.Config.SetLinkColor("source")
+				d.AddLink("gateway", "api, v2", 100)
+				return d
+			},
+		},
+		{
+			name: "Requirement diagram",
+			diagram: func() diagrams.Diagram {
+				d := requirement.NewDiagram()
+				d.Title = "Traceability"
+				d.Config.SetRectMinWidth(250)
+				req := d.AddRequirement(requirement.TypeRequirement, "test_req").SetID("1").SetRisk(requirement.RiskHigh)
+				d.AddRelationship(d.AddElement("test_entity"), requirement.RelationshipSatisfies, req)
+				return d
+			},
+		},
+		{
+			name: "C4 diagram",
+			diagram: func() diagrams.Diagram {
+				d := c4.NewDiagram().SetKind(c4.DiagramContainer)
+				d.Title = "Banking"
+				d.Config.SetC4ShapeInRow(3)
+				user := d.AddPerson("user", "User")
+				api := d.AddBoundary(c4.BoundarySystem, "b", "Bank").AddContainer("api", "API").SetTechnology("Go")
+				d.AddRelationship(user, api, "Uses")
+				return d
+			},
+		},
+		{
+			name: "architecture diagram",
+			diagram: func() diagrams.Diagram {
+				d := architecture.NewDiagram()
+				d.Title = "Platform"
+				d.Config.SetIconSize(60)
+				api := d.AddGroup("api", architecture.IconCloud, "API")
+				db := d.AddService("db", architecture.IconDatabase, "Database").SetParent(api)
+				server := d.AddService("server", architecture.IconServer, "Server").SetParent(api)
+				d.AddEdge(db, architecture.SideLeft, server, architecture.SideRight).SetArrows(false, true)
+				return d
+			},
+		},
+		{
+			name: "packet diagram",
+			diagram: func() diagrams.Diagram {
+				d := packet.NewDiagram()
+				d.Title = "UDP Packet"
+				d.Config.SetBitsPerRow(16)
+				d.AddField(16, "Source Port")
+				d.AddField(16, "Destination Port")
+				d.AddField(1, "Flag")
+				return d
+			},
+		},
+		{
+			name: "radar chart",
+			diagram: func() diagrams.Diagram {
+				d := radar.NewDiagram()
+				d.Title = "Grades"
+				d.Config.SetWidth(500)
+				d.AddAxis("m", "Math")
+				d.AddAxis("s", "Science")
+				d.AddCurve("a", "Alice", 85, 90)
+				d.SetMax(100).SetGraticule(radar.GraticulePolygon)
+				return d
+			},
+		},
+		{
+			name: "treemap",
+			diagram: func() diagrams.Diagram {
+				d := treemap.NewDiagram()
+				d.Title = "Binary size"
+				d.Config.SetShowValues(false)
+				large := d.AddClass("large").SetFill("#f96")
+				d.AddPaths(map[string]float64{"net/http": 300, "net/ip": 10})
+				d.Nodes[0].SetClass(large)
+				return d
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.diagram()
+
+			got, err := Parse(strings.NewReader(want.String()))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got.DiagramType() != want.DiagramType() {
+				t.Errorf("Parse() diagram type = %v, want %v", got.DiagramType(), want.DiagramType())
+			}
+
+			if got.GetTitle() != want.GetTitle() {
+				t.Errorf("Parse() title = %v, want %v", got.GetTitle(), want.GetTitle())
+			}
+
+			if got.GetConfig().String() != want.GetConfig().String() {
+				t.Errorf("Parse() config = %v, want %v", got.GetConfig(), want.GetConfig())
+			}
+
+			if got.String() != want.String() {
+				t.Errorf("Parse() mismatch:\nwant:\n%s\ngot:\n%s", want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParse_MarkdownFence(t *testing.T) {
+	input := "```mermaid\n---\ntitle: Fenced\nconfig:\n  theme: dark\n---\ngraph LR\n    A --> B\n```\n"
+
+	got, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	f, ok := got.(*flowchart.Flowchart)
+	if !ok {
+		t.Fatalf("Parse() = %T, want *flowchart.Flowchart", got)
+	}
+
+	if f.Title != "Fenced" || f.Config.Name != "dark" || f.Direction != flowchart.FlowchartDirectionLeftRight {
+		t.Errorf("Parse() = title %q, theme %q, direction %q, want Fenced, dark and LR", f.Title, f.Config.Name, f.Direction)
+	}
+}
+
+func TestParse_UnmodeledConfig(t *testing.T) {
+	input := "---\nconfig:\n  look: handDrawn\n  layout: elk\n  theme: dark\n  sequence:\n    wrap: true\n---\nflowchart LR\n    A --> B\n"
+
+	got, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if f, ok := got.(*flowchart.Flowchart); !ok || f.Config.Name != "dark" {
+		t.Errorf("Parse() = %T with theme %v, want a dark *flowchart.Flowchart", got, got.GetConfig())
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "Empty document",
+			input:   "\n%% only a comment\n",
+			line:    1,
+			column:  1,
+			message: "missing diagram declaration",
+		},
+		{
+			name:    "Unknown diagram type",
+			input:   "---\ntitle: ZenUML\n---\nzenuml\n",
+			line:    4,
+			column:  1,
+			message: `unknown diagram type "zenuml"`,
+		},
+		{
+			name:    "Error from the diagram parser",
+			input:   "sequenceDiagram\n    A => B: hi\n",
+			line:    2,
+			column:  7,
+			message: "expected message arrow",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.input))
+			if got != nil {
+				t.Errorf("Parse() = %v, want nil", got)
+			}
+
+			var parseErr *parser.Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse() error = %v, want *parser.Error", err)
+			}
+
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Message != tt.message {
+				t.Errorf("Parse() error = %v, want line %d, column %d: %s", parseErr, tt.line, tt.column, tt.message)
+			}
+		})
+	}
+}